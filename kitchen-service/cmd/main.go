@@ -71,7 +71,7 @@ func main() {
 
 	// เริ่มต้น Kafka Consumer
 	ctxConsumer, cancelConsumer := context.WithCancel(context.Background())
-	go consumer.Run(ctxConsumer, []string{kafka.OrderItemsCreatedTopic}, server.KafkaConsumer, server.WebsocketHub)
+	go consumer.Run(ctxConsumer, []string{kafka.OrderItemsCreatedTopic, kafka.OrderItemsAmendedTopic}, server.KafkaConsumer, server.WebsocketHub)
 
 	// Create a done channel to signal when the shutdown is complete
 	done := make(chan bool, 1)
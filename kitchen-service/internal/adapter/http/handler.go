@@ -7,6 +7,7 @@ import (
 	"food-story/pkg/middleware"
 	"food-story/pkg/utils"
 	shareModel "food-story/shared/model"
+	"food-story/shared/orderstatus"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -151,6 +152,42 @@ func (s *Handler) GetOrderItemsByID(c *fiber.Ctx) error {
 	return middleware.ResponseOK(c, result)
 }
 
+// UpdateOrderItemsStatusPrepare godoc
+// @Summary Update order item status to preparing
+// @Description Mark an order item as being prepared, the customer can no longer amend it
+// @Tags Order
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Order ID"
+// @Param orderItemsID path int true "Order Item ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /orders/{id}/items/{orderItemsID}/status/prepare [patch]
+func (s *Handler) UpdateOrderItemsStatusPrepare(c *fiber.Ctx) error {
+	orderItemsID, orderID, err := handleParams(c)
+	if err != nil {
+		return err
+	}
+
+	err = s.useCase.UpdateOrderItemsStatus(c.Context(), shareModel.OrderItemsStatus{
+		ID:         orderItemsID,
+		OrderID:    orderID,
+		StatusCode: orderstatus.Preparing,
+		Actor:      orderstatus.ActorKitchen,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
 // UpdateOrderItemsStatusServe godoc
 // @Summary Update order item status to serv
 // @Description Update status of specific order item to serv
@@ -165,6 +202,7 @@ func (s *Handler) GetOrderItemsByID(c *fiber.Ctx) error {
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /orders/{id}/items/{orderItemsID}/status/serve [patch]
 func (s *Handler) UpdateOrderItemsStatusServe(c *fiber.Ctx) error {
@@ -176,7 +214,8 @@ func (s *Handler) UpdateOrderItemsStatusServe(c *fiber.Ctx) error {
 	err = s.useCase.UpdateOrderItemsStatusServed(c.Context(), shareModel.OrderItemsStatus{
 		ID:         orderItemsID,
 		OrderID:    orderID,
-		StatusCode: "SERVED",
+		StatusCode: orderstatus.Served,
+		Actor:      orderstatus.ActorKitchen,
 	})
 	if err != nil {
 		return middleware.ResponseError(c, err)
@@ -199,6 +238,7 @@ func (s *Handler) UpdateOrderItemsStatusServe(c *fiber.Ctx) error {
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /orders/{id}/items/{orderItemsID}/status/cancel [patch]
 func (s *Handler) UpdateOrderItemsStatusCancel(c *fiber.Ctx) error {
@@ -210,7 +250,8 @@ func (s *Handler) UpdateOrderItemsStatusCancel(c *fiber.Ctx) error {
 	err = s.useCase.UpdateOrderItemsStatus(c.Context(), shareModel.OrderItemsStatus{
 		ID:         orderItemsID,
 		OrderID:    orderID,
-		StatusCode: "CANCELLED",
+		StatusCode: orderstatus.Cancelled,
+		Actor:      orderstatus.ActorKitchen,
 	})
 	if err != nil {
 		return middleware.ResponseError(c, err)
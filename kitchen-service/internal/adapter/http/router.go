@@ -43,6 +43,7 @@ func (s *Handler) setupRoutes() {
 	authGroup.Get("/:id<int>/items", s.GetOrderItems)
 	authGroup.Get("/:id<int>/items/:orderItemsID<int>", s.GetOrderItemsByID)
 
+	authGroup.Patch("/:id<int>/items/:orderItemsID<int>/status/prepare", s.UpdateOrderItemsStatusPrepare)
 	authGroup.Patch("/:id<int>/items/:orderItemsID<int>/status/serve", s.UpdateOrderItemsStatusServe)
 	authGroup.Patch("/:id<int>/items/:orderItemsID<int>/status/cancel", s.UpdateOrderItemsStatusCancel)
 
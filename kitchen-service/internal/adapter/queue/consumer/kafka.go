@@ -2,13 +2,21 @@ package consumer
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"food-story/kitchen-service/internal/adapter/websocket"
+	"food-story/shared/kafka"
 	"log"
 
 	"github.com/IBM/sarama"
 )
 
+// _messageTypes tells kitchen displays which topic a message came from
+var _messageTypes = map[string]string{
+	kafka.OrderItemsCreatedTopic: websocket.MessageOrderItemsPlaced,
+	kafka.OrderItemsAmendedTopic: websocket.MessageOrderItemsAmended,
+}
+
 type Consumer struct {
 	Ready        chan bool
 	WebSocketHub *websocket.Hub
@@ -22,9 +30,25 @@ func (c *Consumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
 
 func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
+		messageType, ok := _messageTypes[msg.Topic]
+		if !ok {
+			log.Printf("unexpected topic %s, message skipped", msg.Topic)
+			session.MarkMessage(msg, "")
+			continue
+		}
+
+		frame, err := json.Marshal(websocket.Message{
+			Type: messageType,
+			Data: msg.Value,
+		})
+		if err != nil {
+			log.Printf("failed to wrap message from %s: %v", msg.Topic, err)
+			session.MarkMessage(msg, "")
+			continue
+		}
 
 		// แจ้งแตือนข้อความ
-		c.WebSocketHub.Broadcast <- msg.Value
+		c.WebSocketHub.Broadcast <- frame
 
 		// แจ้งว่า message นี้ถูก consume แล้ว
 		session.MarkMessage(msg, "")
@@ -7,7 +7,9 @@ import (
 	"food-story/pkg/exceptions"
 	"food-story/pkg/utils"
 	database "food-story/shared/database/sqlc"
+	"food-story/shared/kafka"
 	shareModel "food-story/shared/model"
+	"food-story/shared/orderstatus"
 	"strings"
 	"sync"
 
@@ -20,11 +22,22 @@ func (i *Implement) UpdateOrderItemsStatus(ctx context.Context, payload shareMod
 		return
 	}
 
-	err = i.repository.UpdateOrderItemsStatus(ctx, database.UpdateOrderItemsStatusParams{
-		StatusCode: payload.StatusCode,
-		ID:         payload.ID,
+	err = i.repository.TXUpdateOrderItemsStatus(ctx, database.TXUpdateOrderItemsStatusParams{
+		UpdateOrderItemsStatus: database.UpdateOrderItemsStatusParams{
+			StatusCode: payload.StatusCode,
+			ID:         payload.ID,
+		},
+		Actor:       payload.Actor,
+		GenerateID:  i.snowflakeID.Generate,
+		OutboxTopic: kafka.OrderItemsStatusChangedTopic,
 	})
 	if err != nil {
+		if statusErr, ok := orderstatus.Error(err); ok {
+			return statusErr
+		}
+		if errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
 		return exceptions.Errorf(exceptions.CodeRepository, "failed to update order items status", err)
 	}
 
@@ -37,8 +50,22 @@ func (i *Implement) UpdateOrderItemsStatusServed(ctx context.Context, payload sh
 		return
 	}
 
-	err = i.repository.UpdateOrderItemsStatusServed(ctx, payload.ID)
+	err = i.repository.TXUpdateOrderItemsStatus(ctx, database.TXUpdateOrderItemsStatusParams{
+		UpdateOrderItemsStatus: database.UpdateOrderItemsStatusParams{
+			StatusCode: orderstatus.Served,
+			ID:         payload.ID,
+		},
+		Actor:       payload.Actor,
+		GenerateID:  i.snowflakeID.Generate,
+		OutboxTopic: kafka.OrderItemsStatusChangedTopic,
+	})
 	if err != nil {
+		if statusErr, ok := orderstatus.Error(err); ok {
+			return statusErr
+		}
+		if errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
 		return exceptions.Errorf(exceptions.CodeRepository, "failed to update order items status served", err)
 	}
 
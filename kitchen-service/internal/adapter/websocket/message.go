@@ -0,0 +1,15 @@
+package websocket
+
+import "encoding/json"
+
+// Message types tell a kitchen display whether to add a new ticket or update the one it already shows.
+const (
+	MessageOrderItemsPlaced  = "placed"
+	MessageOrderItemsAmended = "amended"
+)
+
+// Message is the frame every kitchen display receives, Data is the order item as published by the order-service.
+type Message struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
@@ -115,8 +115,8 @@ func New() (*FiberServer, error) {
 		return nil, fmt.Errorf("failed to init kafka consumer: %w", err)
 	}
 
-	// Create a new Node with a Node number of 1
-	node, err := snowflakeid.CreateSnowflakeNode(1)
+	// each service has its own node number so IDs written to the shared database never collide
+	node, err := snowflakeid.CreateSnowflakeNode(snowflakeid.NodeNumber(configApp.SnowflakeNodeID, snowflakeid.NodeKitchenService))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snowflake: %w", err)
 	}
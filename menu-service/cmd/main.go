@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"food-story/menu-service/internal/adapter/job"
 	"food-story/menu-service/internal/app"
+	"food-story/menu-service/internal/usecase"
 	"food-story/pkg/common"
 	"food-story/shared/config"
 	"log"
@@ -16,7 +18,7 @@ import (
 	"food-story/menu-service/docs"
 )
 
-func gracefulShutdown(fiberServer *app.FiberServer, done chan bool) {
+func gracefulShutdown(fiberServer *app.FiberServer, cancelJob context.CancelFunc, done chan bool) {
 	// Create context that listens for the interrupt signal from the OS.
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -32,6 +34,10 @@ func gracefulShutdown(fiberServer *app.FiberServer, done chan bool) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// stop background jobs before their connections go away
+	cancelJob()
+	log.Println("Background jobs stopped")
+
 	// close all connection
 	fiberServer.CloseAllConnection()
 
@@ -55,6 +61,10 @@ func main() {
 
 	initSwagger(server.Config)
 
+	// start recommendation refresh
+	ctxJob, cancelJob := context.WithCancel(context.Background())
+	go job.RunRecommendationRefresh(ctxJob, server.UseCase, usecase.RecommendationRefreshInterval)
+
 	// Create a done channel to signal when the shutdown is complete
 	done := make(chan bool, 1)
 
@@ -66,7 +76,7 @@ func main() {
 	}()
 
 	// Run graceful shutdown in a separate goroutine
-	go gracefulShutdown(server, done)
+	go gracefulShutdown(server, cancelJob, done)
 
 	// Wait for the graceful shutdown to complete
 	<-done
@@ -6,6 +6,7 @@ require (
 	github.com/gofiber/fiber/v2 v2.52.10
 	github.com/jackc/pgx/v5 v5.7.6
 	go.uber.org/mock v0.6.0
+	golang.org/x/image v0.25.0
 )
 
 require (
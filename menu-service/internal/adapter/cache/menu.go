@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"food-story/pkg/exceptions"
+	"food-story/shared/redis"
+	"time"
+)
+
+// menuCacheTTL bounds how stale a cached menu can get from changes made outside menu-service,
+// such as stock deducted by orders or a menu schedule window opening.
+const menuCacheTTL = time.Minute
+
+type RedisMenuCacheInterface interface {
+	GetCachedMenu(key string, result any) (bool, error)
+	SetCachedMenu(key string, value any) error
+	InvalidateMenu() error
+	GetCachedRecommendations(key string, result any) (bool, error)
+	SetCachedRecommendations(key string, value any) error
+	InvalidateRecommendations() error
+}
+
+type RedisMenuCache struct {
+	client redis.RedisInterface
+}
+
+func NewRedisMenuCache(client *redis.RedisClient) *RedisMenuCache {
+	return &RedisMenuCache{
+		client: client,
+	}
+}
+
+func (r *RedisMenuCache) GetCachedMenu(key string, result any) (bool, error) {
+	versionKey, err := r.versionedKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	return r.get(versionKey, result)
+}
+
+func (r *RedisMenuCache) SetCachedMenu(key string, value any) error {
+	versionKey, err := r.versionedKey(key)
+	if err != nil {
+		return err
+	}
+
+	return r.set(versionKey, value)
+}
+
+// InvalidateMenu bumps the menu version so every cached entry is skipped at once,
+// old entries are left to expire on their own.
+func (r *RedisMenuCache) InvalidateMenu() error {
+	_, err := r.client.Incr(redis.KeyMenuVersion)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRedis, "failed to invalidate menu cache", err)
+	}
+	return nil
+}
+
+// GetCachedRecommendations reads recommendations, they go stale when the menu changes and when the
+// product associations are refreshed.
+func (r *RedisMenuCache) GetCachedRecommendations(key string, result any) (bool, error) {
+	recommendationKey, err := r.recommendationKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	return r.get(recommendationKey, result)
+}
+
+func (r *RedisMenuCache) SetCachedRecommendations(key string, value any) error {
+	recommendationKey, err := r.recommendationKey(key)
+	if err != nil {
+		return err
+	}
+
+	return r.set(recommendationKey, value)
+}
+
+// InvalidateRecommendations skips cached recommendations only, the rest of the menu stays cached.
+func (r *RedisMenuCache) InvalidateRecommendations() error {
+	_, err := r.client.Incr(redis.KeyRecommendationVersion)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRedis, "failed to invalidate recommendation cache", err)
+	}
+	return nil
+}
+
+func (r *RedisMenuCache) get(key string, result any) (bool, error) {
+	data, err := r.client.Get(key)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRedisKeyNotFound) {
+			return false, nil
+		}
+		return false, exceptions.Errorf(exceptions.CodeRedis, "failed to get cached menu", err)
+	}
+
+	err = json.Unmarshal([]byte(data), result)
+	if err != nil {
+		return false, exceptions.Errorf(exceptions.CodeRedis, "failed to unmarshal cached menu", err)
+	}
+
+	return true, nil
+}
+
+func (r *RedisMenuCache) set(key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRedis, "failed to marshal menu", err)
+	}
+
+	err = r.client.Set(key, string(data), menuCacheTTL)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRedis, "failed to cache menu", err)
+	}
+
+	return nil
+}
+
+func (r *RedisMenuCache) versionedKey(key string) (string, error) {
+	version, err := r.version(redis.KeyMenuVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%s:%s", redis.KeyMenu, version, key), nil
+}
+
+func (r *RedisMenuCache) recommendationKey(key string) (string, error) {
+	menuVersion, err := r.version(redis.KeyMenuVersion)
+	if err != nil {
+		return "", err
+	}
+
+	recommendationVersion, err := r.version(redis.KeyRecommendationVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s%s:%s:%s", redis.KeyMenu, menuVersion, recommendationVersion, key), nil
+}
+
+func (r *RedisMenuCache) version(versionKey string) (string, error) {
+	version, err := r.client.Get(versionKey)
+	if err != nil {
+		if !errors.Is(err, exceptions.ErrRedisKeyNotFound) {
+			return "", exceptions.Errorf(exceptions.CodeRedis, "failed to get menu version", err)
+		}
+		version = "0"
+	}
+
+	return version, nil
+}
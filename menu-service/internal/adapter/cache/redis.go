@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"encoding/json"
 	"errors"
 	"food-story/pkg/exceptions"
 	"food-story/shared/redis"
@@ -9,7 +10,7 @@ import (
 )
 
 type RedisTableCacheInterface interface {
-	IsCachedTableExist(sessionID uuid.UUID) error
+	GetCachedSessionLocale(sessionID uuid.UUID) (string, error)
 }
 
 type RedisTableCache struct {
@@ -22,14 +23,29 @@ func NewRedisTableCache(client *redis.RedisClient) *RedisTableCache {
 	}
 }
 
-func (r *RedisTableCache) IsCachedTableExist(sessionID uuid.UUID) error {
-	_, err := r.client.Get(redis.KeyTable + sessionID.String())
+// cachedSession is the part of the table session written by table-service that the menu needs
+type cachedSession struct {
+	Locale *string `json:"locale"`
+}
+
+func (r *RedisTableCache) GetCachedSessionLocale(sessionID uuid.UUID) (string, error) {
+	data, err := r.client.Get(redis.KeyTable + sessionID.String())
 	if err != nil {
 		if errors.Is(err, exceptions.ErrRedisKeyNotFound) {
-			return exceptions.Error(exceptions.CodeUnauthorized, exceptions.ErrSessionExpired.Error())
+			return "", exceptions.Error(exceptions.CodeUnauthorized, exceptions.ErrSessionExpired.Error())
 		}
-		return exceptions.Errorf(exceptions.CodeRedis, "cache session", err)
+		return "", exceptions.Errorf(exceptions.CodeRedis, "cache session", err)
+	}
+
+	var session cachedSession
+	err = json.Unmarshal([]byte(data), &session)
+	if err != nil {
+		return "", exceptions.Errorf(exceptions.CodeSystem, "failed to unmarshal cache session", err)
+	}
+
+	if session.Locale == nil {
+		return "", nil
 	}
 
-	return nil
+	return *session.Locale, nil
 }
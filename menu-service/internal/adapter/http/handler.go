@@ -1,10 +1,14 @@
 package http
 
 import (
+	"fmt"
 	"food-story/menu-service/internal/domain"
+	"food-story/menu-service/internal/usecase"
 	"food-story/pkg/exceptions"
 	"food-story/pkg/middleware"
 	"food-story/pkg/utils"
+	"io"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -16,7 +20,10 @@ import (
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localizedName fields, e.g. zh-TW,zh;q=0.9"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} middleware.SuccessResponse{data=[]domain.Category}
+// @Success 304 "Not Modified"
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
@@ -24,28 +31,33 @@ import (
 // @Router /category [get]
 func (s *Handler) CategoryList(c *fiber.Ctx) error {
 
-	result, err := s.useCase.ListCategory(c.Context())
+	result, err := s.useCase.ListCategory(c.Context(), middleware.RequestLocales(c), isPublishedMenu(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
 
-	return middleware.ResponseOK(c, result)
+	return middleware.ResponseOKWithETag(c, result)
 }
 
 // SearchMenu godoc
 // @Summary Search menu items
-// @Description Search menu items with filters
+// @Description Search menu items with filters. The search term is matched fuzzily against Thai and English names, romanization aliases and product search keywords, and results are ordered by relevance first
 // @Tags Menu
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localizedName fields, e.g. zh-TW,zh;q=0.9"
 // @Param pageNumber query int false "Page number"
 // @Param pageSize query int false "Page size"
-// @Param search query string false "Search by name"
+// @Param search query string false "Search by name, alias or keyword"
 // @Param categoryID query []string false "Filter by category IDs"
+// @Param dietaryTag query []string false "Only items with all of these tags (e.g. VEGETARIAN, HALAL)"
+// @Param excludeAllergen query []string false "Hide items containing any of these allergens (e.g. PEANUT, SHELLFISH, GLUTEN)"
 // @Param orderBy query string false "Order by field (id, tableNumber, seats, status)"
 // @Param orderType query string false "Order direction (asc, desc)"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} middleware.SuccessResponse{data=domain.SearchProductResult}
+// @Success 304 "Not Modified"
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
@@ -62,21 +74,24 @@ func (s *Handler) SearchMenu(c *fiber.Ctx) error {
 	}
 
 	payload := domain.SearchProduct{
-		Name:        body.Search,
-		CategoryID:  utils.FilterOutZero(body.CategoryID),
-		IsAvailable: true,
-		OrderByType: body.OrderByType,
-		OrderBy:     body.OrderBy,
-		PageSize:    body.PageSize,
-		PageNumber:  body.PageNumber,
+		Name:             body.Search,
+		CategoryID:       utils.FilterOutZero(body.CategoryID),
+		DietaryTags:      toUpperCodes(body.DietaryTag),
+		ExcludeAllergens: toUpperCodes(body.ExcludeAllergen),
+		IsAvailable:      true,
+		OrderByType:      body.OrderByType,
+		OrderBy:          body.OrderBy,
+		PageSize:         body.PageSize,
+		PageNumber:       body.PageNumber,
+		Locales:          middleware.RequestLocales(c),
 	}
 
-	result, err := s.useCase.SearchProductByFilters(c.Context(), payload)
+	result, err := s.useCase.SearchProductByFilters(c.Context(), payload, isPublishedMenu(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
 
-	return middleware.ResponseOKWithPagination(c, middleware.ResponseWithPaginationPayload{
+	return middleware.ResponseOKWithPaginationETag(c, middleware.ResponseWithPaginationPayload{
 		PageNumber: result.PageNumber,
 		PageSize:   result.PageSize,
 		TotalItems: result.TotalItems,
@@ -92,8 +107,11 @@ func (s *Handler) SearchMenu(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localizedName fields, e.g. zh-TW,zh;q=0.9"
 // @Param id path string true "Product ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} middleware.SuccessResponse{data=domain.Product}
+// @Success 304 "Not Modified"
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
@@ -106,12 +124,12 @@ func (s *Handler) GetProductByID(c *fiber.Ctx) error {
 		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 	}
 
-	result, err := s.useCase.GetProductByID(c.Context(), productID)
+	result, err := s.useCase.GetProductByID(c.Context(), productID, middleware.RequestLocales(c), isPublishedMenu(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
 
-	return middleware.ResponseOK(c, result)
+	return middleware.ResponseOKWithETag(c, result)
 }
 
 // SessionCurrent godoc
@@ -153,3 +171,1866 @@ func (s *Handler) ListProductTimeExtension(c *fiber.Ctx) error {
 
 	return middleware.ResponseOK(c, result)
 }
+
+// CreateProduct godoc
+// @Summary Create menu item
+// @Description Create a new menu item
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param product body Product true "Product details"
+// @Success 201 {object} middleware.SuccessResponse{data=createProductResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff [post]
+func (s *Handler) CreateProduct(c *fiber.Ctx) error {
+	payload, err := s.parseProductBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	result, err := s.useCase.CreateProduct(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, createProductResponse{
+		ID: result,
+	})
+}
+
+// UpdateProduct godoc
+// @Summary Update menu item
+// @Description Update details of an existing menu item
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param product body Product true "Product details"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id} [put]
+func (s *Handler) UpdateProduct(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	payload, err := s.parseProductBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+	payload.ID = productID
+
+	err = s.useCase.UpdateProduct(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UpdateProductAvailability godoc
+// @Summary Update menu item availability
+// @Description Toggle whether a menu item can be ordered
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param availability body updateProductAvailability true "Availability"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/availability [patch]
+func (s *Handler) UpdateProductAvailability(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateProductAvailability)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateProductAvailability(c.Context(), productID, *body.IsAvailable)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UpdateProductStock godoc
+// @Summary Update menu item stock
+// @Description Set the remaining stock of a menu item. The item becomes unavailable automatically when stock reaches 0. Send null to stop tracking stock
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param stock body updateProductStock true "Stock"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/stock [patch]
+func (s *Handler) UpdateProductStock(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateProductStock)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateProductStock(c.Context(), productID, body.StockQuantity)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UploadProductImage godoc
+// @Summary Upload menu item image
+// @Description Upload a jpeg, png or webp image (max 5 MB) for a menu item. A thumbnail is generated and both are served by this service
+// @Tags Menu
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param image formData file true "Image file"
+// @Success 200 {object} middleware.SuccessResponse{data=domain.ProductImage}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/image [post]
+func (s *Handler) UploadProductImage(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, "image file is required"))
+	}
+
+	if fileHeader.Size > usecase.MaxProductImageSize {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("image file must not exceed %d MB", usecase.MaxProductImageSize>>20)))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Errorf(exceptions.CodeSystem, "failed to read image file", err))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Errorf(exceptions.CodeSystem, "failed to read image file", err))
+	}
+
+	result, err := s.useCase.UploadProductImage(c.Context(), productID, data)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// HideProduct godoc
+// @Summary Hide menu item
+// @Description Hide a menu item from customers
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/hide [patch]
+func (s *Handler) HideProduct(c *fiber.Ctx) error {
+	return s.updateProductVisibility(c, false)
+}
+
+// ShowProduct godoc
+// @Summary Show menu item
+// @Description Make a hidden menu item visible to customers again
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/show [patch]
+func (s *Handler) ShowProduct(c *fiber.Ctx) error {
+	return s.updateProductVisibility(c, true)
+}
+
+func (s *Handler) updateProductVisibility(c *fiber.Ctx, isVisible bool) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateProductVisibility(c.Context(), productID, isVisible)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+func (s *Handler) parseProductBody(c *fiber.Ctx) (domain.Product, error) {
+	body := new(Product)
+	if err := c.BodyParser(body); err != nil {
+		return domain.Product{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return domain.Product{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	categoryID, err := utils.StrToInt64(body.CategoryID)
+	if err != nil {
+		return domain.Product{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return domain.Product{
+		Name:        body.Name,
+		NameEN:      body.NameEN,
+		CategoryID:  categoryID,
+		Description: body.Description,
+		Price:       body.Price,
+		IsAvailable: body.IsAvailable,
+		ImageURL:    body.ImageURL,
+	}, nil
+}
+
+// ListAllCategory godoc
+// @Summary Get list of categories for management
+// @Description Get list of all categories including hidden ones
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.CategoryDetail}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/manage [get]
+func (s *Handler) ListAllCategory(c *fiber.Ctx) error {
+	result, err := s.useCase.ListAllCategory(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// CreateCategory godoc
+// @Summary Create category
+// @Description Create a new category, placed after the last category
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param category body createCategory true "Category details"
+// @Success 201 {object} middleware.SuccessResponse{data=createCategoryResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category [post]
+func (s *Handler) CreateCategory(c *fiber.Ctx) error {
+	body := new(createCategory)
+	if err := c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.CreateCategory(c.Context(), domain.CategoryDetail{
+		Name:      body.Name,
+		NameEn:    body.NameEN,
+		Icon:      body.Icon,
+		Code:      body.Code,
+		IsVisible: body.IsVisible,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, createCategoryResponse{
+		ID: result,
+	})
+}
+
+// UpdateCategory godoc
+// @Summary Rename category
+// @Description Update name and icon of an existing category
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param category body updateCategory true "Category details"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/{id} [put]
+func (s *Handler) UpdateCategory(c *fiber.Ctx) error {
+	categoryID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateCategory)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateCategory(c.Context(), domain.CategoryDetail{
+		ID:     categoryID,
+		Name:   body.Name,
+		NameEn: body.NameEN,
+		Icon:   body.Icon,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UpdateCategorySortOrder godoc
+// @Summary Reorder category
+// @Description Move a category to the given sort order, swapping with the category currently holding it
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param sortOrder body updateCategorySortOrder true "New sort order"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/{id}/sort-order [patch]
+func (s *Handler) UpdateCategorySortOrder(c *fiber.Ctx) error {
+	categoryID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateCategorySortOrder)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateCategorySortOrder(c.Context(), categoryID, body.SortOrder)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// HideCategory godoc
+// @Summary Hide category
+// @Description Hide a category and its menu items from customers
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/{id}/hide [patch]
+func (s *Handler) HideCategory(c *fiber.Ctx) error {
+	return s.updateCategoryVisibility(c, false)
+}
+
+// ShowCategory godoc
+// @Summary Show category
+// @Description Make a hidden category and its menu items visible to customers again
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/{id}/show [patch]
+func (s *Handler) ShowCategory(c *fiber.Ctx) error {
+	return s.updateCategoryVisibility(c, true)
+}
+
+func (s *Handler) updateCategoryVisibility(c *fiber.Ctx, isVisible bool) error {
+	categoryID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateCategoryVisibility(c.Context(), categoryID, isVisible)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UpdateVariants godoc
+// @Summary Update menu item variants
+// @Description Replace all variants (e.g. S/M/L sizes) of a menu item, each with its own price and availability. Send the id of an existing variant to keep it, variants without an id are added and variants left out are removed
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param variants body updateVariants true "Variants"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/variants [put]
+func (s *Handler) UpdateVariants(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateVariants)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	variants := make([]*domain.Variant, len(body.Variants))
+	for index, item := range body.Variants {
+		variantID, err := parseOptionID(item.ID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		variants[index] = &domain.Variant{
+			ID:          variantID,
+			Name:        item.Name,
+			NameEN:      item.NameEN,
+			Price:       item.Price,
+			IsAvailable: item.IsAvailable,
+		}
+	}
+
+	err = s.useCase.UpdateVariants(c.Context(), productID, variants)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UpdateComboSlots godoc
+// @Summary Update combo slots
+// @Description Replace all choice slots of a combo (set menu). Each slot lists the component products a customer can pick, with an optional extra charge. Slots keep their id the same way as variants
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param combo body updateComboSlots true "Combo slots"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/combo [put]
+func (s *Handler) UpdateComboSlots(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateComboSlots)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	slots := make([]*domain.ComboSlot, len(body.Slots))
+	for index, item := range body.Slots {
+		options := make([]*domain.ComboOption, len(item.Options))
+		for optionIndex, option := range item.Options {
+			optionProductID, err := utils.StrToInt64(option.ProductID)
+			if err != nil {
+				return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+			}
+			options[optionIndex] = &domain.ComboOption{
+				ProductID:  optionProductID,
+				PriceDelta: option.PriceDelta,
+			}
+		}
+		slotID, err := parseOptionID(item.ID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		slots[index] = &domain.ComboSlot{
+			ID:      slotID,
+			Name:    item.Name,
+			NameEN:  item.NameEN,
+			Options: options,
+		}
+	}
+
+	err = s.useCase.UpdateComboSlots(c.Context(), productID, slots)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// UpdateModifierGroups godoc
+// @Summary Update menu item modifiers
+// @Description Replace all modifier groups (e.g. spice level, add-ons) of a menu item. Groups and modifiers keep their id the same way as variants
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param modifiers body updateModifierGroups true "Modifier groups"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/modifiers [put]
+func (s *Handler) UpdateModifierGroups(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateModifierGroups)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	groups := make([]*domain.ModifierGroup, len(body.Groups))
+	for groupIndex, group := range body.Groups {
+		modifiers := make([]*domain.Modifier, len(group.Modifiers))
+		for modifierIndex, item := range group.Modifiers {
+			modifierID, err := parseOptionID(item.ID)
+			if err != nil {
+				return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+			}
+			modifiers[modifierIndex] = &domain.Modifier{
+				ID:          modifierID,
+				Name:        item.Name,
+				NameEN:      item.NameEN,
+				PriceDelta:  item.PriceDelta,
+				IsAvailable: item.IsAvailable,
+			}
+		}
+
+		groupID, err := parseOptionID(group.ID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		groups[groupIndex] = &domain.ModifierGroup{
+			ID:            groupID,
+			Name:          group.Name,
+			NameEN:        group.NameEN,
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+			Modifiers:     modifiers,
+		}
+	}
+
+	err = s.useCase.UpdateModifierGroups(c.Context(), productID, groups)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListProductSchedules godoc
+// @Summary Get menu item schedules
+// @Description Get selling time windows of a menu item, evaluated in the configured time zone
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.MenuSchedule}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/schedules [get]
+func (s *Handler) ListProductSchedules(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ListProductSchedules(c.Context(), productID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// UpdateProductSchedules godoc
+// @Summary Update menu item schedules
+// @Description Replace selling time windows of a menu item (e.g. breakfast until 11:00, weekends only). A start time after the end time runs past midnight into the next day. An empty list means always available
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param schedules body updateSchedules true "Schedules"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/schedules [put]
+func (s *Handler) UpdateProductSchedules(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	schedules, err := s.parseSchedulesBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	err = s.useCase.UpdateProductSchedules(c.Context(), productID, schedules)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListCategorySchedules godoc
+// @Summary Get category schedules
+// @Description Get selling time windows of a category, evaluated in the configured time zone
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.MenuSchedule}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/{id}/schedules [get]
+func (s *Handler) ListCategorySchedules(c *fiber.Ctx) error {
+	categoryID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ListCategorySchedules(c.Context(), categoryID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// UpdateCategorySchedules godoc
+// @Summary Update category schedules
+// @Description Replace selling time windows of a category. Applies to every menu item in the category on top of its own schedule. A start time after the end time runs past midnight into the next day. An empty list means always available
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param schedules body updateSchedules true "Schedules"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/category/{id}/schedules [put]
+func (s *Handler) UpdateCategorySchedules(c *fiber.Ctx) error {
+	categoryID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	schedules, err := s.parseSchedulesBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	err = s.useCase.UpdateCategorySchedules(c.Context(), categoryID, schedules)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+func (s *Handler) parseSchedulesBody(c *fiber.Ctx) ([]*domain.MenuSchedule, error) {
+	body := new(updateSchedules)
+	if err := c.BodyParser(body); err != nil {
+		return nil, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return nil, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	schedules := make([]*domain.MenuSchedule, len(body.Schedules))
+	for index, item := range body.Schedules {
+		schedules[index] = &domain.MenuSchedule{
+			DayOfWeek: *item.DayOfWeek,
+			StartTime: item.StartTime,
+			EndTime:   item.EndTime,
+		}
+	}
+
+	return schedules, nil
+}
+
+// ListIngredients godoc
+// @Summary Get list of ingredients
+// @Description Get all ingredients with their remaining stock
+// @Tags Ingredient
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.Ingredient}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/ingredients [get]
+func (s *Handler) ListIngredients(c *fiber.Ctx) error {
+	result, err := s.useCase.ListIngredients(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// ListLowStockIngredients godoc
+// @Summary Low stock report
+// @Description Get ingredients whose remaining stock is at or below their low stock threshold
+// @Tags Ingredient
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.Ingredient}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/ingredients/low-stock [get]
+func (s *Handler) ListLowStockIngredients(c *fiber.Ctx) error {
+	result, err := s.useCase.ListLowStockIngredients(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// CreateIngredient godoc
+// @Summary Create ingredient
+// @Description Create a new ingredient with zero stock
+// @Tags Ingredient
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param ingredient body ingredient true "Ingredient details"
+// @Success 201 {object} middleware.SuccessResponse{data=createIngredientResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/ingredients [post]
+func (s *Handler) CreateIngredient(c *fiber.Ctx) error {
+	payload, err := s.parseIngredientBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	result, err := s.useCase.CreateIngredient(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, createIngredientResponse{
+		ID: result,
+	})
+}
+
+// UpdateIngredient godoc
+// @Summary Update ingredient
+// @Description Update name, unit and low stock threshold of an ingredient
+// @Tags Ingredient
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Ingredient ID"
+// @Param ingredient body ingredient true "Ingredient details"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/ingredients/{id} [put]
+func (s *Handler) UpdateIngredient(c *fiber.Ctx) error {
+	ingredientID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	payload, err := s.parseIngredientBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+	payload.ID = ingredientID
+
+	err = s.useCase.UpdateIngredient(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// CreateIngredientMovement godoc
+// @Summary Adjust ingredient stock
+// @Description Record a stock movement for an ingredient. RESTOCK adds stock, ADJUST accepts a positive or negative quantity (e.g. waste, stock count correction). Menu items taken off sale for lack of this ingredient go back on sale once every ingredient of their recipe is in stock, items switched off by staff stay off
+// @Tags Ingredient
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Ingredient ID"
+// @Param movement body createIngredientMovement true "Stock movement"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/ingredients/{id}/movements [post]
+func (s *Handler) CreateIngredientMovement(c *fiber.Ctx) error {
+	ingredientID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(createIngredientMovement)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.CreateIngredientMovement(c.Context(), domain.IngredientMovement{
+		IngredientID: ingredientID,
+		Quantity:     body.Quantity,
+		Reason:       body.Reason,
+		Note:         body.Note,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListProductIngredients godoc
+// @Summary Get menu item recipe
+// @Description Get ingredients and quantity used per unit of a menu item
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.ProductIngredient}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/ingredients [get]
+func (s *Handler) ListProductIngredients(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ListProductIngredients(c.Context(), productID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// UpdateProductIngredients godoc
+// @Summary Update menu item recipe
+// @Description Replace the recipe (bill of materials) of a menu item. Ingredients are deducted when an item is prepared or served and returned when it is cancelled
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param ingredients body updateProductIngredients true "Recipe"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/ingredients [put]
+func (s *Handler) UpdateProductIngredients(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateProductIngredients)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	ingredients := make([]*domain.ProductIngredient, len(body.Ingredients))
+	for index, item := range body.Ingredients {
+		ingredientID, err := utils.StrToInt64(item.IngredientID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		ingredients[index] = &domain.ProductIngredient{
+			IngredientID: ingredientID,
+			Quantity:     item.Quantity,
+		}
+	}
+
+	err = s.useCase.UpdateProductIngredients(c.Context(), productID, ingredients)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListProductSearchKeywords godoc
+// @Summary Get menu item search keywords
+// @Description Get extra keywords a menu item can be found by
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} middleware.SuccessResponse{data=[]string}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/search-keywords [get]
+func (s *Handler) ListProductSearchKeywords(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ListProductSearchKeywords(c.Context(), productID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// UpdateProductSearchKeywords godoc
+// @Summary Update menu item search keywords
+// @Description Replace the extra keywords (nicknames, main ingredients, other spellings) a menu item can be found by
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param keywords body updateProductSearchKeywords true "Search keywords"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/search-keywords [put]
+func (s *Handler) UpdateProductSearchKeywords(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateProductSearchKeywords)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateProductSearchKeywords(c.Context(), productID, body.Keywords)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListSearchAliases godoc
+// @Summary List search aliases
+// @Description List alternative spellings used when searching the menu
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.SearchAlias}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/search-aliases [get]
+func (s *Handler) ListSearchAliases(c *fiber.Ctx) error {
+	result, err := s.useCase.ListSearchAliases(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// CreateSearchAlias godoc
+// @Summary Create search alias
+// @Description Map a word customers type (e.g. krapow) to the word used in menu names (e.g. kaphrao)
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param alias body createSearchAlias true "Search alias"
+// @Success 201 {object} middleware.SuccessResponse{data=createSearchAliasResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/search-aliases [post]
+func (s *Handler) CreateSearchAlias(c *fiber.Ctx) error {
+	body := new(createSearchAlias)
+	if err := c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.CreateSearchAlias(c.Context(), domain.SearchAlias{
+		Alias: body.Alias,
+		Term:  body.Term,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, createSearchAliasResponse{
+		ID: result,
+	})
+}
+
+// DeleteSearchAlias godoc
+// @Summary Delete search alias
+// @Description Delete an alternative spelling
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Search alias ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/search-aliases/{id} [delete]
+func (s *Handler) DeleteSearchAlias(c *fiber.Ctx) error {
+	aliasID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.DeleteSearchAlias(c.Context(), aliasID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListDietaryTags godoc
+// @Summary List dietary tags
+// @Description List allergen and dietary tags that can be assigned to menu items
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.DietaryTag}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/dietary-tags [get]
+func (s *Handler) ListDietaryTags(c *fiber.Ctx) error {
+	result, err := s.useCase.ListDietaryTags(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// UpdateProductDietaryTags godoc
+// @Summary Update menu item dietary tags
+// @Description Replace the allergen and dietary tags of a menu item. Allergens are copied to order items so the kitchen can highlight them
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param tags body updateProductDietaryTags true "Dietary tag codes"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/dietary-tags [put]
+func (s *Handler) UpdateProductDietaryTags(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(updateProductDietaryTags)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.UpdateProductDietaryTags(c.Context(), productID, body.Codes)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListTranslations godoc
+// @Summary List translations
+// @Description List translations of a menu entity or order status in languages other than Thai and English
+// @Tags Translation
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param entityType path string true "Entity type (product, category, variant, modifier_group, modifier, combo_slot, order_status)"
+// @Param id path string true "Entity ID"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.Translation}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/translations/{entityType}/{id} [get]
+func (s *Handler) ListTranslations(c *fiber.Ctx) error {
+	entityType, entityID, err := s.parseTranslationEntity(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	result, err := s.useCase.ListTranslations(c.Context(), entityType, entityID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// UpdateTranslations godoc
+// @Summary Update translations
+// @Description Replace the translations of a menu entity or order status. Thai and English stay on name and nameEN
+// @Tags Translation
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param entityType path string true "Entity type (product, category, variant, modifier_group, modifier, combo_slot, order_status)"
+// @Param id path string true "Entity ID"
+// @Param translations body updateTranslations true "Translations"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/translations/{entityType}/{id} [put]
+func (s *Handler) UpdateTranslations(c *fiber.Ctx) error {
+	entityType, entityID, err := s.parseTranslationEntity(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	body := new(updateTranslations)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	translations := make([]*domain.Translation, len(body.Translations))
+	for index, item := range body.Translations {
+		translations[index] = &domain.Translation{
+			Field:  item.Field,
+			Locale: item.Locale,
+			Value:  item.Value,
+		}
+	}
+
+	err = s.useCase.UpdateTranslations(c.Context(), entityType, entityID, translations)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+func (s *Handler) parseTranslationEntity(c *fiber.Ctx) (string, int64, error) {
+	params := new(translationEntity)
+	if err := c.ParamsParser(params); err != nil {
+		return "", 0, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(params); err != nil {
+		return "", 0, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	entityID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return "", 0, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return params.EntityType, entityID, nil
+}
+
+func toUpperCodes(codes []string) []string {
+	result := make([]string, 0, len(codes))
+	for _, code := range codes {
+		if code == "" {
+			continue
+		}
+		result = append(result, strings.ToUpper(code))
+	}
+	return result
+}
+
+func (s *Handler) parseIngredientBody(c *fiber.Ctx) (domain.Ingredient, error) {
+	body := new(ingredient)
+	if err := c.BodyParser(body); err != nil {
+		return domain.Ingredient{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return domain.Ingredient{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return domain.Ingredient{
+		Name:              body.Name,
+		NameEN:            body.NameEN,
+		Unit:              body.Unit,
+		LowStockThreshold: body.LowStockThreshold,
+	}, nil
+}
+
+// ListProductPrices godoc
+// @Summary Get menu item price timeline
+// @Description List past, current and scheduled prices of a menu item, newest first
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.ProductPrice}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/prices [get]
+func (s *Handler) ListProductPrices(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ListProductPrices(c.Context(), productID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// ScheduleProductPrice godoc
+// @Summary Schedule a price change
+// @Description Schedule a new price for a menu item, orders placed from effectiveFrom onwards are charged this price
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param price body scheduleProductPrice true "Scheduled price"
+// @Success 201 {object} middleware.SuccessResponse{data=scheduleProductPriceResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/prices [post]
+func (s *Handler) ScheduleProductPrice(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(scheduleProductPrice)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ScheduleProductPrice(c.Context(), productID, domain.ProductPrice{
+		Price:         body.Price,
+		EffectiveFrom: body.EffectiveFrom,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, scheduleProductPriceResponse{
+		ID: result,
+	})
+}
+
+// CancelScheduledProductPrice godoc
+// @Summary Cancel a scheduled price change
+// @Description Delete a price that has not started yet. Prices already in effect are kept as history
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param priceID path string true "Scheduled price ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/{id}/prices/{priceID} [delete]
+func (s *Handler) CancelScheduledProductPrice(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	priceID, err := utils.StrToInt64(c.Params("priceID"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.CancelScheduledProductPrice(c.Context(), productID, priceID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListPromotions godoc
+// @Summary List promotions
+// @Description List percentage, fixed-amount and buy-x-get-y promotions including inactive ones
+// @Tags Promotion
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.Promotion}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/promotions [get]
+func (s *Handler) ListPromotions(c *fiber.Ctx) error {
+	result, err := s.useCase.ListPromotions(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// CreatePromotion godoc
+// @Summary Create promotion
+// @Description Create a promotion for a product or a category. startsAt/endsAt limit the period, daysOfWeek and startTime/endTime the hours (e.g. drinks 20% off 15:00-17:00)
+// @Tags Promotion
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param promotion body promotion true "Promotion"
+// @Success 201 {object} middleware.SuccessResponse{data=createPromotionResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/promotions [post]
+func (s *Handler) CreatePromotion(c *fiber.Ctx) error {
+	payload, err := s.parsePromotionBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	result, err := s.useCase.CreatePromotion(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, createPromotionResponse{
+		ID: result,
+	})
+}
+
+// UpdatePromotion godoc
+// @Summary Update promotion
+// @Description Update a promotion. Set isActive to false to end it, items already ordered keep their discount
+// @Tags Promotion
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID"
+// @Param promotion body promotion true "Promotion"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/promotions/{id} [put]
+func (s *Handler) UpdatePromotion(c *fiber.Ctx) error {
+	promotionID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	payload, err := s.parsePromotionBody(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	payload.ID = promotionID
+	err = s.useCase.UpdatePromotion(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListMenuVersions godoc
+// @Summary List menu versions
+// @Description List published and scheduled menu versions, newest first. isCurrent marks the version customers see right now
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.MenuVersion}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/menu-versions [get]
+func (s *Handler) ListMenuVersions(c *fiber.Ctx) error {
+	result, err := s.useCase.ListMenuVersions(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// PublishMenuVersion godoc
+// @Summary Publish the draft menu
+// @Description Snapshot the current draft menu and publish it to customers, right away or at publishAt. Product prices in the snapshot take effect for orders at the same moment
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param version body publishMenuVersion true "Menu version"
+// @Success 201 {object} middleware.SuccessResponse{data=menuVersionResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/menu-versions [post]
+func (s *Handler) PublishMenuVersion(c *fiber.Ctx) error {
+	body := new(publishMenuVersion)
+	if err := c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.PublishMenuVersion(c.Context(), domain.MenuVersion{
+		PublishAt: body.PublishAt,
+		Note:      body.Note,
+	})
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, menuVersionResponse{
+		ID: result,
+	})
+}
+
+// RollbackMenuVersion godoc
+// @Summary Roll back to a menu version
+// @Description Publish an earlier version again as a new version, effective immediately
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Menu version ID"
+// @Param version body rollbackMenuVersion true "Rollback"
+// @Success 201 {object} middleware.SuccessResponse{data=menuVersionResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/menu-versions/{id}/rollback [post]
+func (s *Handler) RollbackMenuVersion(c *fiber.Ctx) error {
+	versionID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	body := new(rollbackMenuVersion)
+	if err = c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err = s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.RollbackMenuVersion(c.Context(), versionID, body.Note)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseCreated(c, menuVersionResponse{
+		ID: result,
+	})
+}
+
+// CancelScheduledMenuVersion godoc
+// @Summary Cancel a scheduled menu version
+// @Description Delete a version that has not been published yet, together with the prices it scheduled
+// @Tags Menu
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Menu version ID"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /staff/menu-versions/{id} [delete]
+func (s *Handler) CancelScheduledMenuVersion(c *fiber.Ctx) error {
+	versionID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	err = s.useCase.CancelScheduledMenuVersion(c.Context(), versionID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
+// ListProductRecommendations godoc
+// @Summary Frequently ordered together
+// @Description Menu items often ordered together with the given item, best match first. Filter by categoryID to upsell e.g. drinks or desserts
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localizedName fields, e.g. zh-TW,zh;q=0.9"
+// @Param id path string true "Product ID"
+// @Param categoryID query []string false "Only recommend items in these categories"
+// @Param limit query int false "Maximum number of items, default 5"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.Product}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /customer/{id}/recommendations [get]
+func (s *Handler) ListProductRecommendations(c *fiber.Ctx) error {
+	productID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	payload, err := s.parseRecommendationQuery(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	result, err := s.useCase.ListProductRecommendations(c.Context(), productID, payload, isPublishedMenu(c))
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// ListSessionRecommendations godoc
+// @Summary Recommendations for the current session
+// @Description Menu items often ordered together with what the session has already ordered and the items in productID (e.g. the cart), best match first
+// @Tags Menu
+// @Accept json
+// @Produce json
+// @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localizedName fields, e.g. zh-TW,zh;q=0.9"
+// @Param productID query []string false "Items not ordered yet, such as the cart"
+// @Param categoryID query []string false "Only recommend items in these categories"
+// @Param limit query int false "Maximum number of items, default 5"
+// @Success 200 {object} middleware.SuccessResponse{data=[]domain.Product}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /customer/recommendations [get]
+func (s *Handler) ListSessionRecommendations(c *fiber.Ctx) error {
+	sessionID, err := utils.PareStringToUUID(c.Get("X-Session-Id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeSystem, exceptions.ErrFailedToReadSession.Error()))
+	}
+
+	payload, err := s.parseRecommendationQuery(c)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	result, err := s.useCase.ListSessionRecommendations(c.Context(), sessionID, payload, isPublishedMenu(c))
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+func (s *Handler) parseRecommendationQuery(c *fiber.Ctx) (domain.Recommendation, error) {
+	query := new(recommendationQuery)
+	if err := c.QueryParser(query); err != nil {
+		return domain.Recommendation{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(query); err != nil {
+		return domain.Recommendation{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return domain.Recommendation{
+		ProductIDs: utils.FilterOutZero(query.ProductID),
+		CategoryID: utils.FilterOutZero(query.CategoryID),
+		Limit:      query.Limit,
+		Locales:    middleware.RequestLocales(c),
+	}, nil
+}
+
+func (s *Handler) parsePromotionBody(c *fiber.Ctx) (domain.Promotion, error) {
+	body := new(promotion)
+	if err := c.BodyParser(body); err != nil {
+		return domain.Promotion{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return domain.Promotion{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	productID, err := strPtrToInt64Ptr(body.ProductID)
+	if err != nil {
+		return domain.Promotion{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	categoryID, err := strPtrToInt64Ptr(body.CategoryID)
+	if err != nil {
+		return domain.Promotion{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return domain.Promotion{
+		Name:          body.Name,
+		NameEN:        body.NameEN,
+		PromotionType: body.PromotionType,
+		DiscountValue: body.DiscountValue,
+		BuyQuantity:   body.BuyQuantity,
+		FreeQuantity:  body.FreeQuantity,
+		ProductID:     productID,
+		CategoryID:    categoryID,
+		StartsAt:      body.StartsAt,
+		EndsAt:        body.EndsAt,
+		DaysOfWeek:    body.DaysOfWeek,
+		StartTime:     body.StartTime,
+		EndTime:       body.EndTime,
+		IsActive:      body.IsActive,
+	}, nil
+}
+
+func strPtrToInt64Ptr(value *string) (*int64, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	result, err := utils.StrToInt64(*value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// parseOptionID returns 0 for an entry sent without an ID, which the repository adds as a new row.
+func parseOptionID(value *string) (int64, error) {
+	id, err := strPtrToInt64Ptr(value)
+	if err != nil || id == nil {
+		return 0, err
+	}
+	return *id, nil
+}
@@ -1,10 +1,195 @@
 package http
 
 type SearchMenu struct {
-	PageNumber  int64   `query:"pageNumber"`
-	PageSize    int64   `query:"pageSize"`
-	Search      string  `query:"search" validate:"omitempty,no_special_char,max=255"`
-	CategoryID  []int64 `query:"categoryID"`
-	OrderBy     string  `query:"orderBy" validate:"omitempty,oneof=id name price"`
-	OrderByType string  `query:"orderType" validate:"omitempty,oneof=asc desc"`
+	PageNumber      int64    `query:"pageNumber"`
+	PageSize        int64    `query:"pageSize"`
+	Search          string   `query:"search" validate:"omitempty,no_special_char,max=255"`
+	CategoryID      []int64  `query:"categoryID"`
+	DietaryTag      []string `query:"dietaryTag" validate:"omitempty,dive,alpha,max=20"`
+	ExcludeAllergen []string `query:"excludeAllergen" validate:"omitempty,dive,alpha,max=20"`
+	OrderBy         string   `query:"orderBy" validate:"omitempty,oneof=id name price"`
+	OrderByType     string   `query:"orderType" validate:"omitempty,oneof=asc desc"`
+}
+
+type recommendationQuery struct {
+	ProductID  []int64 `query:"productID"`
+	CategoryID []int64 `query:"categoryID"`
+	Limit      int32   `query:"limit" validate:"omitempty,gte=1,lte=20"`
+}
+
+type Product struct {
+	Name        string  `json:"name" validate:"required,no_special_char,max=255" example:"ข้าวมันไก่"`
+	NameEN      string  `json:"nameEN" validate:"required,no_special_char,max=255" example:"Chicken rice"`
+	CategoryID  string  `json:"categoryID" validate:"required" example:"1921143886227443712"`
+	Description *string `json:"description" validate:"omitempty,max=1000" example:"lorem ipsum"`
+	Price       float64 `json:"price" validate:"gte=0,lte=99999999.99" example:"100"`
+	IsAvailable bool    `json:"isAvailable" example:"true"`
+	ImageURL    *string `json:"imageURL" validate:"omitempty,url" example:"https://example.com/image.jpg"`
+}
+
+type updateProductAvailability struct {
+	IsAvailable *bool `json:"isAvailable" validate:"required" example:"true"`
+}
+
+type updateProductStock struct {
+	StockQuantity *int32 `json:"stockQuantity" validate:"omitempty,gte=0" example:"20"`
+}
+
+type createCategory struct {
+	Name      string  `json:"name" validate:"required,no_special_char,max=100" example:"ขนม"`
+	NameEN    string  `json:"nameEN" validate:"required,no_special_char,max=100" example:"Dessert"`
+	Icon      *string `json:"icon" validate:"omitempty,no_special_char,max=50" example:"cake-slice"`
+	Code      *string `json:"code" validate:"omitempty,no_special_char,max=50" example:"DESSERT"`
+	IsVisible bool    `json:"isVisible" example:"true"`
+}
+
+type updateCategory struct {
+	Name   string  `json:"name" validate:"required,no_special_char,max=100" example:"ขนม"`
+	NameEN string  `json:"nameEN" validate:"required,no_special_char,max=100" example:"Dessert"`
+	Icon   *string `json:"icon" validate:"omitempty,no_special_char,max=50" example:"cake-slice"`
+}
+
+type updateCategorySortOrder struct {
+	SortOrder int32 `json:"sortOrder" validate:"required,gte=1" example:"1"`
+}
+
+type updateVariants struct {
+	Variants []variant `json:"variants" validate:"dive"`
+}
+
+// variant, comboSlot, modifierGroup and modifier keep their ID when it is sent back, entries without an ID are
+// added and entries left out are removed
+type variant struct {
+	ID          *string `json:"id" validate:"omitempty,gt=0" example:"1921822053405560834"`
+	Name        string  `json:"name" validate:"required,no_special_char,max=50" example:"ใหญ่"`
+	NameEN      string  `json:"nameEN" validate:"required,no_special_char,max=50" example:"Large"`
+	Price       float64 `json:"price" validate:"gte=0,lte=99999999.99" example:"65"`
+	IsAvailable bool    `json:"isAvailable" example:"true"`
+}
+
+type updateComboSlots struct {
+	Slots []comboSlot `json:"slots" validate:"dive"`
+}
+
+type comboSlot struct {
+	ID      *string       `json:"id" validate:"omitempty,gt=0" example:"1921822053405560835"`
+	Name    string        `json:"name" validate:"required,no_special_char,max=100" example:"เครื่องดื่ม"`
+	NameEN  string        `json:"nameEN" validate:"required,no_special_char,max=100" example:"Drink"`
+	Options []comboOption `json:"options" validate:"required,gt=0,dive"`
+}
+
+type comboOption struct {
+	ProductID  string  `json:"productID" validate:"required" example:"1921822053405560836"`
+	PriceDelta float64 `json:"priceDelta" validate:"gte=0,lte=99999999.99" example:"0"`
+}
+
+type updateModifierGroups struct {
+	Groups []modifierGroup `json:"groups" validate:"dive"`
+}
+
+type modifierGroup struct {
+	ID            *string    `json:"id" validate:"omitempty,gt=0" example:"1921822053405560832"`
+	Name          string     `json:"name" validate:"required,no_special_char,max=100" example:"ระดับความเผ็ด"`
+	NameEN        string     `json:"nameEN" validate:"required,no_special_char,max=100" example:"Spice level"`
+	MinSelections int32      `json:"minSelections" validate:"gte=0" example:"1"`
+	MaxSelections int32      `json:"maxSelections" validate:"gte=1" example:"1"`
+	Modifiers     []modifier `json:"modifiers" validate:"required,gt=0,dive"`
+}
+
+type modifier struct {
+	ID          *string `json:"id" validate:"omitempty,gt=0" example:"1921822053405560833"`
+	Name        string  `json:"name" validate:"required,no_special_char,max=100" example:"ไม่เผ็ด"`
+	NameEN      string  `json:"nameEN" validate:"required,no_special_char,max=100" example:"No chili"`
+	PriceDelta  float64 `json:"priceDelta" validate:"gte=0,lte=99999999.99" example:"0"`
+	IsAvailable bool    `json:"isAvailable" example:"true"`
+}
+
+type updateSchedules struct {
+	Schedules []schedule `json:"schedules" validate:"dive"`
+}
+
+type schedule struct {
+	DayOfWeek *int16 `json:"dayOfWeek" validate:"required,gte=0,lte=6" example:"1"`
+	StartTime string `json:"startTime" validate:"required,datetime=15:04" example:"06:00"`
+	EndTime   string `json:"endTime" validate:"required,datetime=15:04" example:"11:00"`
+}
+
+type ingredient struct {
+	Name              string  `json:"name" validate:"required,no_special_char,max=100" example:"ไข่ไก่"`
+	NameEN            string  `json:"nameEN" validate:"required,no_special_char,max=100" example:"Egg"`
+	Unit              string  `json:"unit" validate:"required,no_special_char,max=20" example:"ฟอง"`
+	LowStockThreshold float64 `json:"lowStockThreshold" validate:"gte=0,lte=9999999999.99" example:"30"`
+}
+
+type createIngredientMovement struct {
+	Quantity float64 `json:"quantity" validate:"required,gte=-9999999999.99,lte=9999999999.99" example:"60"`
+	Reason   string  `json:"reason" validate:"required,oneof=RESTOCK ADJUST" example:"RESTOCK"`
+	Note     *string `json:"note" validate:"omitempty,max=255" example:"รับของจากตลาด"`
+}
+
+type updateProductIngredients struct {
+	Ingredients []productIngredient `json:"ingredients" validate:"dive"`
+}
+
+type productIngredient struct {
+	IngredientID string  `json:"ingredientID" validate:"required" example:"1921822053405560836"`
+	Quantity     float64 `json:"quantity" validate:"gt=0,lte=9999999999.99" example:"1"`
+}
+
+type updateProductSearchKeywords struct {
+	Keywords []string `json:"keywords" validate:"dive,required,no_special_char,max=100" example:"กะเพรา,kaprao"`
+}
+
+type createSearchAlias struct {
+	Alias string `json:"alias" validate:"required,no_special_char,max=100" example:"krapow"`
+	Term  string `json:"term" validate:"required,no_special_char,max=100" example:"kaphrao"`
+}
+
+type updateProductDietaryTags struct {
+	Codes []string `json:"codes" validate:"dive,required,alpha,max=20" example:"PEANUT,VEGETARIAN"`
+}
+
+type translationEntity struct {
+	EntityType string `params:"entityType" validate:"required,oneof=product category variant modifier_group modifier combo_slot order_status"`
+}
+
+type updateTranslations struct {
+	Translations []translation `json:"translations" validate:"dive"`
+}
+
+type translation struct {
+	Field  string `json:"field" validate:"required,oneof=name description" example:"name"`
+	Locale string `json:"locale" validate:"required,bcp47_language_tag,max=10" example:"zh"`
+	Value  string `json:"value" validate:"required,max=1000" example:"海南鸡饭"`
+}
+
+type scheduleProductPrice struct {
+	Price         float64 `json:"price" validate:"gte=0,lte=99999999.99" example:"69"`
+	EffectiveFrom string  `json:"effectiveFrom" validate:"required,datetime=2006-01-02T15:04:05Z07:00" example:"2025-06-02T00:00:00+07:00"`
+}
+
+type publishMenuVersion struct {
+	PublishAt string  `json:"publishAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2025-06-02T00:00:00+07:00"`
+	Note      *string `json:"note" validate:"omitempty,max=255" example:"Rainy season menu"`
+}
+
+type rollbackMenuVersion struct {
+	Note *string `json:"note" validate:"omitempty,max=255" example:"Back to the dry season menu"`
+}
+
+type promotion struct {
+	Name          string  `json:"name" validate:"required,max=255" example:"เครื่องดื่มลด 20%"`
+	NameEN        string  `json:"nameEN" validate:"required,max=255" example:"Drinks 20% off"`
+	PromotionType string  `json:"promotionType" validate:"required,oneof=PERCENTAGE FIXED_AMOUNT BUY_X_GET_Y" example:"PERCENTAGE"`
+	DiscountValue float64 `json:"discountValue" validate:"gte=0,lte=99999999.99" example:"20"`
+	BuyQuantity   *int32  `json:"buyQuantity" validate:"omitempty,gte=1,lte=100" example:"2"`
+	FreeQuantity  *int32  `json:"freeQuantity" validate:"omitempty,gte=1,lte=100" example:"1"`
+	ProductID     *string `json:"productID" validate:"omitempty,number" example:"1921822053405560832"`
+	CategoryID    *string `json:"categoryID" validate:"omitempty,number" example:"1921143886227443712"`
+	StartsAt      *string `json:"startsAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2025-06-01T00:00:00+07:00"`
+	EndsAt        *string `json:"endsAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00" example:"2025-07-01T00:00:00+07:00"`
+	DaysOfWeek    []int16 `json:"daysOfWeek" validate:"omitempty,unique,dive,gte=0,lte=6" example:"1,2,3,4,5"`
+	StartTime     *string `json:"startTime" validate:"omitempty,datetime=15:04" example:"15:00"`
+	EndTime       *string `json:"endTime" validate:"omitempty,datetime=15:04" example:"17:00"`
+	IsActive      bool    `json:"isActive" example:"true"`
 }
@@ -0,0 +1,29 @@
+package http
+
+type createProductResponse struct {
+	ID int64 `json:"id,string" example:"1921144250070732800"`
+}
+
+type createCategoryResponse struct {
+	ID int64 `json:"id,string" example:"1921144250070732800"`
+}
+
+type createIngredientResponse struct {
+	ID int64 `json:"id,string" example:"1921822053405560836"`
+}
+
+type createSearchAliasResponse struct {
+	ID int64 `json:"id,string" example:"1921822053405560836"`
+}
+
+type scheduleProductPriceResponse struct {
+	ID int64 `json:"id,string" example:"1921822053405560837"`
+}
+
+type menuVersionResponse struct {
+	ID int64 `json:"id,string" example:"1921822053405560838"`
+}
+
+type createPromotionResponse struct {
+	ID int64 `json:"id,string" example:"1921822053405560838"`
+}
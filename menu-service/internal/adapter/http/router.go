@@ -1,6 +1,7 @@
 package http
 
 import (
+	"food-story/menu-service/internal/adapter/storage"
 	"food-story/menu-service/internal/usecase"
 	"food-story/pkg/exceptions"
 	"food-story/pkg/middleware"
@@ -15,6 +16,7 @@ type Handler struct {
 	useCase   usecase.Usecase
 	validator *middleware.CustomValidator
 	config    config.Config
+	auth      middleware.AuthInterface
 }
 
 func NewHTTPHandler(
@@ -22,13 +24,14 @@ func NewHTTPHandler(
 	useCase usecase.Usecase,
 	validator *middleware.CustomValidator,
 	config config.Config,
-
+	auth middleware.AuthInterface,
 ) *Handler {
 	handler := &Handler{
 		router,
 		useCase,
 		validator,
 		config,
+		auth,
 	}
 	handler.setupRoutes()
 	return handler
@@ -37,9 +40,16 @@ func NewHTTPHandler(
 func (s *Handler) setupRoutes() {
 	group := s.router.Group("/")
 
-	groupCustomer := group.Group("/customer", s.handleSessionID)
+	// uploaded images are stored under a unique name, so they can be cached indefinitely
+	group.Static(storage.PublicPath, s.config.UploadDir, fiber.Static{
+		MaxAge: 365 * 24 * 60 * 60,
+	})
+
+	groupCustomer := group.Group("/customer", s.handleSessionID, s.usePublishedMenu)
 	groupCustomer.Get("", s.SearchMenu)
 	groupCustomer.Get("/:id<int>", s.GetProductByID)
+	groupCustomer.Get("/:id<int>/recommendations", s.ListProductRecommendations)
+	groupCustomer.Get("/recommendations", s.ListSessionRecommendations)
 	groupCustomer.Get("/category", s.CategoryList)
 	groupCustomer.Get("/session/current", s.SessionCurrent)
 
@@ -48,6 +58,61 @@ func (s *Handler) setupRoutes() {
 	groupStaff.Get("/session-extension", s.ListProductTimeExtension)
 	groupStaff.Get("/:id<int>", s.GetProductByID)
 	groupStaff.Get("/category", s.CategoryList)
+
+	groupStaffAuth := groupStaff.Group("", s.auth.JWTMiddleware(), s.auth.RequireRole([]string{"MANAGER", "CASHIER"}))
+	groupStaffAuth.Post("", s.CreateProduct)
+	groupStaffAuth.Put("/:id<int>", s.UpdateProduct)
+	groupStaffAuth.Patch("/:id<int>/availability", s.UpdateProductAvailability)
+	groupStaffAuth.Patch("/:id<int>/stock", s.UpdateProductStock)
+	groupStaffAuth.Post("/:id<int>/image", s.UploadProductImage)
+	groupStaffAuth.Patch("/:id<int>/hide", s.HideProduct)
+	groupStaffAuth.Patch("/:id<int>/show", s.ShowProduct)
+	groupStaffAuth.Put("/:id<int>/variants", s.UpdateVariants)
+	groupStaffAuth.Put("/:id<int>/modifiers", s.UpdateModifierGroups)
+	groupStaffAuth.Put("/:id<int>/combo", s.UpdateComboSlots)
+	groupStaffAuth.Get("/:id<int>/schedules", s.ListProductSchedules)
+	groupStaffAuth.Put("/:id<int>/schedules", s.UpdateProductSchedules)
+	groupStaffAuth.Get("/:id<int>/ingredients", s.ListProductIngredients)
+	groupStaffAuth.Put("/:id<int>/ingredients", s.UpdateProductIngredients)
+	groupStaffAuth.Get("/:id<int>/search-keywords", s.ListProductSearchKeywords)
+	groupStaffAuth.Put("/:id<int>/search-keywords", s.UpdateProductSearchKeywords)
+	groupStaffAuth.Put("/:id<int>/dietary-tags", s.UpdateProductDietaryTags)
+	groupStaffAuth.Get("/:id<int>/prices", s.ListProductPrices)
+	groupStaffAuth.Post("/:id<int>/prices", s.ScheduleProductPrice)
+	groupStaffAuth.Delete("/:id<int>/prices/:priceID<int>", s.CancelScheduledProductPrice)
+
+	groupStaffAuth.Get("/category/manage", s.ListAllCategory)
+	groupStaffAuth.Post("/category", s.CreateCategory)
+	groupStaffAuth.Put("/category/:id<int>", s.UpdateCategory)
+	groupStaffAuth.Patch("/category/:id<int>/sort-order", s.UpdateCategorySortOrder)
+	groupStaffAuth.Patch("/category/:id<int>/hide", s.HideCategory)
+	groupStaffAuth.Patch("/category/:id<int>/show", s.ShowCategory)
+	groupStaffAuth.Get("/category/:id<int>/schedules", s.ListCategorySchedules)
+	groupStaffAuth.Put("/category/:id<int>/schedules", s.UpdateCategorySchedules)
+
+	groupStaffAuth.Get("/ingredients", s.ListIngredients)
+	groupStaffAuth.Get("/ingredients/low-stock", s.ListLowStockIngredients)
+	groupStaffAuth.Post("/ingredients", s.CreateIngredient)
+	groupStaffAuth.Put("/ingredients/:id<int>", s.UpdateIngredient)
+	groupStaffAuth.Post("/ingredients/:id<int>/movements", s.CreateIngredientMovement)
+
+	groupStaffAuth.Get("/dietary-tags", s.ListDietaryTags)
+
+	groupStaffAuth.Get("/translations/:entityType/:id<int>", s.ListTranslations)
+	groupStaffAuth.Put("/translations/:entityType/:id<int>", s.UpdateTranslations)
+
+	groupStaffAuth.Get("/promotions", s.ListPromotions)
+	groupStaffAuth.Post("/promotions", s.CreatePromotion)
+	groupStaffAuth.Put("/promotions/:id<int>", s.UpdatePromotion)
+
+	groupStaffAuth.Get("/menu-versions", s.ListMenuVersions)
+	groupStaffAuth.Post("/menu-versions", s.PublishMenuVersion)
+	groupStaffAuth.Post("/menu-versions/:id<int>/rollback", s.RollbackMenuVersion)
+	groupStaffAuth.Delete("/menu-versions/:id<int>", s.CancelScheduledMenuVersion)
+
+	groupStaffAuth.Get("/search-aliases", s.ListSearchAliases)
+	groupStaffAuth.Post("/search-aliases", s.CreateSearchAlias)
+	groupStaffAuth.Delete("/search-aliases/:id<int>", s.DeleteSearchAlias)
 }
 
 func (s *Handler) handleSessionID(c *fiber.Ctx) error {
@@ -61,10 +126,24 @@ func (s *Handler) handleSessionID(c *fiber.Ctx) error {
 		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeSystem, exceptions.ErrFailedToReadSession.Error()))
 	}
 
-	err = s.useCase.IsSessionValid(sessionID)
+	locale, err := s.useCase.GetSessionLocale(sessionID)
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
 
+	c.Locals(middleware.LocalsSessionLocale, locale)
+	return c.Next()
+}
+
+const localsPublishedMenu = "publishedMenu"
+
+// usePublishedMenu makes customer reads serve the published menu version, staff keep reading the draft.
+func (s *Handler) usePublishedMenu(c *fiber.Ctx) error {
+	c.Locals(localsPublishedMenu, true)
 	return c.Next()
 }
+
+func isPublishedMenu(c *fiber.Ctx) bool {
+	published, _ := c.Locals(localsPublishedMenu).(bool)
+	return published
+}
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"context"
+	"food-story/menu-service/internal/usecase"
+	"log/slog"
+	"time"
+)
+
+// RunRecommendationRefresh recomputes "frequently ordered together" statistics on start and then every
+// interval until ctx is cancelled. A failed run is logged and retried on the next tick.
+func RunRecommendationRefresh(ctx context.Context, useCase usecase.Usecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := useCase.RefreshRecommendations(ctx)
+		if err != nil {
+			slog.Error("failed to refresh recommendations", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -2,12 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"food-story/menu-service/internal/domain"
 	"food-story/pkg/exceptions"
 	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
 )
 
-func (i *Implement) ListCategory(ctx context.Context) (result []*domain.Category, err error) {
+// ListCategory returns the visible categories, taken from the published snapshot when menuVersionID is set.
+// Visibility is always live so staff can still hide a category without publishing.
+func (i *Implement) ListCategory(ctx context.Context, menuVersionID int64) (result []*domain.Category, err error) {
 
 	data, err := i.repository.ListCategory(ctx)
 	if err != nil {
@@ -27,5 +32,123 @@ func (i *Implement) ListCategory(ctx context.Context) (result []*domain.Category
 			Icon:   utils.PgTextToStringPtr(v.Icon),
 		}
 	}
+
+	if menuVersionID != 0 {
+		return i.applyPublishedCategories(ctx, menuVersionID, result)
+	}
+	return result, nil
+}
+
+func (i *Implement) applyPublishedCategories(ctx context.Context, menuVersionID int64, categories []*domain.Category) ([]*domain.Category, error) {
+	published, err := i.ListPublishedCategories(ctx, menuVersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedByID := make(map[int64]*domain.Category, len(published))
+	for _, category := range published {
+		publishedByID[category.ID] = category
+	}
+
+	result := make([]*domain.Category, 0, len(categories))
+	for _, category := range categories {
+		if snapshot, ok := publishedByID[category.ID]; ok {
+			result = append(result, snapshot)
+		}
+	}
+	return result, nil
+}
+
+func (i *Implement) ListAllCategory(ctx context.Context) (result []*domain.CategoryDetail, err error) {
+	data, err := i.repository.ListAllCategory(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch category", err)
+	}
+
+	result = make([]*domain.CategoryDetail, len(data))
+	for index, v := range data {
+		result[index] = &domain.CategoryDetail{
+			ID:        v.ID,
+			Name:      v.Name,
+			NameEn:    v.NameEN,
+			Icon:      utils.PgTextToStringPtr(v.Icon),
+			Code:      utils.PgTextToStringPtr(v.Code),
+			SortOrder: v.SortOrder,
+			IsVisible: v.IsVisible,
+		}
+	}
 	return result, nil
 }
+
+func (i *Implement) CreateCategory(ctx context.Context, payload domain.CategoryDetail) (int64, error) {
+	id, err := i.repository.CreateCategory(ctx, database.CreateCategoryParams{
+		ID:        i.snowflakeID.Generate(),
+		Name:      payload.Name,
+		NameEn:    payload.NameEn,
+		IconName:  utils.StringPtrToPgText(payload.Icon),
+		IsVisible: payload.IsVisible,
+		Code:      utils.StringPtrToPgText(payload.Code),
+	})
+	if err != nil {
+		return 0, mapCategoryWriteError(err, "failed to create category")
+	}
+
+	return id, nil
+}
+
+func (i *Implement) UpdateCategory(ctx context.Context, payload domain.CategoryDetail) error {
+	rowsAffected, err := i.repository.UpdateCategory(ctx, database.UpdateCategoryParams{
+		ID:       payload.ID,
+		Name:     payload.Name,
+		NameEn:   payload.NameEn,
+		IconName: utils.StringPtrToPgText(payload.Icon),
+	})
+	if err != nil {
+		return mapCategoryWriteError(err, "failed to update category")
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeCategoryNotFound, payload.ID)
+	}
+
+	return nil
+}
+
+func (i *Implement) UpdateCategoryVisibility(ctx context.Context, id int64, isVisible bool) error {
+	rowsAffected, err := i.repository.UpdateCategoryVisibility(ctx, database.UpdateCategoryVisibilityParams{
+		ID:        id,
+		IsVisible: isVisible,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update category visibility", err)
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeCategoryNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) UpdateCategorySortOrder(ctx context.Context, id int64, sortOrder int32) error {
+	err := i.repository.TXUpdateCategorySortOrder(ctx, database.TXUpdateCategorySortOrderParams{
+		ID:        id,
+		SortOrder: sortOrder,
+	})
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return exceptions.ErrorIDNotFound(exceptions.CodeCategoryNotFound, id)
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update category sort order", err)
+	}
+
+	return nil
+}
+
+func mapCategoryWriteError(err error, message string) error {
+	if field, ok := utils.PgUniqueViolationField(err); ok {
+		return exceptions.Error(exceptions.CodeConflict, fmt.Sprintf("category %s already exists", field))
+	}
+
+	return exceptions.Errorf(exceptions.CodeRepository, message, err)
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListComboSlots(ctx context.Context, productIDs []int64) (map[int64][]*domain.ComboSlot, error) {
+	result := make(map[int64][]*domain.ComboSlot)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	slots, err := i.repository.ListComboSlotsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch combo slots", err)
+	}
+
+	if len(slots) == 0 {
+		return result, nil
+	}
+
+	slotIDs := make([]int64, len(slots))
+	for index, slot := range slots {
+		slotIDs[index] = slot.ID
+	}
+
+	options, err := i.repository.ListComboSlotOptionsBySlotIDs(ctx, slotIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch combo slot options", err)
+	}
+
+	optionMap := make(map[int64][]*domain.ComboOption)
+	for _, option := range options {
+		optionMap[option.SlotID] = append(optionMap[option.SlotID], &domain.ComboOption{
+			ProductID:   option.ProductID,
+			Name:        option.Name,
+			NameEN:      option.NameEN,
+			ImageURL:    utils.PgTextToStringPtr(option.ImageURL),
+			PriceDelta:  utils.PgNumericToFloat64(option.PriceDelta),
+			IsAvailable: option.IsAvailable,
+		})
+	}
+
+	for _, slot := range slots {
+		slotOptions := optionMap[slot.ID]
+		if slotOptions == nil {
+			slotOptions = []*domain.ComboOption{}
+		}
+		result[slot.ComboProductID] = append(result[slot.ComboProductID], &domain.ComboSlot{
+			ID:      slot.ID,
+			Name:    slot.Name,
+			NameEN:  slot.NameEN,
+			Options: slotOptions,
+		})
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceComboSlots(ctx context.Context, productID int64, slots []*domain.ComboSlot) error {
+	params := database.TXReplaceComboSlotsParams{
+		ComboProductID: productID,
+		ComboSlots:     make([]database.TXComboSlotParams, len(slots)),
+	}
+
+	for index, slot := range slots {
+		slotID, isNew := i.optionID(slot.ID)
+		slotParams := database.TXComboSlotParams{
+			CreateComboSlot: database.CreateComboSlotParams{
+				ID:             slotID,
+				ComboProductID: productID,
+				Name:           slot.Name,
+				NameEn:         slot.NameEN,
+				SortOrder:      int32(index + 1),
+			},
+			IsNew:                  isNew,
+			CreateComboSlotOptions: make([]database.CreateComboSlotOptionParams, len(slot.Options)),
+		}
+
+		for optionIndex, option := range slot.Options {
+			slotParams.CreateComboSlotOptions[optionIndex] = database.CreateComboSlotOptionParams{
+				ProductID:  option.ProductID,
+				PriceDelta: utils.Float64ToPgNumeric(option.PriceDelta),
+				SortOrder:  int32(optionIndex + 1),
+			}
+		}
+
+		params.ComboSlots[index] = slotParams
+	}
+
+	err := i.repository.TXReplaceComboSlots(ctx, params)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrMenuOptionNotFound) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+		if errors.Is(utils.MapPgErr(err), exceptions.ErrForeignKeyViolation) {
+			return exceptions.Error(exceptions.CodeBusiness, "combo option product not found")
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update combo slots", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) attachComboSlots(ctx context.Context, products []*domain.Product) error {
+	productIDs := make([]int64, 0, len(products))
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		productIDs = append(productIDs, product.ID)
+	}
+
+	slots, err := i.ListComboSlots(ctx, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		product.ComboSlots = slots[product.ID]
+		if product.ComboSlots == nil {
+			product.ComboSlots = []*domain.ComboSlot{}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListDietaryTags(ctx context.Context) ([]*domain.DietaryTag, error) {
+	data, err := i.repository.ListDietaryTags(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch dietary tags", err)
+	}
+
+	result := make([]*domain.DietaryTag, len(data))
+	for index, row := range data {
+		result[index] = &domain.DietaryTag{
+			Code:       row.Code,
+			Name:       row.Name,
+			NameEN:     row.NameEN,
+			IsAllergen: row.IsAllergen,
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceProductDietaryTags(ctx context.Context, productID int64, codes []string) error {
+	err := i.repository.TXReplaceProductDietaryTags(ctx, database.CreateProductDietaryTagsParams{
+		ProductID: productID,
+		Codes:     codes,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product dietary tags", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) attachDietaryTags(ctx context.Context, products []*domain.Product) error {
+	productIDs := make([]int64, 0, len(products))
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		productIDs = append(productIDs, product.ID)
+	}
+
+	tagMap := make(map[int64][]*domain.DietaryTag)
+	if len(productIDs) > 0 {
+		tags, err := i.repository.ListDietaryTagsByProductIDs(ctx, productIDs)
+		if err != nil {
+			return exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product dietary tags", err)
+		}
+
+		for _, tag := range tags {
+			tagMap[tag.ProductID] = append(tagMap[tag.ProductID], &domain.DietaryTag{
+				Code:       tag.Code,
+				Name:       tag.Name,
+				NameEN:     tag.NameEN,
+				IsAllergen: tag.IsAllergen,
+			})
+		}
+	}
+
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		product.DietaryTags = tagMap[product.ID]
+		if product.DietaryTags == nil {
+			product.DietaryTags = []*domain.DietaryTag{}
+		}
+	}
+
+	return nil
+}
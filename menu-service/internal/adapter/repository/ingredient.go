@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListIngredients(ctx context.Context) ([]*domain.Ingredient, error) {
+	data, err := i.repository.ListIngredients(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch ingredients", err)
+	}
+
+	result := make([]*domain.Ingredient, len(data))
+	for index, row := range data {
+		result[index] = &domain.Ingredient{
+			ID:                row.ID,
+			Name:              row.Name,
+			NameEN:            row.NameEN,
+			Unit:              row.Unit,
+			StockQuantity:     utils.PgNumericToFloat64(row.StockQuantity),
+			LowStockThreshold: utils.PgNumericToFloat64(row.LowStockThreshold),
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ListLowStockIngredients(ctx context.Context) ([]*domain.Ingredient, error) {
+	data, err := i.repository.ListLowStockIngredients(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch low stock ingredients", err)
+	}
+
+	result := make([]*domain.Ingredient, len(data))
+	for index, row := range data {
+		result[index] = &domain.Ingredient{
+			ID:                row.ID,
+			Name:              row.Name,
+			NameEN:            row.NameEN,
+			Unit:              row.Unit,
+			StockQuantity:     utils.PgNumericToFloat64(row.StockQuantity),
+			LowStockThreshold: utils.PgNumericToFloat64(row.LowStockThreshold),
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) CreateIngredient(ctx context.Context, payload domain.Ingredient) (int64, error) {
+	id, err := i.repository.CreateIngredient(ctx, database.CreateIngredientParams{
+		ID:                i.snowflakeID.Generate(),
+		Name:              payload.Name,
+		NameEn:            payload.NameEN,
+		Unit:              payload.Unit,
+		LowStockThreshold: utils.Float64ToPgNumeric(payload.LowStockThreshold),
+	})
+	if err != nil {
+		return 0, mapIngredientWriteError(err, "failed to create ingredient")
+	}
+
+	return id, nil
+}
+
+func (i *Implement) UpdateIngredient(ctx context.Context, payload domain.Ingredient) error {
+	rowsAffected, err := i.repository.UpdateIngredient(ctx, database.UpdateIngredientParams{
+		ID:                payload.ID,
+		Name:              payload.Name,
+		NameEn:            payload.NameEN,
+		Unit:              payload.Unit,
+		LowStockThreshold: utils.Float64ToPgNumeric(payload.LowStockThreshold),
+	})
+	if err != nil {
+		return mapIngredientWriteError(err, "failed to update ingredient")
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeIngredientNotFound, payload.ID)
+	}
+
+	return nil
+}
+
+func (i *Implement) IsIngredientExists(ctx context.Context, id int64) error {
+	isExists, err := i.repository.IsIngredientExists(ctx, id)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to check ingredient exists", err)
+	}
+
+	if !isExists {
+		return exceptions.ErrorIDNotFound(exceptions.CodeIngredientNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) CreateIngredientMovement(ctx context.Context, payload domain.IngredientMovement) error {
+	err := i.repository.TXCreateIngredientMovement(ctx, database.CreateIngredientMovementParams{
+		ID:           i.snowflakeID.Generate(),
+		IngredientID: payload.IngredientID,
+		Quantity:     utils.Float64ToPgNumeric(payload.Quantity),
+		Reason:       payload.Reason,
+		Note:         utils.StringPtrToPgText(payload.Note),
+	})
+	if err != nil {
+		if errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to create ingredient movement", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) ListProductIngredients(ctx context.Context, productID int64) ([]*domain.ProductIngredient, error) {
+	data, err := i.repository.ListProductIngredients(ctx, productID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product ingredients", err)
+	}
+
+	result := make([]*domain.ProductIngredient, len(data))
+	for index, row := range data {
+		result[index] = &domain.ProductIngredient{
+			IngredientID: row.IngredientID,
+			Name:         row.Name,
+			NameEN:       row.NameEN,
+			Unit:         row.Unit,
+			Quantity:     utils.PgNumericToFloat64(row.Quantity),
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceProductIngredients(ctx context.Context, productID int64, ingredients []*domain.ProductIngredient) error {
+	params := database.TXReplaceProductIngredientsParams{
+		ProductID:                productID,
+		CreateProductIngredients: make([]database.CreateProductIngredientParams, len(ingredients)),
+	}
+
+	for index, ingredient := range ingredients {
+		params.CreateProductIngredients[index] = database.CreateProductIngredientParams{
+			IngredientID: ingredient.IngredientID,
+			Quantity:     utils.Float64ToPgNumeric(ingredient.Quantity),
+		}
+	}
+
+	err := i.repository.TXReplaceProductIngredients(ctx, params)
+	if err != nil {
+		if errors.Is(utils.MapPgErr(err), exceptions.ErrForeignKeyViolation) {
+			return exceptions.Error(exceptions.CodeBusiness, "ingredient not found")
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product ingredients", err)
+	}
+
+	return nil
+}
+
+func mapIngredientWriteError(err error, message string) error {
+	if field, ok := utils.PgUniqueViolationField(err); ok {
+		return exceptions.Error(exceptions.CodeConflict, fmt.Sprintf("ingredient %s already exists", field))
+	}
+
+	return exceptions.Errorf(exceptions.CodeRepository, message, err)
+}
@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (i *Implement) ListMenuVersions(ctx context.Context) ([]*domain.MenuVersion, error) {
+	data, err := i.repository.ListMenuVersions(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch menu versions", err)
+	}
+
+	result := make([]*domain.MenuVersion, len(data))
+	for index, row := range data {
+		publishAt, _ := utils.PgTimestampToThaiISO8601(row.PublishAt)
+		createdAt, _ := utils.PgTimestampToThaiISO8601(row.CreatedAt)
+		result[index] = &domain.MenuVersion{
+			ID:            row.ID,
+			VersionNumber: row.VersionNumber,
+			PublishAt:     publishAt,
+			Note:          utils.PgTextToStringPtr(row.Note),
+			RollbackOf:    utils.PgInt8ToInt64Ptr(row.RollbackOf),
+			CreatedAt:     createdAt,
+			ProductCount:  row.ProductCount,
+			IsScheduled:   row.IsScheduled,
+			IsCurrent:     row.IsCurrent,
+		}
+	}
+
+	return result, nil
+}
+
+// GetPublishedMenuVersionID returns 0 while nothing has been published yet, customers then see the live menu.
+func (i *Implement) GetPublishedMenuVersionID(ctx context.Context) (int64, error) {
+	id, err := i.repository.GetPublishedMenuVersionID(ctx)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return 0, nil
+		}
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to get published menu version", err)
+	}
+
+	return id, nil
+}
+
+func (i *Implement) IsMenuVersioned(ctx context.Context) (bool, error) {
+	isVersioned, err := i.repository.IsMenuVersioned(ctx)
+	if err != nil {
+		return false, exceptions.Errorf(exceptions.CodeRepository, "failed to check menu versions", err)
+	}
+
+	return isVersioned, nil
+}
+
+func (i *Implement) BuildMenuSnapshot(ctx context.Context) (*domain.MenuSnapshot, error) {
+	categories, err := i.repository.ListAllCategory(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch category", err)
+	}
+
+	data, err := i.repository.ListProductsForMenuSnapshot(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product", err)
+	}
+
+	snapshot := &domain.MenuSnapshot{
+		Categories: make([]*domain.Category, len(categories)),
+		Products:   make([]*domain.Product, len(data)),
+	}
+
+	for index, v := range categories {
+		snapshot.Categories[index] = &domain.Category{
+			ID:     v.ID,
+			Name:   v.Name,
+			NameEn: v.NameEN,
+			Icon:   utils.PgTextToStringPtr(v.Icon),
+		}
+	}
+
+	for index, row := range data {
+		snapshot.Products[index] = &domain.Product{
+			ID:             row.ID,
+			Name:           row.Name,
+			NameEN:         row.NameEn,
+			CategoryName:   row.CategoryName,
+			CategoryNameEN: row.CategoryNameEN,
+			CategoryID:     row.Categories,
+			Price:          utils.PgNumericToFloat64(row.Price),
+			Description:    utils.PgTextToStringPtr(row.Description),
+			IsAvailable:    row.IsAvailable,
+			ImageURL:       utils.PgTextToStringPtr(row.ImageUrl),
+			ThumbnailURL:   utils.PgTextToStringPtr(row.ThumbnailUrl),
+			StockQuantity:  utils.PgInt4ToInt32Ptr(row.StockQuantity),
+		}
+	}
+
+	err = i.attachVariants(ctx, snapshot.Products)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.attachModifierGroups(ctx, snapshot.Products)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.attachComboSlots(ctx, snapshot.Products)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.attachDietaryTags(ctx, snapshot.Products)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (i *Implement) GetMenuVersionSnapshot(ctx context.Context, id int64) (*domain.MenuSnapshot, bool, error) {
+	data, err := i.repository.GetMenuVersionSnapshot(ctx, id)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return nil, false, exceptions.ErrorIDNotFound(exceptions.CodeMenuVersionNotFound, id)
+		}
+		return nil, false, exceptions.Errorf(exceptions.CodeRepository, "failed to get menu version", err)
+	}
+
+	snapshot := new(domain.MenuSnapshot)
+	err = json.Unmarshal(data.Snapshot, snapshot)
+	if err != nil {
+		return nil, false, exceptions.Errorf(exceptions.CodeSystem, "failed to decode menu snapshot", err)
+	}
+
+	return snapshot, data.IsPublished, nil
+}
+
+// CreateMenuVersion stores the snapshot as a new version, an empty publishAt publishes it right away.
+func (i *Implement) CreateMenuVersion(ctx context.Context, snapshot *domain.MenuSnapshot, payload domain.MenuVersion) (int64, error) {
+	publishAt := pgtype.Timestamptz{}
+	if payload.PublishAt != "" {
+		at, err := time.Parse(time.RFC3339, payload.PublishAt)
+		if err != nil {
+			return 0, exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+
+		if !at.After(time.Now()) {
+			return 0, exceptions.Error(exceptions.CodeBusiness, "publish at must be in the future")
+		}
+		publishAt = pgtype.Timestamptz{Time: at, Valid: true}
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return 0, exceptions.Errorf(exceptions.CodeSystem, "failed to encode menu snapshot", err)
+	}
+
+	prices := make([]database.RecordProductPriceChangeParams, len(snapshot.Products))
+	for index, product := range snapshot.Products {
+		prices[index] = database.RecordProductPriceChangeParams{
+			ID:        i.snowflakeID.Generate(),
+			ProductID: product.ID,
+			Price:     utils.Float64ToPgNumeric(product.Price),
+		}
+	}
+
+	id := i.snowflakeID.Generate()
+	_, err = i.repository.TXPublishMenuVersion(ctx, database.TXPublishMenuVersionParams{
+		CreateMenuVersion: database.CreateMenuVersionParams{
+			ID:         id,
+			Snapshot:   data,
+			PublishAt:  publishAt,
+			Note:       utils.StringPtrToPgText(payload.Note),
+			RollbackOf: utils.Int64PtrToPgInt8(payload.RollbackOf),
+		},
+		ProductPrices: prices,
+	})
+	if err != nil {
+		// a product removed since the snapshot was taken cannot be priced again
+		if errors.Is(utils.MapPgErr(err), exceptions.ErrForeignKeyViolation) {
+			return 0, exceptions.Error(exceptions.CodeConflict, "menu version contains products that no longer exist")
+		}
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to publish menu version", err)
+	}
+
+	return id, nil
+}
+
+func (i *Implement) DeleteScheduledMenuVersion(ctx context.Context, id int64) error {
+	rowsAffected, err := i.repository.DeleteScheduledMenuVersion(ctx, id)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to delete scheduled menu version", err)
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeMenuVersionNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) ListPublishedCategories(ctx context.Context, menuVersionID int64) ([]*domain.Category, error) {
+	data, err := i.repository.GetPublishedCategories(ctx, menuVersionID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch published category", err)
+	}
+
+	var result []*domain.Category
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeSystem, "failed to decode menu snapshot", err)
+	}
+
+	return result, nil
+}
+
+// listPublishedProducts returns the snapshot of the given products keyed by id, products that were not part
+// of the version are missing from the map.
+func (i *Implement) listPublishedProducts(ctx context.Context, menuVersionID int64, productIDs []int64) (map[int64]*domain.Product, error) {
+	data, err := i.repository.ListPublishedProducts(ctx, database.ListPublishedProductsParams{
+		MenuVersionID: menuVersionID,
+		ProductIds:    productIDs,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch published product", err)
+	}
+
+	result := make(map[int64]*domain.Product, len(data))
+	for _, row := range data {
+		product := new(domain.Product)
+		err = json.Unmarshal(row, product)
+		if err != nil {
+			return nil, exceptions.Errorf(exceptions.CodeSystem, "failed to decode menu snapshot", err)
+		}
+		result[product.ID] = product
+	}
+
+	return result, nil
+}
+
+// applyPublishedProducts swaps live products for their published snapshot. Price, availability and stock stay
+// live, published prices are already in effect through the price history.
+func (i *Implement) applyPublishedProducts(ctx context.Context, menuVersionID int64, products []*domain.Product) ([]*domain.Product, error) {
+	productIDs := make([]int64, 0, len(products))
+	for _, product := range products {
+		if product != nil {
+			productIDs = append(productIDs, product.ID)
+		}
+	}
+
+	published, err := i.listPublishedProducts(ctx, menuVersionID, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Product, 0, len(products))
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+
+		snapshot, ok := published[product.ID]
+		if !ok {
+			continue
+		}
+
+		snapshot.Price = product.Price
+		snapshot.IsAvailable = product.IsAvailable
+		snapshot.StockQuantity = product.StockQuantity
+		result = append(result, snapshot)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListModifierGroups(ctx context.Context, productIDs []int64) (map[int64][]*domain.ModifierGroup, error) {
+	result := make(map[int64][]*domain.ModifierGroup)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	groups, err := i.repository.ListModifierGroupsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch modifier groups", err)
+	}
+
+	if len(groups) == 0 {
+		return result, nil
+	}
+
+	groupIDs := make([]int64, len(groups))
+	groupMap := make(map[int64]*domain.ModifierGroup, len(groups))
+	for index, group := range groups {
+		groupIDs[index] = group.ID
+		groupMap[group.ID] = &domain.ModifierGroup{
+			ID:            group.ID,
+			Name:          group.Name,
+			NameEN:        group.NameEN,
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+			Modifiers:     []*domain.Modifier{},
+		}
+		result[group.ProductID] = append(result[group.ProductID], groupMap[group.ID])
+	}
+
+	modifiers, err := i.repository.ListModifiersByGroupIDs(ctx, groupIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch modifiers", err)
+	}
+
+	for _, modifier := range modifiers {
+		group, ok := groupMap[modifier.GroupID]
+		if !ok {
+			continue
+		}
+		group.Modifiers = append(group.Modifiers, &domain.Modifier{
+			ID:          modifier.ID,
+			Name:        modifier.Name,
+			NameEN:      modifier.NameEN,
+			PriceDelta:  utils.PgNumericToFloat64(modifier.PriceDelta),
+			IsAvailable: modifier.IsAvailable,
+		})
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceModifierGroups(ctx context.Context, productID int64, groups []*domain.ModifierGroup) error {
+	params := database.TXReplaceModifierGroupsParams{
+		ProductID:      productID,
+		ModifierGroups: make([]database.TXModifierGroupParams, len(groups)),
+	}
+
+	for groupIndex, group := range groups {
+		modifiers := make([]database.TXModifierParams, len(group.Modifiers))
+		for modifierIndex, modifier := range group.Modifiers {
+			modifierID, isNew := i.optionID(modifier.ID)
+			modifiers[modifierIndex] = database.TXModifierParams{
+				CreateModifier: database.CreateModifierParams{
+					ID:          modifierID,
+					Name:        modifier.Name,
+					NameEn:      modifier.NameEN,
+					PriceDelta:  utils.Float64ToPgNumeric(modifier.PriceDelta),
+					IsAvailable: modifier.IsAvailable,
+					SortOrder:   int32(modifierIndex + 1),
+				},
+				IsNew: isNew,
+			}
+		}
+
+		groupID, isNew := i.optionID(group.ID)
+		params.ModifierGroups[groupIndex] = database.TXModifierGroupParams{
+			CreateModifierGroup: database.CreateModifierGroupParams{
+				ID:            groupID,
+				Name:          group.Name,
+				NameEn:        group.NameEN,
+				MinSelections: group.MinSelections,
+				MaxSelections: group.MaxSelections,
+				SortOrder:     int32(groupIndex + 1),
+			},
+			IsNew:     isNew,
+			Modifiers: modifiers,
+		}
+	}
+
+	err := i.repository.TXReplaceModifierGroups(ctx, params)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrMenuOptionNotFound) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update modifier groups", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) attachModifierGroups(ctx context.Context, products []*domain.Product) error {
+	productIDs := make([]int64, 0, len(products))
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		productIDs = append(productIDs, product.ID)
+	}
+
+	modifierGroups, err := i.ListModifierGroups(ctx, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		product.ModifierGroups = modifierGroups[product.ID]
+		if product.ModifierGroups == nil {
+			product.ModifierGroups = []*domain.ModifierGroup{}
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"food-story/menu-service/internal/domain"
 	"food-story/pkg/exceptions"
 	"food-story/pkg/utils"
@@ -13,7 +14,7 @@ import (
 )
 
 func (i *Implement) SearchProduct(ctx context.Context, payload domain.SearchProduct) (domain.SearchProductResult, error) {
-	searchParams := buildSearchParams(payload)
+	searchParams := buildSearchParams(payload, i.config.TimeZone)
 
 	var (
 		searchResult  []*database.SearchProductsRow
@@ -45,18 +46,26 @@ func (i *Implement) SearchProduct(ctx context.Context, payload domain.SearchProd
 		return domain.SearchProductResult{}, totalItemsErr
 	}
 
+	products, err := i.hydrateProducts(ctx, payload.MenuVersionID, transformSearchResults(searchResult))
+	if err != nil {
+		return domain.SearchProductResult{}, err
+	}
+
 	return domain.SearchProductResult{
 		PageNumber: utils.GetPageNumber(payload.PageNumber),
 		PageSize:   utils.GetPageSize(payload.PageSize),
 		TotalItems: totalItems,
 		TotalPages: utils.CalculateTotalPages(totalItems, searchParams.PageSize),
-		Data:       transformSearchResults(searchResult),
+		Data:       products,
 	}, nil
 }
 
-func (i *Implement) GetProductByID(ctx context.Context, id int64) (*domain.Product, error) {
+// GetProductByID reads the published snapshot of the product when menuVersionID is set, a product added after
+// that version was published is not found. Customers only see products they can order, staff also see the ones
+// they hid, marked unavailable or scheduled for another time of day.
+func (i *Implement) GetProductByID(ctx context.Context, id int64, menuVersionID int64, published bool) (*domain.Product, error) {
 
-	data, err := i.repository.GetProductAvailableByID(ctx, id)
+	data, err := i.getProductRow(ctx, id, published)
 	if err != nil {
 		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
 			return nil, exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, id)
@@ -68,7 +77,7 @@ func (i *Implement) GetProductByID(ctx context.Context, id int64) (*domain.Produ
 		return nil, exceptions.ErrorDataNotFound()
 	}
 
-	return &domain.Product{
+	product := &domain.Product{
 		ID:             data.ID,
 		Name:           data.Name,
 		NameEN:         data.NameEn,
@@ -79,7 +88,67 @@ func (i *Implement) GetProductByID(ctx context.Context, id int64) (*domain.Produ
 		Description:    utils.PgTextToStringPtr(data.Description),
 		IsAvailable:    data.IsAvailable,
 		ImageURL:       utils.PgTextToStringPtr(data.ImageUrl),
-	}, nil
+		ThumbnailURL:   utils.PgTextToStringPtr(data.ThumbnailUrl),
+		StockQuantity:  utils.PgInt4ToInt32Ptr(data.StockQuantity),
+	}
+
+	products, err := i.hydrateProducts(ctx, menuVersionID, []*domain.Product{product})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(products) == 0 {
+		return nil, exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, id)
+	}
+
+	return products[0], nil
+}
+
+func (i *Implement) getProductRow(ctx context.Context, id int64, published bool) (*database.GetProductAvailableByIDRow, error) {
+	if published {
+		return i.repository.GetProductAvailableByID(ctx, database.GetProductAvailableByIDParams{
+			ID:       id,
+			TimeZone: i.config.TimeZone,
+		})
+	}
+
+	data, err := i.repository.GetProductDetailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetProductAvailableByIDRow(*data)
+	return &row, nil
+}
+
+// hydrateProducts fills in variants, modifiers, combo slots and dietary tags, from the published snapshot when
+// menuVersionID is set or from the live tables otherwise.
+func (i *Implement) hydrateProducts(ctx context.Context, menuVersionID int64, products []*domain.Product) ([]*domain.Product, error) {
+	if menuVersionID != 0 {
+		return i.applyPublishedProducts(ctx, menuVersionID, products)
+	}
+
+	err := i.attachVariants(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.attachModifierGroups(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.attachComboSlots(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.attachDietaryTags(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
 }
 
 func (i *Implement) fetchProducts(ctx context.Context, params database.SearchProductsParams) ([]*database.SearchProductsRow, error) {
@@ -92,9 +161,13 @@ func (i *Implement) fetchProducts(ctx context.Context, params database.SearchPro
 
 func (i *Implement) fetchTotalItems(ctx context.Context, params database.SearchProductsParams) (int64, error) {
 	totalParams := database.GetTotalPageSearchProductsParams{
-		Name:        params.Name,
-		IsAvailable: params.IsAvailable,
-		CategoryID:  params.CategoryID,
+		TimeZone:         params.TimeZone,
+		Name:             params.Name,
+		IsAvailable:      params.IsAvailable,
+		DietaryTags:      params.DietaryTags,
+		ExcludeAllergens: params.ExcludeAllergens,
+		CategoryID:       params.CategoryID,
+		MenuVersionID:    params.MenuVersionID,
 	}
 	totalItems, err := i.repository.GetTotalPageSearchProducts(ctx, totalParams)
 	if err != nil {
@@ -112,15 +185,19 @@ func (i *Implement) ListProductTimeExtension(ctx context.Context) (result []*dom
 	return transformListProductTimeExtension(data), nil
 }
 
-func buildSearchParams(payload domain.SearchProduct) database.SearchProductsParams {
+func buildSearchParams(payload domain.SearchProduct, timeZone string) database.SearchProductsParams {
 	params := database.SearchProductsParams{
-		Name:        pgtype.Text{String: payload.Name, Valid: payload.Name != ""},
-		IsAvailable: pgtype.Bool{Bool: payload.IsAvailable, Valid: true},
-		CategoryID:  payload.CategoryID,
-		OrderByType: payload.OrderByType,
-		OrderBy:     payload.OrderBy,
-		PageSize:    payload.PageSize,
-		PageNumber:  payload.PageNumber,
+		TimeZone:         timeZone,
+		Name:             pgtype.Text{String: payload.Name, Valid: payload.Name != ""},
+		IsAvailable:      pgtype.Bool{Bool: payload.IsAvailable, Valid: true},
+		DietaryTags:      payload.DietaryTags,
+		ExcludeAllergens: payload.ExcludeAllergens,
+		CategoryID:       payload.CategoryID,
+		MenuVersionID:    pgtype.Int8{Int64: payload.MenuVersionID, Valid: payload.MenuVersionID != 0},
+		OrderByType:      payload.OrderByType,
+		OrderBy:          payload.OrderBy,
+		PageSize:         payload.PageSize,
+		PageNumber:       payload.PageNumber,
 	}
 
 	params.PageSize, params.PageNumber = utils.CalculatePageSizeAndNumber(payload.PageSize, payload.PageNumber)
@@ -147,6 +224,8 @@ func transformSearchResults(results []*database.SearchProductsRow) []*domain.Pro
 			Description:    utils.PgTextToStringPtr(row.Description),
 			IsAvailable:    row.IsAvailable,
 			ImageURL:       utils.PgTextToStringPtr(row.ImageUrl),
+			ThumbnailURL:   utils.PgTextToStringPtr(row.ThumbnailUrl),
+			StockQuantity:  utils.PgInt4ToInt32Ptr(row.StockQuantity),
 		}
 	}
 	return data
@@ -175,3 +254,134 @@ func transformListProductTimeExtension(results []*database.ListProductTimeExtens
 	}
 	return data
 }
+
+func (i *Implement) CreateProduct(ctx context.Context, payload domain.Product) (int64, error) {
+	id, err := i.repository.TXCreateProduct(ctx, database.TXCreateProductParams{
+		CreateProduct: database.CreateProductParams{
+			ID:          i.snowflakeID.Generate(),
+			Name:        payload.Name,
+			NameEn:      payload.NameEN,
+			Categories:  payload.CategoryID,
+			Description: utils.StringPtrToPgText(payload.Description),
+			Price:       utils.Float64ToPgNumeric(payload.Price),
+			IsAvailable: payload.IsAvailable,
+			ImageUrl:    utils.StringPtrToPgText(payload.ImageURL),
+		},
+		PriceID: i.snowflakeID.Generate(),
+	})
+	if err != nil {
+		return 0, mapProductWriteError(err, "failed to create product")
+	}
+
+	return id, nil
+}
+
+func (i *Implement) UpdateProduct(ctx context.Context, payload domain.Product) error {
+	// once the menu is versioned the edit is a draft, its price takes effect when the next version is published
+	isVersioned, err := i.IsMenuVersioned(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.TXUpdateProduct(ctx, database.TXUpdateProductParams{
+		UpdateProduct: database.UpdateProductParams{
+			ID:          payload.ID,
+			Name:        payload.Name,
+			NameEn:      payload.NameEN,
+			Categories:  payload.CategoryID,
+			Description: utils.StringPtrToPgText(payload.Description),
+			Price:       utils.Float64ToPgNumeric(payload.Price),
+			IsAvailable: payload.IsAvailable,
+			ImageUrl:    utils.StringPtrToPgText(payload.ImageURL),
+		},
+		PriceID:     i.snowflakeID.Generate(),
+		RecordPrice: !isVersioned,
+	})
+	if err != nil {
+		return mapProductWriteError(err, "failed to update product")
+	}
+
+	return nil
+}
+
+func (i *Implement) UpdateProductAvailability(ctx context.Context, id int64, isAvailable bool) error {
+	err := i.repository.UpdateProductAvailability(ctx, database.UpdateProductAvailabilityParams{
+		ID:          id,
+		IsAvailable: isAvailable,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product availability", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) UpdateProductStock(ctx context.Context, id int64, stockQuantity *int32) error {
+	rowsAffected, err := i.repository.UpdateProductStock(ctx, database.UpdateProductStockParams{
+		ID:            id,
+		StockQuantity: utils.Int32PtrToPgInt4(stockQuantity),
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product stock", err)
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) UpdateProductImage(ctx context.Context, id int64, image domain.ProductImage) error {
+	rowsAffected, err := i.repository.UpdateProductImage(ctx, database.UpdateProductImageParams{
+		ID:           id,
+		ImageUrl:     image.ImageURL,
+		ThumbnailUrl: image.ThumbnailURL,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product image", err)
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) UpdateProductVisibility(ctx context.Context, id int64, isVisible bool) error {
+	err := i.repository.UpdateProductVisibility(ctx, database.UpdateProductVisibilityParams{
+		ID:        id,
+		IsVisible: isVisible,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product visibility", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) IsProductExists(ctx context.Context, id int64) error {
+	isExists, err := i.repository.IsProductExists(ctx, id)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to check product exists", err)
+	}
+
+	if !isExists {
+		return exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, id)
+	}
+
+	return nil
+}
+
+func mapProductWriteError(err error, message string) error {
+	if field, ok := utils.PgUniqueViolationField(err); ok {
+		return exceptions.Error(exceptions.CodeConflict, fmt.Sprintf("product %s already exists", field))
+	}
+
+	if errors.Is(utils.MapPgErr(err), exceptions.ErrForeignKeyViolation) {
+		return exceptions.Error(exceptions.CodeBusiness, "category not found")
+	}
+
+	return exceptions.Errorf(exceptions.CodeRepository, message, err)
+}
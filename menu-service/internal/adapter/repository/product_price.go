@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (i *Implement) ListProductPrices(ctx context.Context, productID int64) ([]*domain.ProductPrice, error) {
+	data, err := i.repository.ListProductPrices(ctx, productID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product prices", err)
+	}
+
+	result := make([]*domain.ProductPrice, len(data))
+	for index, row := range data {
+		effectiveFrom, _ := utils.PgTimestampToThaiISO8601(row.EffectiveFrom)
+		createdAt, _ := utils.PgTimestampToThaiISO8601(row.CreatedAt)
+		result[index] = &domain.ProductPrice{
+			ID:            row.ID,
+			Price:         utils.PgNumericToFloat64(row.Price),
+			EffectiveFrom: effectiveFrom,
+			CreatedAt:     createdAt,
+			IsScheduled:   row.IsScheduled,
+			IsCurrent:     row.IsCurrent,
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) CreateScheduledProductPrice(ctx context.Context, productID int64, payload domain.ProductPrice) (int64, error) {
+	effectiveFrom, err := time.Parse(time.RFC3339, payload.EffectiveFrom)
+	if err != nil {
+		return 0, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	id := i.snowflakeID.Generate()
+	rowsAffected, err := i.repository.CreateScheduledProductPrice(ctx, database.CreateScheduledProductPriceParams{
+		ID:            id,
+		ProductID:     productID,
+		Price:         utils.Float64ToPgNumeric(payload.Price),
+		EffectiveFrom: pgtype.Timestamptz{Time: effectiveFrom, Valid: true},
+	})
+	if err != nil {
+		if _, ok := utils.PgUniqueViolationField(err); ok {
+			return 0, exceptions.Error(exceptions.CodeConflict, "a price already starts at this time")
+		}
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to schedule product price", err)
+	}
+
+	if rowsAffected == 0 {
+		return 0, exceptions.Error(exceptions.CodeBusiness, "effective from must be in the future")
+	}
+
+	return id, nil
+}
+
+func (i *Implement) DeleteScheduledProductPrice(ctx context.Context, productID, priceID int64) error {
+	rowsAffected, err := i.repository.DeleteScheduledProductPrice(ctx, database.DeleteScheduledProductPriceParams{
+		ID:        priceID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to delete scheduled product price", err)
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeProductPriceNotFound, priceID)
+	}
+
+	return nil
+}
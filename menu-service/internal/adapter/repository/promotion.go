@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (i *Implement) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+	data, err := i.repository.ListPromotions(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch promotions", err)
+	}
+
+	result := make([]*domain.Promotion, len(data))
+	for index, row := range data {
+		promotion := &domain.Promotion{
+			ID:            row.ID,
+			Name:          row.Name,
+			NameEN:        row.NameEN,
+			PromotionType: row.PromotionType,
+			DiscountValue: utils.PgNumericToFloat64(row.DiscountValue),
+			BuyQuantity:   utils.PgInt4ToInt32Ptr(row.BuyQuantity),
+			FreeQuantity:  utils.PgInt4ToInt32Ptr(row.FreeQuantity),
+			ProductID:     utils.PgInt8ToInt64Ptr(row.ProductID),
+			CategoryID:    utils.PgInt8ToInt64Ptr(row.CategoryID),
+			StartsAt:      pgTimestampToStringPtr(row.StartsAt),
+			EndsAt:        pgTimestampToStringPtr(row.EndsAt),
+			DaysOfWeek:    row.DaysOfWeek,
+			IsActive:      row.IsActive,
+		}
+		if row.StartTime.Valid {
+			startTime, endTime := utils.PgTimeToStr(row.StartTime), utils.PgTimeToStr(row.EndTime)
+			promotion.StartTime, promotion.EndTime = &startTime, &endTime
+		}
+		result[index] = promotion
+	}
+
+	return result, nil
+}
+
+func (i *Implement) CreatePromotion(ctx context.Context, payload domain.Promotion) (int64, error) {
+	params, err := buildPromotionParams(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	params.ID = i.snowflakeID.Generate()
+	err = i.repository.CreatePromotion(ctx, params)
+	if err != nil {
+		return 0, mapPromotionWriteError(err, "failed to create promotion")
+	}
+
+	return params.ID, nil
+}
+
+func (i *Implement) UpdatePromotion(ctx context.Context, payload domain.Promotion) error {
+	params, err := buildPromotionParams(payload)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := i.repository.UpdatePromotion(ctx, database.UpdatePromotionParams{
+		ID:            payload.ID,
+		Name:          params.Name,
+		NameEn:        params.NameEn,
+		PromotionType: params.PromotionType,
+		DiscountValue: params.DiscountValue,
+		BuyQuantity:   params.BuyQuantity,
+		FreeQuantity:  params.FreeQuantity,
+		ProductID:     params.ProductID,
+		CategoryID:    params.CategoryID,
+		StartsAt:      params.StartsAt,
+		EndsAt:        params.EndsAt,
+		DaysOfWeek:    params.DaysOfWeek,
+		StartTime:     params.StartTime,
+		EndTime:       params.EndTime,
+		IsActive:      params.IsActive,
+	})
+	if err != nil {
+		return mapPromotionWriteError(err, "failed to update promotion")
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodePromotionNotFound, payload.ID)
+	}
+
+	return nil
+}
+
+func buildPromotionParams(payload domain.Promotion) (database.CreatePromotionParams, error) {
+	params := database.CreatePromotionParams{
+		Name:          payload.Name,
+		NameEn:        payload.NameEN,
+		PromotionType: payload.PromotionType,
+		DiscountValue: utils.Float64ToPgNumeric(payload.DiscountValue),
+		BuyQuantity:   utils.Int32PtrToPgInt4(payload.BuyQuantity),
+		FreeQuantity:  utils.Int32PtrToPgInt4(payload.FreeQuantity),
+		ProductID:     utils.Int64PtrToPgInt8(payload.ProductID),
+		CategoryID:    utils.Int64PtrToPgInt8(payload.CategoryID),
+		DaysOfWeek:    payload.DaysOfWeek,
+		IsActive:      payload.IsActive,
+	}
+
+	var err error
+	params.StartsAt, err = stringPtrToPgTimestamp(payload.StartsAt)
+	if err != nil {
+		return database.CreatePromotionParams{}, err
+	}
+
+	params.EndsAt, err = stringPtrToPgTimestamp(payload.EndsAt)
+	if err != nil {
+		return database.CreatePromotionParams{}, err
+	}
+
+	if payload.StartTime != nil && payload.EndTime != nil {
+		params.StartTime, err = utils.StrToPgTime(*payload.StartTime)
+		if err != nil {
+			return database.CreatePromotionParams{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+
+		params.EndTime, err = utils.StrToPgTime(*payload.EndTime)
+		if err != nil {
+			return database.CreatePromotionParams{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+	}
+
+	return params, nil
+}
+
+func mapPromotionWriteError(err error, message string) error {
+	if errors.Is(utils.MapPgErr(err), exceptions.ErrForeignKeyViolation) {
+		return exceptions.Error(exceptions.CodeBusiness, "product or category not found")
+	}
+
+	return exceptions.Errorf(exceptions.CodeRepository, message, err)
+}
+
+func stringPtrToPgTimestamp(value *string) (pgtype.Timestamptz, error) {
+	if value == nil {
+		return pgtype.Timestamptz{}, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return pgtype.Timestamptz{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return pgtype.Timestamptz{Time: parsed, Valid: true}, nil
+}
+
+func pgTimestampToStringPtr(value pgtype.Timestamptz) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	result, _ := utils.PgTimestampToThaiISO8601(value)
+	return &result
+}
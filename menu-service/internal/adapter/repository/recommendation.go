@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (i *Implement) RefreshProductAssociations(ctx context.Context, lookbackDays, minOrderCount int32) (int64, bool, error) {
+	rows, refreshed, err := i.repository.TXRefreshProductAssociations(ctx, database.RefreshProductAssociationsParams{
+		LookbackDays:  lookbackDays,
+		MinOrderCount: minOrderCount,
+	})
+	if err != nil {
+		return 0, false, exceptions.Errorf(exceptions.CodeRepository, "failed to refresh product associations", err)
+	}
+
+	return rows, refreshed, nil
+}
+
+func (i *Implement) ListSessionOrderedProductIDs(ctx context.Context, sessionID uuid.UUID) ([]int64, error) {
+	result, err := i.repository.ListSessionOrderedProductIDs(ctx, utils.UUIDToPgUUID(sessionID))
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch session ordered products", err)
+	}
+
+	return result, nil
+}
+
+// ListRecommendedProducts ranks products often ordered together with payload.ProductIDs, only products a
+// customer can order right now are returned.
+func (i *Implement) ListRecommendedProducts(ctx context.Context, payload domain.Recommendation) ([]*domain.Product, error) {
+	data, err := i.repository.ListRecommendedProducts(ctx, database.ListRecommendedProductsParams{
+		ProductIds:    payload.ProductIDs,
+		TimeZone:      i.config.TimeZone,
+		MenuVersionID: pgtype.Int8{Int64: payload.MenuVersionID, Valid: payload.MenuVersionID != 0},
+		CategoryID:    payload.CategoryID,
+		MaxResults:    payload.Limit,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch recommended products", err)
+	}
+
+	products := make([]*domain.Product, len(data))
+	for index, row := range data {
+		products[index] = &domain.Product{
+			ID:             row.ID,
+			Name:           row.Name,
+			NameEN:         row.NameEn,
+			CategoryName:   row.CategoryName,
+			CategoryNameEN: row.CategoryNameEN,
+			CategoryID:     row.Categories,
+			Price:          utils.PgNumericToFloat64(row.Price),
+			Description:    utils.PgTextToStringPtr(row.Description),
+			IsAvailable:    row.IsAvailable,
+			ImageURL:       utils.PgTextToStringPtr(row.ImageUrl),
+			ThumbnailURL:   utils.PgTextToStringPtr(row.ThumbnailUrl),
+			StockQuantity:  utils.PgInt4ToInt32Ptr(row.StockQuantity),
+		}
+	}
+
+	return i.hydrateProducts(ctx, payload.MenuVersionID, products)
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (i *Implement) ListProductSchedules(ctx context.Context, productID int64) ([]*domain.MenuSchedule, error) {
+	data, err := i.repository.ListMenuSchedulesByProductID(ctx, productID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product schedules", err)
+	}
+
+	result := make([]*domain.MenuSchedule, len(data))
+	for index, row := range data {
+		result[index] = transformMenuSchedule(row.ID, row.DayOfWeek, row.StartTime, row.EndTime)
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ListCategorySchedules(ctx context.Context, categoryID int64) ([]*domain.MenuSchedule, error) {
+	data, err := i.repository.ListMenuSchedulesByCategoryID(ctx, categoryID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch category schedules", err)
+	}
+
+	result := make([]*domain.MenuSchedule, len(data))
+	for index, row := range data {
+		result[index] = transformMenuSchedule(row.ID, row.DayOfWeek, row.StartTime, row.EndTime)
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceProductSchedules(ctx context.Context, productID int64, schedules []*domain.MenuSchedule) error {
+	return i.replaceMenuSchedules(ctx, database.TXReplaceMenuSchedulesParams{
+		ProductID: pgtype.Int8{Int64: productID, Valid: true},
+	}, schedules)
+}
+
+func (i *Implement) ReplaceCategorySchedules(ctx context.Context, categoryID int64, schedules []*domain.MenuSchedule) error {
+	return i.replaceMenuSchedules(ctx, database.TXReplaceMenuSchedulesParams{
+		CategoryID: pgtype.Int8{Int64: categoryID, Valid: true},
+	}, schedules)
+}
+
+func (i *Implement) IsCategoryExists(ctx context.Context, id int64) error {
+	isExists, err := i.repository.IsCategoryExists(ctx, id)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to check category exists", err)
+	}
+
+	if !isExists {
+		return exceptions.ErrorIDNotFound(exceptions.CodeCategoryNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) replaceMenuSchedules(ctx context.Context, params database.TXReplaceMenuSchedulesParams, schedules []*domain.MenuSchedule) error {
+	params.CreateMenuSchedules = make([]database.CreateMenuScheduleParams, len(schedules))
+	for index, schedule := range schedules {
+		startTime, err := utils.StrToPgTime(schedule.StartTime)
+		if err != nil {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+
+		endTime, err := utils.StrToPgTime(schedule.EndTime)
+		if err != nil {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+
+		params.CreateMenuSchedules[index] = database.CreateMenuScheduleParams{
+			ID:        i.snowflakeID.Generate(),
+			DayOfWeek: schedule.DayOfWeek,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+	}
+
+	err := i.repository.TXReplaceMenuSchedules(ctx, params)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update menu schedules", err)
+	}
+
+	return nil
+}
+
+func transformMenuSchedule(id int64, dayOfWeek int16, startTime, endTime pgtype.Time) *domain.MenuSchedule {
+	return &domain.MenuSchedule{
+		ID:        id,
+		DayOfWeek: dayOfWeek,
+		StartTime: utils.PgTimeToStr(startTime),
+		EndTime:   utils.PgTimeToStr(endTime),
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListSearchAliases(ctx context.Context) ([]*domain.SearchAlias, error) {
+	data, err := i.repository.ListSearchAliases(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch search aliases", err)
+	}
+
+	result := make([]*domain.SearchAlias, len(data))
+	for index, row := range data {
+		result[index] = &domain.SearchAlias{
+			ID:    row.ID,
+			Alias: row.Alias,
+			Term:  row.Term,
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) CreateSearchAlias(ctx context.Context, payload domain.SearchAlias) (int64, error) {
+	id := i.snowflakeID.Generate()
+	err := i.repository.CreateSearchAlias(ctx, database.CreateSearchAliasParams{
+		ID:    id,
+		Alias: payload.Alias,
+		Term:  payload.Term,
+	})
+	if err != nil {
+		if _, ok := utils.PgUniqueViolationField(err); ok {
+			return 0, exceptions.Error(exceptions.CodeConflict, "search alias already exists")
+		}
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to create search alias", err)
+	}
+
+	return id, nil
+}
+
+func (i *Implement) DeleteSearchAlias(ctx context.Context, id int64) error {
+	rowsAffected, err := i.repository.DeleteSearchAlias(ctx, id)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to delete search alias", err)
+	}
+
+	if rowsAffected == 0 {
+		return exceptions.ErrorIDNotFound(exceptions.CodeSearchAliasNotFound, id)
+	}
+
+	return nil
+}
+
+func (i *Implement) ListProductSearchKeywords(ctx context.Context, productID int64) ([]string, error) {
+	data, err := i.repository.ListProductSearchKeywords(ctx, productID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product search keywords", err)
+	}
+
+	return data, nil
+}
+
+func (i *Implement) ReplaceProductSearchKeywords(ctx context.Context, productID int64, keywords []string) error {
+	err := i.repository.TXReplaceProductSearchKeywords(ctx, database.TXReplaceProductSearchKeywordsParams{
+		ProductID: productID,
+		Keywords:  keywords,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product search keywords", err)
+	}
+
+	return nil
+}
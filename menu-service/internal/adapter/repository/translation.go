@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	database "food-story/shared/database/sqlc"
+	"food-story/shared/i18n"
+)
+
+func (i *Implement) LoadTranslations(ctx context.Context, locales []string, entityIDs []int64) (*i18n.Translations, error) {
+	translations, err := i18n.Load(ctx, i.repository, locales, entityIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch translations", err)
+	}
+
+	return translations, nil
+}
+
+func (i *Implement) ListTranslationLocales(ctx context.Context) ([]string, error) {
+	locales, err := i.repository.ListTranslationLocales(ctx)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch translation locales", err)
+	}
+
+	return locales, nil
+}
+
+func (i *Implement) ListTranslations(ctx context.Context, entityType string, entityID int64) ([]*domain.Translation, error) {
+	data, err := i.repository.ListTranslationsByEntity(ctx, database.ListTranslationsByEntityParams{
+		EntityType: entityType,
+		EntityID:   entityID,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch translations", err)
+	}
+
+	result := make([]*domain.Translation, len(data))
+	for index, row := range data {
+		result[index] = &domain.Translation{
+			Field:  row.Field,
+			Locale: row.Locale,
+			Value:  row.Value,
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceTranslations(ctx context.Context, entityType string, entityID int64, translations []*domain.Translation) error {
+	params := database.TXReplaceTranslationsParams{
+		EntityType:         entityType,
+		EntityID:           entityID,
+		CreateTranslations: make([]database.CreateTranslationParams, len(translations)),
+	}
+
+	for index, translation := range translations {
+		params.CreateTranslations[index] = database.CreateTranslationParams{
+			Field:  translation.Field,
+			Locale: translation.Locale,
+			Value:  translation.Value,
+		}
+	}
+
+	err := i.repository.TXReplaceTranslations(ctx, params)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update translations", err)
+	}
+
+	return nil
+}
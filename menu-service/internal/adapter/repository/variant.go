@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListVariants(ctx context.Context, productIDs []int64) (map[int64][]*domain.Variant, error) {
+	result := make(map[int64][]*domain.Variant)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	variants, err := i.repository.ListVariantsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product variants", err)
+	}
+
+	for _, variant := range variants {
+		result[variant.ProductID] = append(result[variant.ProductID], &domain.Variant{
+			ID:          variant.ID,
+			Name:        variant.Name,
+			NameEN:      variant.NameEN,
+			Price:       utils.PgNumericToFloat64(variant.Price),
+			IsAvailable: variant.IsAvailable,
+		})
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ReplaceVariants(ctx context.Context, productID int64, variants []*domain.Variant) error {
+	params := database.TXReplaceVariantsParams{
+		ProductID: productID,
+		Variants:  make([]database.TXVariantParams, len(variants)),
+	}
+
+	for index, variant := range variants {
+		id, isNew := i.optionID(variant.ID)
+		params.Variants[index] = database.TXVariantParams{
+			CreateVariant: database.CreateVariantParams{
+				ID:          id,
+				ProductID:   productID,
+				Name:        variant.Name,
+				NameEn:      variant.NameEN,
+				Price:       utils.Float64ToPgNumeric(variant.Price),
+				IsAvailable: variant.IsAvailable,
+				SortOrder:   int32(index + 1),
+			},
+			IsNew: isNew,
+		}
+	}
+
+	err := i.repository.TXReplaceVariants(ctx, params)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrMenuOptionNotFound) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+		if _, ok := utils.PgUniqueViolationField(err); ok {
+			return exceptions.Error(exceptions.CodeConflict, "variant name already exists")
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to update product variants", err)
+	}
+
+	return nil
+}
+
+// optionID keeps the ID of an existing variant, modifier or combo slot and generates one for a new entry.
+func (i *Implement) optionID(id int64) (int64, bool) {
+	if id != 0 {
+		return id, false
+	}
+	return i.snowflakeID.Generate(), true
+}
+
+func (i *Implement) attachVariants(ctx context.Context, products []*domain.Product) error {
+	productIDs := make([]int64, 0, len(products))
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		productIDs = append(productIDs, product.ID)
+	}
+
+	variants, err := i.ListVariants(ctx, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		product.Variants = variants[product.ID]
+		if product.Variants == nil {
+			product.Variants = []*domain.Variant{}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/exceptions"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type LocalImageStorage struct {
+	rootDir string
+	baseURL string
+}
+
+func NewLocalImageStorage(rootDir, baseURL string) (*LocalImageStorage, error) {
+	err := os.MkdirAll(rootDir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	return &LocalImageStorage{
+		rootDir: rootDir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+func (s *LocalImageStorage) Save(_ context.Context, key string, data []byte) (string, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0o755)
+	if err != nil {
+		return "", exceptions.Errorf(exceptions.CodeSystem, "failed to create image directory", err)
+	}
+
+	// write to a temp file first so a half-written image is never served
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return "", exceptions.Errorf(exceptions.CodeSystem, "failed to create image file", err)
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(data)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", exceptions.Errorf(exceptions.CodeSystem, "failed to write image file", err)
+	}
+
+	err = os.Chmod(tempFile.Name(), 0o644)
+	if err != nil {
+		return "", exceptions.Errorf(exceptions.CodeSystem, "failed to write image file", err)
+	}
+
+	err = os.Rename(tempFile.Name(), filePath)
+	if err != nil {
+		return "", exceptions.Errorf(exceptions.CodeSystem, "failed to write image file", err)
+	}
+
+	return s.baseURL + PublicPath + "/" + path.Clean(key), nil
+}
+
+func (s *LocalImageStorage) Delete(_ context.Context, key string) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return exceptions.Errorf(exceptions.CodeSystem, "failed to delete image file", err)
+	}
+
+	return nil
+}
+
+func (s *LocalImageStorage) filePath(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if cleanKey == "/" || strings.Contains(key, "..") {
+		return "", exceptions.Error(exceptions.CodeSystem, fmt.Sprintf("invalid image key '%s'", key))
+	}
+
+	return filepath.Join(s.rootDir, filepath.FromSlash(cleanKey)), nil
+}
@@ -0,0 +1,11 @@
+package storage
+
+import "context"
+
+// PublicPath is the route, relative to the service base URL, that serves stored images.
+const PublicPath = "/images"
+
+type ImageStorageInterface interface {
+	Save(ctx context.Context, key string, data []byte) (url string, err error)
+	Delete(ctx context.Context, key string) error
+}
@@ -8,6 +8,7 @@ import (
 	"food-story/menu-service/internal/adapter/cache"
 	menuhd "food-story/menu-service/internal/adapter/http"
 	"food-story/menu-service/internal/adapter/repository"
+	"food-story/menu-service/internal/adapter/storage"
 	"food-story/menu-service/internal/usecase"
 	"food-story/pkg/common"
 	"food-story/pkg/middleware"
@@ -30,8 +31,9 @@ const EnvFile = ".env"
 const ServiceName = "menu-service"
 
 type FiberServer struct {
-	App    *fiber.App
-	Config config.Config
+	App     *fiber.App
+	Config  config.Config
+	UseCase usecase.Usecase
 
 	db    *pgxpool.Pool
 	redis *redis.RedisClient
@@ -62,6 +64,7 @@ func New() (*FiberServer, error) {
 		AppName:                  ServiceName,
 		ErrorHandler:             middleware.HandleError,
 		EnableSplittingOnParsers: true,
+		BodyLimit:                usecase.MaxProductImageSize + 1<<20, // leave room for multipart overhead
 		JSONEncoder:              json.Marshal,
 		JSONDecoder:              json.Unmarshal,
 	})
@@ -78,6 +81,12 @@ func New() (*FiberServer, error) {
 	// add log handler
 	app.Use(middleware.LogHandler(configApp.BaseURL))
 
+	// init auth
+	authInstance, err := middleware.NewAuthInstance(configApp.KeyCloakCertURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init auth instance: %w", err)
+	}
+
 	// connect to database
 	configDB, err := config.InitDBConfig(EnvFile)
 	if err != nil {
@@ -93,13 +102,19 @@ func New() (*FiberServer, error) {
 	// connect to redis
 	redisConn := redis.NewRedisClient(configApp.RedisAddress, configApp.RedisPassword, 0)
 
-	// Create a new Node with a Node number of 1
-	node, err := snowflakeid.CreateSnowflakeNode(1)
+	// each service has its own node number so IDs written to the shared database never collide
+	node, err := snowflakeid.CreateSnowflakeNode(snowflakeid.NodeNumber(configApp.SnowflakeNodeID, snowflakeid.NodeMenuService))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snowflake: %w", err)
 	}
 	snowflakeNode := snowflakeid.NewSnowflake(node)
 
+	// init image storage
+	imageStorage, err := storage.NewLocalImageStorage(configApp.UploadDir, configApp.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init image storage: %w", err)
+	}
+
 	// init validator
 	validator := middleware.NewCustomValidator()
 
@@ -121,12 +136,13 @@ func New() (*FiberServer, error) {
 		ReadinessEndpoint: common.ReadinessEndpoint,
 	}))
 
-	registerHandlers(apiV1, store, validator, snowflakeNode, configApp, redisConn)
+	menuUseCase := registerHandlers(apiV1, store, validator, snowflakeNode, configApp, redisConn, authInstance, imageStorage)
 	return &FiberServer{
-		App:    app,
-		Config: configApp,
-		db:     dbConn,
-		redis:  redisConn,
+		App:     app,
+		Config:  configApp,
+		UseCase: menuUseCase,
+		db:      dbConn,
+		redis:   redisConn,
 	}, nil
 }
 
@@ -146,10 +162,12 @@ func readiness(ctx context.Context, dbConn *pgxpool.Pool, redisConn *redis.Redis
 	return true
 }
 
-func registerHandlers(router fiber.Router, store database.Store, validator *middleware.CustomValidator, snowflakeNode *snowflakeid.SnowflakeImpl, configApp config.Config, redisConn *redis.RedisClient) {
+func registerHandlers(router fiber.Router, store database.Store, validator *middleware.CustomValidator, snowflakeNode *snowflakeid.SnowflakeImpl, configApp config.Config, redisConn *redis.RedisClient, authInstance *middleware.AuthInstance, imageStorage storage.ImageStorageInterface) usecase.Usecase {
 	menuCache := cache.NewRedisTableCache(redisConn)
+	menuDataCache := cache.NewRedisMenuCache(redisConn)
 	menuRepo := repository.NewRepository(configApp, store, snowflakeNode)
-	menuUseCase := usecase.NewUsecase(configApp, *menuRepo, menuCache)
+	menuUseCase := usecase.NewUsecase(configApp, *menuRepo, menuCache, menuDataCache, imageStorage)
 
-	menuhd.NewHTTPHandler(router, menuUseCase, validator, configApp)
+	menuhd.NewHTTPHandler(router, menuUseCase, validator, configApp, authInstance)
+	return menuUseCase
 }
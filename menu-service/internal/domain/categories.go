@@ -1,8 +1,19 @@
 package domain
 
 type Category struct {
-	ID     int64   `json:"id,string" example:"1921144250070732800"`
-	Name   string  `json:"name" example:"ขนม"`
-	NameEn string  `json:"nameEN" example:"Dessert"`
-	Icon   *string `json:"icon" example:"CakeSlice"`
+	ID            int64   `json:"id,string" example:"1921144250070732800"`
+	Name          string  `json:"name" example:"ขนม"`
+	NameEn        string  `json:"nameEN" example:"Dessert"`
+	Icon          *string `json:"icon" example:"CakeSlice"`
+	LocalizedName string  `json:"localizedName" example:"甜点"`
+}
+
+type CategoryDetail struct {
+	ID        int64   `json:"id,string" example:"1921144250070732800"`
+	Name      string  `json:"name" example:"ขนม"`
+	NameEn    string  `json:"nameEN" example:"Dessert"`
+	Icon      *string `json:"icon" example:"CakeSlice"`
+	Code      *string `json:"code" example:"DESSERT"`
+	SortOrder int32   `json:"sortOrder" example:"3"`
+	IsVisible bool    `json:"isVisible" example:"true"`
 }
@@ -0,0 +1,8 @@
+package domain
+
+type DietaryTag struct {
+	Code       string `json:"code" example:"PEANUT"`
+	Name       string `json:"name" example:"ถั่วลิสง"`
+	NameEN     string `json:"nameEN" example:"Peanut"`
+	IsAllergen bool   `json:"isAllergen" example:"true"`
+}
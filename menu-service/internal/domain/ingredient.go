@@ -0,0 +1,25 @@
+package domain
+
+type Ingredient struct {
+	ID                int64   `json:"id,string" example:"1921822053405560836"`
+	Name              string  `json:"name" example:"ไข่ไก่"`
+	NameEN            string  `json:"nameEN" example:"Egg"`
+	Unit              string  `json:"unit" example:"ฟอง"`
+	StockQuantity     float64 `json:"stockQuantity" example:"120"`
+	LowStockThreshold float64 `json:"lowStockThreshold" example:"30"`
+}
+
+type ProductIngredient struct {
+	IngredientID int64   `json:"ingredientID,string" example:"1921822053405560836"`
+	Name         string  `json:"name" example:"ไข่ไก่"`
+	NameEN       string  `json:"nameEN" example:"Egg"`
+	Unit         string  `json:"unit" example:"ฟอง"`
+	Quantity     float64 `json:"quantity" example:"1"`
+}
+
+type IngredientMovement struct {
+	IngredientID int64
+	Quantity     float64
+	Reason       string
+	Note         *string
+}
@@ -0,0 +1,20 @@
+package domain
+
+type MenuVersion struct {
+	ID            int64   `json:"id,string" example:"1921822053405560838"`
+	VersionNumber int32   `json:"versionNumber" example:"3"`
+	PublishAt     string  `json:"publishAt" example:"2025-06-02T00:00:00+07:00"`
+	Note          *string `json:"note" example:"Rainy season menu"`
+	RollbackOf    *int64  `json:"rollbackOf,string" example:"1921822053405560837"`
+	CreatedAt     string  `json:"createdAt" example:"2025-05-28T10:15:00+07:00"`
+	ProductCount  int32   `json:"productCount" example:"42"`
+	IsScheduled   bool    `json:"isScheduled" example:"false"`
+	IsCurrent     bool    `json:"isCurrent" example:"true"`
+}
+
+// MenuSnapshot is the menu customers see while its version is the current one. Price, availability and stock
+// are still read live, everything else comes from the snapshot.
+type MenuSnapshot struct {
+	Categories []*Category `json:"categories"`
+	Products   []*Product  `json:"products"`
+}
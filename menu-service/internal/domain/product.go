@@ -1,26 +1,90 @@
 package domain
 
 type Product struct {
-	ID             int64   `json:"id,string" example:"1921144250070732800"`
-	Name           string  `json:"name" example:"ข้าวมันไก่"`
-	NameEN         string  `json:"nameEN" example:"Chicken rice"`
-	CategoryName   string  `json:"categoryName" example:"อาหาร"`
-	CategoryNameEN string  `json:"categoryNameEN" example:"Food"`
-	CategoryID     int64   `json:"categoryID,string" example:"1921143886227443712"`
-	Price          float64 `json:"price" example:"100"`
-	Description    *string `json:"description" example:"lorem ipsum"`
-	IsAvailable    bool    `json:"isAvailable" example:"true"`
-	ImageURL       *string `json:"imageURL" example:"https://example.com/image.jpg"`
+	ID                    int64            `json:"id,string" example:"1921144250070732800"`
+	Name                  string           `json:"name" example:"ข้าวมันไก่"`
+	NameEN                string           `json:"nameEN" example:"Chicken rice"`
+	CategoryName          string           `json:"categoryName" example:"อาหาร"`
+	CategoryNameEN        string           `json:"categoryNameEN" example:"Food"`
+	CategoryID            int64            `json:"categoryID,string" example:"1921143886227443712"`
+	Price                 float64          `json:"price" example:"100"`
+	Description           *string          `json:"description" example:"lorem ipsum"`
+	LocalizedName         string           `json:"localizedName" example:"海南鸡饭"`
+	LocalizedDescription  *string          `json:"localizedDescription" example:"lorem ipsum"`
+	LocalizedCategoryName string           `json:"localizedCategoryName" example:"食物"`
+	IsAvailable           bool             `json:"isAvailable" example:"true"`
+	ImageURL              *string          `json:"imageURL" example:"https://example.com/image.jpg"`
+	ThumbnailURL          *string          `json:"thumbnailURL" example:"/api/v1/menu/images/products/1921144250070732800/thumb.jpg"`
+	StockQuantity         *int32           `json:"stockQuantity" example:"20"`
+	Variants              []*Variant       `json:"variants"`
+	ModifierGroups        []*ModifierGroup `json:"modifierGroups"`
+	ComboSlots            []*ComboSlot     `json:"comboSlots"`
+	DietaryTags           []*DietaryTag    `json:"dietaryTags"`
+}
+
+type ProductImage struct {
+	ImageURL     string `json:"imageURL" example:"/api/v1/menu/images/products/1921144250070732800/original.jpg"`
+	ThumbnailURL string `json:"thumbnailURL" example:"/api/v1/menu/images/products/1921144250070732800/thumb.jpg"`
+}
+
+type Variant struct {
+	ID            int64   `json:"id,string" example:"1921822053405560834"`
+	Name          string  `json:"name" example:"ใหญ่"`
+	NameEN        string  `json:"nameEN" example:"Large"`
+	LocalizedName string  `json:"localizedName" example:"大份"`
+	Price         float64 `json:"price" example:"65"`
+	IsAvailable   bool    `json:"isAvailable" example:"true"`
+}
+
+type ModifierGroup struct {
+	ID            int64       `json:"id,string" example:"1921822053405560832"`
+	Name          string      `json:"name" example:"ระดับความเผ็ด"`
+	NameEN        string      `json:"nameEN" example:"Spice level"`
+	LocalizedName string      `json:"localizedName" example:"辣度"`
+	MinSelections int32       `json:"minSelections" example:"1"`
+	MaxSelections int32       `json:"maxSelections" example:"1"`
+	Modifiers     []*Modifier `json:"modifiers"`
+}
+
+type Modifier struct {
+	ID            int64   `json:"id,string" example:"1921822053405560833"`
+	Name          string  `json:"name" example:"ไม่เผ็ด"`
+	NameEN        string  `json:"nameEN" example:"No chili"`
+	LocalizedName string  `json:"localizedName" example:"不辣"`
+	PriceDelta    float64 `json:"priceDelta" example:"0"`
+	IsAvailable   bool    `json:"isAvailable" example:"true"`
+}
+
+type ComboSlot struct {
+	ID            int64          `json:"id,string" example:"1921822053405560835"`
+	Name          string         `json:"name" example:"เครื่องดื่ม"`
+	NameEN        string         `json:"nameEN" example:"Drink"`
+	LocalizedName string         `json:"localizedName" example:"饮料"`
+	Options       []*ComboOption `json:"options"`
+}
+
+type ComboOption struct {
+	ProductID     int64   `json:"productID,string" example:"1921822053405560836"`
+	Name          string  `json:"name" example:"ชาไทย"`
+	NameEN        string  `json:"nameEN" example:"Thai tea"`
+	LocalizedName string  `json:"localizedName" example:"泰式奶茶"`
+	ImageURL      *string `json:"imageURL" example:"https://example.com/image.jpg"`
+	PriceDelta    float64 `json:"priceDelta" example:"0"`
+	IsAvailable   bool    `json:"isAvailable" example:"true"`
 }
 
 type SearchProduct struct {
-	Name        string
-	CategoryID  []int64
-	IsAvailable bool
-	OrderByType string
-	OrderBy     string
-	PageSize    int64
-	PageNumber  int64
+	Name             string
+	CategoryID       []int64
+	DietaryTags      []string
+	ExcludeAllergens []string
+	IsAvailable      bool
+	OrderByType      string
+	OrderBy          string
+	PageSize         int64
+	PageNumber       int64
+	Locales          []string
+	MenuVersionID    int64
 }
 
 type SearchProductResult struct {
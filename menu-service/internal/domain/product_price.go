@@ -0,0 +1,10 @@
+package domain
+
+type ProductPrice struct {
+	ID            int64   `json:"id,string" example:"1921822053405560837"`
+	Price         float64 `json:"price" example:"69"`
+	EffectiveFrom string  `json:"effectiveFrom" example:"2025-06-02T00:00:00+07:00"`
+	CreatedAt     string  `json:"createdAt" example:"2025-05-28T10:15:00+07:00"`
+	IsScheduled   bool    `json:"isScheduled" example:"true"`
+	IsCurrent     bool    `json:"isCurrent" example:"false"`
+}
@@ -0,0 +1,19 @@
+package domain
+
+type Promotion struct {
+	ID            int64   `json:"id,string" example:"1921822053405560838"`
+	Name          string  `json:"name" example:"เครื่องดื่มลด 20%"`
+	NameEN        string  `json:"nameEN" example:"Drinks 20% off"`
+	PromotionType string  `json:"promotionType" example:"PERCENTAGE"`
+	DiscountValue float64 `json:"discountValue" example:"20"`
+	BuyQuantity   *int32  `json:"buyQuantity" example:"2"`
+	FreeQuantity  *int32  `json:"freeQuantity" example:"1"`
+	ProductID     *int64  `json:"productID,string" example:"1921822053405560832"`
+	CategoryID    *int64  `json:"categoryID,string" example:"1921143886227443712"`
+	StartsAt      *string `json:"startsAt" example:"2025-06-01T00:00:00+07:00"`
+	EndsAt        *string `json:"endsAt" example:"2025-07-01T00:00:00+07:00"`
+	DaysOfWeek    []int16 `json:"daysOfWeek" example:"1,2,3,4,5"`
+	StartTime     *string `json:"startTime" example:"15:00"`
+	EndTime       *string `json:"endTime" example:"17:00"`
+	IsActive      bool    `json:"isActive" example:"true"`
+}
@@ -0,0 +1,9 @@
+package domain
+
+type Recommendation struct {
+	ProductIDs    []int64
+	CategoryID    []int64
+	Limit         int32
+	Locales       []string
+	MenuVersionID int64
+}
@@ -0,0 +1,8 @@
+package domain
+
+type MenuSchedule struct {
+	ID        int64  `json:"id,string" example:"1921822053405560835"`
+	DayOfWeek int16  `json:"dayOfWeek" example:"1"`
+	StartTime string `json:"startTime" example:"06:00"`
+	EndTime   string `json:"endTime" example:"11:00"`
+}
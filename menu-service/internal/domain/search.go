@@ -0,0 +1,7 @@
+package domain
+
+type SearchAlias struct {
+	ID    int64  `json:"id,string" example:"1921822053405560836"`
+	Alias string `json:"alias" example:"krapow"`
+	Term  string `json:"term" example:"kaphrao"`
+}
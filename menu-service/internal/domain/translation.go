@@ -0,0 +1,7 @@
+package domain
+
+type Translation struct {
+	Field  string `json:"field" example:"name"`
+	Locale string `json:"locale" example:"zh"`
+	Value  string `json:"value" example:"海南鸡饭"`
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+)
+
+func (i *Implement) ListAllCategory(ctx context.Context) (result []*domain.CategoryDetail, err error) {
+	return i.repository.ListAllCategory(ctx)
+}
+
+func (i *Implement) CreateCategory(ctx context.Context, payload domain.CategoryDetail) (result int64, err error) {
+	result, err = i.repository.CreateCategory(ctx, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	i.invalidateMenuCache()
+	return result, nil
+}
+
+func (i *Implement) UpdateCategory(ctx context.Context, payload domain.CategoryDetail) (err error) {
+	err = i.repository.UpdateCategory(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) UpdateCategorySortOrder(ctx context.Context, id int64, sortOrder int32) (err error) {
+	err = i.repository.UpdateCategorySortOrder(ctx, id, sortOrder)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) UpdateCategoryVisibility(ctx context.Context, id int64, isVisible bool) (err error) {
+	err = i.repository.UpdateCategoryVisibility(ctx, id, isVisible)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+)
+
+func (i *Implement) UpdateComboSlots(ctx context.Context, productID int64, slots []*domain.ComboSlot) (err error) {
+	slotNames := make(map[string]bool, len(slots))
+	var optionProductIDs []int64
+	for _, slot := range slots {
+		if slotNames[slot.NameEN] {
+			return exceptions.Error(exceptions.CodeConflict, fmt.Sprintf("combo slot '%s' is duplicated", slot.NameEN))
+		}
+		slotNames[slot.NameEN] = true
+
+		seen := make(map[int64]bool, len(slot.Options))
+		for _, option := range slot.Options {
+			if option.ProductID == productID {
+				return exceptions.Error(exceptions.CodeBusiness, "combo cannot include itself")
+			}
+
+			if seen[option.ProductID] {
+				return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' is duplicated in combo slot '%s'", option.ProductID, slot.NameEN))
+			}
+			seen[option.ProductID] = true
+			optionProductIDs = append(optionProductIDs, option.ProductID)
+		}
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	nestedCombos, err := i.repository.ListComboSlots(ctx, optionProductIDs)
+	if err != nil {
+		return err
+	}
+
+	for nestedID := range nestedCombos {
+		return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' is a combo and cannot be a combo option", nestedID))
+	}
+
+	err = i.repository.ReplaceComboSlots(ctx, productID, slots)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"strings"
+)
+
+func (i *Implement) ListDietaryTags(ctx context.Context) (result []*domain.DietaryTag, err error) {
+	return i.repository.ListDietaryTags(ctx)
+}
+
+func (i *Implement) UpdateProductDietaryTags(ctx context.Context, productID int64, codes []string) (err error) {
+	tags, err := i.repository.ListDietaryTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		known[tag.Code] = true
+	}
+
+	normalized := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !known[code] {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("dietary tag '%s' not found", code))
+		}
+		if seen[code] {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("dietary tag '%s' is duplicated", code))
+		}
+		seen[code] = true
+		normalized = append(normalized, code)
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.ReplaceProductDietaryTags(ctx, productID, normalized)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
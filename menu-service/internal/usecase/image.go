@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"image"
+	"image/jpeg"
+	_ "image/png" // register png decoder for image.Decode
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // register webp decoder for image.Decode
+)
+
+const (
+	MaxProductImageSize     = 5 << 20
+	_maxProductImagePixels  = 40_000_000
+	_thumbnailWidth         = 320
+	_thumbnailJPEGQuality   = 85
+	_productImageKeyPattern = "products/%d/%s%s"
+)
+
+var _productImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+func (i *Implement) UploadProductImage(ctx context.Context, productID int64, data []byte) (result *domain.ProductImage, err error) {
+	extension, thumbnail, err := processProductImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// a new name per upload keeps old order snapshots pointing at the image they were taken with
+	name := uuid.NewString()
+	imageKey := fmt.Sprintf(_productImageKeyPattern, productID, name, extension)
+	thumbnailKey := fmt.Sprintf(_productImageKeyPattern, productID, name, "_thumb.jpg")
+
+	imageURL, err := i.storage.Save(ctx, imageKey, data)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnailURL, err := i.storage.Save(ctx, thumbnailKey, thumbnail)
+	if err != nil {
+		i.deleteImages(ctx, imageKey)
+		return nil, err
+	}
+
+	result = &domain.ProductImage{
+		ImageURL:     imageURL,
+		ThumbnailURL: thumbnailURL,
+	}
+
+	err = i.repository.UpdateProductImage(ctx, productID, *result)
+	if err != nil {
+		i.deleteImages(ctx, imageKey, thumbnailKey)
+		return nil, err
+	}
+
+	i.invalidateMenuCache()
+	return result, nil
+}
+
+func (i *Implement) deleteImages(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := i.storage.Delete(ctx, key); err != nil {
+			slog.Error("failed to delete image", "key", key, "error", err)
+		}
+	}
+}
+
+// processProductImage validates the uploaded bytes by content rather than the client supplied
+// content type, and returns the file extension together with an encoded JPEG thumbnail.
+func processProductImage(data []byte) (extension string, thumbnail []byte, err error) {
+	if len(data) == 0 {
+		return "", nil, exceptions.Error(exceptions.CodeBusiness, "image file is empty")
+	}
+
+	if len(data) > MaxProductImageSize {
+		return "", nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("image file must not exceed %d MB", MaxProductImageSize>>20))
+	}
+
+	contentType := http.DetectContentType(data)
+	extension, ok := _productImageExtensions[contentType]
+	if !ok {
+		return "", nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("unsupported image type '%s', allowed types are jpeg, png and webp", contentType))
+	}
+
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, exceptions.Error(exceptions.CodeBusiness, "invalid image file")
+	}
+
+	if imageConfig.Width*imageConfig.Height > _maxProductImagePixels {
+		return "", nil, exceptions.Error(exceptions.CodeBusiness, "image dimensions are too large")
+	}
+
+	source, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, exceptions.Error(exceptions.CodeBusiness, "invalid image file")
+	}
+
+	thumbnail, err = resizeToJPEG(source, _thumbnailWidth)
+	if err != nil {
+		return "", nil, exceptions.Errorf(exceptions.CodeSystem, "failed to generate thumbnail", err)
+	}
+
+	return extension, thumbnail, nil
+}
+
+func resizeToJPEG(source image.Image, maxWidth int) ([]byte, error) {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > maxWidth {
+		height = max(1, height*maxWidth/width)
+		width = maxWidth
+	}
+
+	// JPEG has no alpha channel, so transparent areas are flattened onto white
+	target := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(target, target.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(target, target.Bounds(), source, bounds, draw.Over, nil)
+
+	buffer := new(bytes.Buffer)
+	err := jpeg.Encode(buffer, target, &jpeg.Options{Quality: _thumbnailJPEGQuality})
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	database "food-story/shared/database/sqlc"
+)
+
+func (i *Implement) ListIngredients(ctx context.Context) (result []*domain.Ingredient, err error) {
+	return i.repository.ListIngredients(ctx)
+}
+
+func (i *Implement) ListLowStockIngredients(ctx context.Context) (result []*domain.Ingredient, err error) {
+	return i.repository.ListLowStockIngredients(ctx)
+}
+
+func (i *Implement) CreateIngredient(ctx context.Context, payload domain.Ingredient) (result int64, err error) {
+	return i.repository.CreateIngredient(ctx, payload)
+}
+
+func (i *Implement) UpdateIngredient(ctx context.Context, payload domain.Ingredient) (err error) {
+	return i.repository.UpdateIngredient(ctx, payload)
+}
+
+func (i *Implement) CreateIngredientMovement(ctx context.Context, payload domain.IngredientMovement) (err error) {
+	if payload.Quantity == 0 {
+		return exceptions.Error(exceptions.CodeBusiness, "quantity must not be zero")
+	}
+
+	if payload.Reason == database.IngredientMovementRestock && payload.Quantity < 0 {
+		return exceptions.Error(exceptions.CodeBusiness, "restock quantity must be greater than zero")
+	}
+
+	err = i.repository.IsIngredientExists(ctx, payload.IngredientID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.CreateIngredientMovement(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) ListProductIngredients(ctx context.Context, productID int64) (result []*domain.ProductIngredient, err error) {
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.ListProductIngredients(ctx, productID)
+}
+
+func (i *Implement) UpdateProductIngredients(ctx context.Context, productID int64, ingredients []*domain.ProductIngredient) (err error) {
+	seen := make(map[int64]bool, len(ingredients))
+	for _, ingredient := range ingredients {
+		if seen[ingredient.IngredientID] {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("ingredient id '%d' is duplicated", ingredient.IngredientID))
+		}
+		seen[ingredient.IngredientID] = true
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	return i.repository.ReplaceProductIngredients(ctx, productID, ingredients)
+}
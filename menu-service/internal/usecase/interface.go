@@ -4,6 +4,7 @@ import (
 	"context"
 	"food-story/menu-service/internal/adapter/cache"
 	"food-story/menu-service/internal/adapter/repository"
+	"food-story/menu-service/internal/adapter/storage"
 	"food-story/menu-service/internal/domain"
 	"food-story/shared/config"
 
@@ -11,24 +12,75 @@ import (
 )
 
 type Usecase interface {
-	ListCategory(ctx context.Context) (result []*domain.Category, err error)
-	SearchProductByFilters(ctx context.Context, payload domain.SearchProduct) (result domain.SearchProductResult, err error)
-	GetProductByID(ctx context.Context, id int64) (result *domain.Product, err error)
-	IsSessionValid(sessionID uuid.UUID) error
+	ListCategory(ctx context.Context, locales []string, published bool) (result []*domain.Category, err error)
+	SearchProductByFilters(ctx context.Context, payload domain.SearchProduct, published bool) (result domain.SearchProductResult, err error)
+	GetProductByID(ctx context.Context, id int64, locales []string, published bool) (result *domain.Product, err error)
+	GetSessionLocale(sessionID uuid.UUID) (result string, err error)
 	ListProductTimeExtension(ctx context.Context) (result []*domain.Product, err error)
+	CreateProduct(ctx context.Context, payload domain.Product) (result int64, err error)
+	UpdateProduct(ctx context.Context, payload domain.Product) (err error)
+	UpdateProductAvailability(ctx context.Context, id int64, isAvailable bool) (err error)
+	UpdateProductStock(ctx context.Context, id int64, stockQuantity *int32) (err error)
+	UploadProductImage(ctx context.Context, productID int64, data []byte) (result *domain.ProductImage, err error)
+	UpdateProductVisibility(ctx context.Context, id int64, isVisible bool) (err error)
+	UpdateVariants(ctx context.Context, productID int64, variants []*domain.Variant) (err error)
+	UpdateComboSlots(ctx context.Context, productID int64, slots []*domain.ComboSlot) (err error)
+	UpdateModifierGroups(ctx context.Context, productID int64, groups []*domain.ModifierGroup) (err error)
+	ListProductSchedules(ctx context.Context, productID int64) (result []*domain.MenuSchedule, err error)
+	UpdateProductSchedules(ctx context.Context, productID int64, schedules []*domain.MenuSchedule) (err error)
+	ListAllCategory(ctx context.Context) (result []*domain.CategoryDetail, err error)
+	CreateCategory(ctx context.Context, payload domain.CategoryDetail) (result int64, err error)
+	UpdateCategory(ctx context.Context, payload domain.CategoryDetail) (err error)
+	UpdateCategorySortOrder(ctx context.Context, id int64, sortOrder int32) (err error)
+	UpdateCategoryVisibility(ctx context.Context, id int64, isVisible bool) (err error)
+	ListCategorySchedules(ctx context.Context, categoryID int64) (result []*domain.MenuSchedule, err error)
+	UpdateCategorySchedules(ctx context.Context, categoryID int64, schedules []*domain.MenuSchedule) (err error)
+	ListIngredients(ctx context.Context) (result []*domain.Ingredient, err error)
+	ListLowStockIngredients(ctx context.Context) (result []*domain.Ingredient, err error)
+	CreateIngredient(ctx context.Context, payload domain.Ingredient) (result int64, err error)
+	UpdateIngredient(ctx context.Context, payload domain.Ingredient) (err error)
+	CreateIngredientMovement(ctx context.Context, payload domain.IngredientMovement) (err error)
+	ListProductIngredients(ctx context.Context, productID int64) (result []*domain.ProductIngredient, err error)
+	UpdateProductIngredients(ctx context.Context, productID int64, ingredients []*domain.ProductIngredient) (err error)
+	ListSearchAliases(ctx context.Context) (result []*domain.SearchAlias, err error)
+	CreateSearchAlias(ctx context.Context, payload domain.SearchAlias) (result int64, err error)
+	DeleteSearchAlias(ctx context.Context, id int64) (err error)
+	ListProductSearchKeywords(ctx context.Context, productID int64) (result []string, err error)
+	UpdateProductSearchKeywords(ctx context.Context, productID int64, keywords []string) (err error)
+	ListDietaryTags(ctx context.Context) (result []*domain.DietaryTag, err error)
+	UpdateProductDietaryTags(ctx context.Context, productID int64, codes []string) (err error)
+	ListTranslations(ctx context.Context, entityType string, entityID int64) (result []*domain.Translation, err error)
+	UpdateTranslations(ctx context.Context, entityType string, entityID int64, translations []*domain.Translation) (err error)
+	ListProductPrices(ctx context.Context, productID int64) (result []*domain.ProductPrice, err error)
+	ScheduleProductPrice(ctx context.Context, productID int64, payload domain.ProductPrice) (result int64, err error)
+	CancelScheduledProductPrice(ctx context.Context, productID, priceID int64) (err error)
+	ListPromotions(ctx context.Context) (result []*domain.Promotion, err error)
+	CreatePromotion(ctx context.Context, payload domain.Promotion) (result int64, err error)
+	UpdatePromotion(ctx context.Context, payload domain.Promotion) (err error)
+	ListMenuVersions(ctx context.Context) (result []*domain.MenuVersion, err error)
+	PublishMenuVersion(ctx context.Context, payload domain.MenuVersion) (result int64, err error)
+	RollbackMenuVersion(ctx context.Context, id int64, note *string) (result int64, err error)
+	CancelScheduledMenuVersion(ctx context.Context, id int64) (err error)
+	RefreshRecommendations(ctx context.Context) (err error)
+	ListProductRecommendations(ctx context.Context, productID int64, payload domain.Recommendation, published bool) (result []*domain.Product, err error)
+	ListSessionRecommendations(ctx context.Context, sessionID uuid.UUID, payload domain.Recommendation, published bool) (result []*domain.Product, err error)
 }
 
 type Implement struct {
 	config     config.Config
 	repository repository.Implement
 	cache      cache.RedisTableCacheInterface
+	menuCache  cache.RedisMenuCacheInterface
+	storage    storage.ImageStorageInterface
 }
 
-func NewUsecase(config config.Config, repository repository.Implement, cache cache.RedisTableCacheInterface) *Implement {
+func NewUsecase(config config.Config, repository repository.Implement, cache cache.RedisTableCacheInterface, menuCache cache.RedisMenuCacheInterface, storage storage.ImageStorageInterface) *Implement {
 	return &Implement{
 		config,
 		repository,
 		cache,
+		menuCache,
+		storage,
 	}
 }
 
@@ -2,21 +2,167 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"food-story/menu-service/internal/domain"
+	"strings"
 )
 
-func (i *Implement) ListCategory(ctx context.Context) (result []*domain.Category, err error) {
-	return i.repository.ListCategory(ctx)
+func (i *Implement) ListCategory(ctx context.Context, locales []string, published bool) (result []*domain.Category, err error) {
+	menuVersionID, err := i.menuVersionID(ctx, published)
+	if err != nil {
+		return nil, err
+	}
+
+	locales, err = i.menuLocales(ctx, locales)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf(_menuCacheKeyCategory, menuVersionID, strings.Join(locales, ","))
+	if i.getCachedMenu(cacheKey, &result) {
+		return result, nil
+	}
+
+	result, err = i.repository.ListCategory(ctx, menuVersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.localizeCategories(ctx, locales, result)
+	if err != nil {
+		return nil, err
+	}
+
+	i.setCachedMenu(cacheKey, result)
+	return result, nil
 }
 
-func (i *Implement) SearchProductByFilters(ctx context.Context, payload domain.SearchProduct) (result domain.SearchProductResult, err error) {
-	return i.repository.SearchProduct(ctx, payload)
+func (i *Implement) SearchProductByFilters(ctx context.Context, payload domain.SearchProduct, published bool) (result domain.SearchProductResult, err error) {
+	payload.MenuVersionID, err = i.menuVersionID(ctx, published)
+	if err != nil {
+		return domain.SearchProductResult{}, err
+	}
+
+	payload.Locales, err = i.menuLocales(ctx, payload.Locales)
+	if err != nil {
+		return domain.SearchProductResult{}, err
+	}
+
+	cacheKey := searchProductCacheKey(payload)
+	if i.getCachedMenu(cacheKey, &result) {
+		return result, nil
+	}
+
+	result, err = i.repository.SearchProduct(ctx, payload)
+	if err != nil {
+		return domain.SearchProductResult{}, err
+	}
+
+	err = i.localizeProducts(ctx, payload.Locales, result.Data)
+	if err != nil {
+		return domain.SearchProductResult{}, err
+	}
+
+	i.setCachedMenu(cacheKey, result)
+	return result, nil
 }
 
-func (i *Implement) GetProductByID(ctx context.Context, id int64) (result *domain.Product, err error) {
-	return i.repository.GetProductByID(ctx, id)
+func (i *Implement) GetProductByID(ctx context.Context, id int64, locales []string, published bool) (result *domain.Product, err error) {
+	menuVersionID, err := i.menuVersionID(ctx, published)
+	if err != nil {
+		return nil, err
+	}
+
+	locales, err = i.menuLocales(ctx, locales)
+	if err != nil {
+		return nil, err
+	}
+
+	// staff and customers read the same product differently, even when no menu version is published yet
+	cacheKey := fmt.Sprintf(_menuCacheKeyProduct, id, menuVersionID, published, strings.Join(locales, ","))
+	if i.getCachedMenu(cacheKey, &result) {
+		return result, nil
+	}
+
+	result, err = i.repository.GetProductByID(ctx, id, menuVersionID, published)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.localizeProducts(ctx, locales, []*domain.Product{result})
+	if err != nil {
+		return nil, err
+	}
+
+	i.setCachedMenu(cacheKey, result)
+	return result, nil
 }
 
 func (i *Implement) ListProductTimeExtension(ctx context.Context) (result []*domain.Product, err error) {
 	return i.repository.ListProductTimeExtension(ctx)
 }
+
+func (i *Implement) CreateProduct(ctx context.Context, payload domain.Product) (result int64, err error) {
+	result, err = i.repository.CreateProduct(ctx, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	i.invalidateMenuCache()
+	return result, nil
+}
+
+func (i *Implement) UpdateProduct(ctx context.Context, payload domain.Product) (err error) {
+	err = i.repository.IsProductExists(ctx, payload.ID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.UpdateProduct(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) UpdateProductAvailability(ctx context.Context, id int64, isAvailable bool) (err error) {
+	err = i.repository.IsProductExists(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.UpdateProductAvailability(ctx, id, isAvailable)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) UpdateProductStock(ctx context.Context, id int64, stockQuantity *int32) (err error) {
+	err = i.repository.UpdateProductStock(ctx, id, stockQuantity)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) UpdateProductVisibility(ctx context.Context, id int64, isVisible bool) (err error) {
+	err = i.repository.IsProductExists(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.UpdateProductVisibility(ctx, id, isVisible)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"log/slog"
+)
+
+const (
+	_menuCacheKeyCategory       = "category:%d:%s"
+	_menuCacheKeySearch         = "search:%s"
+	_menuCacheKeyProduct        = "product:%d:%d:%t:%s"
+	_menuCacheKeyRecommendation = "recommendation:%s"
+	_menuCacheKeyLocales        = "locales"
+)
+
+// cache errors never fail a request, the menu is always readable straight from the database
+
+func (i *Implement) getCachedMenu(key string, result any) bool {
+	found, err := i.menuCache.GetCachedMenu(key, result)
+	if err != nil {
+		slog.Warn("failed to read menu cache", "key", key, "error", err)
+		return false
+	}
+	return found
+}
+
+func (i *Implement) setCachedMenu(key string, value any) {
+	err := i.menuCache.SetCachedMenu(key, value)
+	if err != nil {
+		slog.Warn("failed to write menu cache", "key", key, "error", err)
+	}
+}
+
+func (i *Implement) invalidateMenuCache() {
+	err := i.menuCache.InvalidateMenu()
+	if err != nil {
+		slog.Error("failed to invalidate menu cache", "error", err)
+	}
+}
+
+func (i *Implement) getCachedRecommendations(key string, result any) bool {
+	found, err := i.menuCache.GetCachedRecommendations(key, result)
+	if err != nil {
+		slog.Warn("failed to read recommendation cache", "key", key, "error", err)
+		return false
+	}
+	return found
+}
+
+func (i *Implement) setCachedRecommendations(key string, value any) {
+	err := i.menuCache.SetCachedRecommendations(key, value)
+	if err != nil {
+		slog.Warn("failed to write recommendation cache", "key", key, "error", err)
+	}
+}
+
+func (i *Implement) invalidateRecommendationCache() {
+	err := i.menuCache.InvalidateRecommendations()
+	if err != nil {
+		slog.Error("failed to invalidate recommendation cache", "error", err)
+	}
+}
+
+func searchProductCacheKey(payload domain.SearchProduct) string {
+	data, _ := json.Marshal(payload)
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf(_menuCacheKeySearch, hex.EncodeToString(sum[:16]))
+}
+
+func recommendationCacheKey(payload domain.Recommendation) string {
+	data, _ := json.Marshal(payload)
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf(_menuCacheKeyRecommendation, hex.EncodeToString(sum[:16]))
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+)
+
+// menuVersionID resolves the version customers are shown right now, a scheduled version takes over as soon as
+// its publish time passes. Staff reads and an unpublished menu use the live tables (0).
+func (i *Implement) menuVersionID(ctx context.Context, published bool) (int64, error) {
+	if !published {
+		return 0, nil
+	}
+	return i.repository.GetPublishedMenuVersionID(ctx)
+}
+
+func (i *Implement) ListMenuVersions(ctx context.Context) (result []*domain.MenuVersion, err error) {
+	return i.repository.ListMenuVersions(ctx)
+}
+
+func (i *Implement) PublishMenuVersion(ctx context.Context, payload domain.MenuVersion) (result int64, err error) {
+	snapshot, err := i.repository.BuildMenuSnapshot(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	payload.RollbackOf = nil
+	result, err = i.repository.CreateMenuVersion(ctx, snapshot, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	i.invalidateMenuCache()
+	return result, nil
+}
+
+// RollbackMenuVersion republishes an earlier version as a new one, so the history stays append-only.
+func (i *Implement) RollbackMenuVersion(ctx context.Context, id int64, note *string) (result int64, err error) {
+	snapshot, isPublished, err := i.repository.GetMenuVersionSnapshot(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	if !isPublished {
+		return 0, exceptions.Error(exceptions.CodeBusiness, "only a version that has been published can be rolled back to")
+	}
+
+	result, err = i.repository.CreateMenuVersion(ctx, snapshot, domain.MenuVersion{
+		Note:       note,
+		RollbackOf: &id,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	i.invalidateMenuCache()
+	return result, nil
+}
+
+func (i *Implement) CancelScheduledMenuVersion(ctx context.Context, id int64) (err error) {
+	err = i.repository.DeleteScheduledMenuVersion(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+)
+
+func (i *Implement) UpdateModifierGroups(ctx context.Context, productID int64, groups []*domain.ModifierGroup) (err error) {
+	for _, group := range groups {
+		if group.MinSelections > group.MaxSelections {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("modifier group '%s' min selections must not exceed max selections", group.NameEN))
+		}
+
+		if int(group.MaxSelections) > len(group.Modifiers) {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("modifier group '%s' max selections must not exceed number of modifiers", group.NameEN))
+		}
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.ReplaceModifierGroups(ctx, productID, groups)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
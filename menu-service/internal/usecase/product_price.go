@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+)
+
+func (i *Implement) ListProductPrices(ctx context.Context, productID int64) (result []*domain.ProductPrice, err error) {
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.ListProductPrices(ctx, productID)
+}
+
+// ScheduleProductPrice needs no cache invalidation, menu reads resolve the price in effect at read time and the
+// menu cache TTL bounds how long the old price is served once the new one starts.
+func (i *Implement) ScheduleProductPrice(ctx context.Context, productID int64, payload domain.ProductPrice) (result int64, err error) {
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	return i.repository.CreateScheduledProductPrice(ctx, productID, payload)
+}
+
+func (i *Implement) CancelScheduledProductPrice(ctx context.Context, productID, priceID int64) (err error) {
+	return i.repository.DeleteScheduledProductPrice(ctx, productID, priceID)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	shareModel "food-story/shared/model"
+	"time"
+)
+
+func (i *Implement) ListPromotions(ctx context.Context) (result []*domain.Promotion, err error) {
+	return i.repository.ListPromotions(ctx)
+}
+
+func (i *Implement) CreatePromotion(ctx context.Context, payload domain.Promotion) (result int64, err error) {
+	err = validatePromotion(&payload)
+	if err != nil {
+		return 0, err
+	}
+
+	return i.repository.CreatePromotion(ctx, payload)
+}
+
+func (i *Implement) UpdatePromotion(ctx context.Context, payload domain.Promotion) (err error) {
+	err = validatePromotion(&payload)
+	if err != nil {
+		return err
+	}
+
+	return i.repository.UpdatePromotion(ctx, payload)
+}
+
+func validatePromotion(payload *domain.Promotion) error {
+	if (payload.ProductID == nil) == (payload.CategoryID == nil) {
+		return exceptions.Error(exceptions.CodeBusiness, "promotion must apply to either a product or a category")
+	}
+
+	switch payload.PromotionType {
+	case shareModel.PromotionTypePercentage:
+		if payload.DiscountValue <= 0 || payload.DiscountValue > 100 {
+			return exceptions.Error(exceptions.CodeBusiness, "percentage discount must be between 0 and 100")
+		}
+	case shareModel.PromotionTypeFixedAmount:
+		if payload.DiscountValue <= 0 {
+			return exceptions.Error(exceptions.CodeBusiness, "fixed discount must be greater than 0")
+		}
+	case shareModel.PromotionTypeBuyXGetY:
+		if payload.BuyQuantity == nil || payload.FreeQuantity == nil {
+			return exceptions.Error(exceptions.CodeBusiness, "buy x get y requires buyQuantity and freeQuantity")
+		}
+		payload.DiscountValue = 0
+	}
+
+	if payload.PromotionType != shareModel.PromotionTypeBuyXGetY {
+		payload.BuyQuantity, payload.FreeQuantity = nil, nil
+	}
+
+	if (payload.StartTime == nil) != (payload.EndTime == nil) {
+		return exceptions.Error(exceptions.CodeBusiness, "startTime and endTime must be set together")
+	}
+
+	if payload.StartTime != nil && *payload.StartTime >= *payload.EndTime {
+		return exceptions.Error(exceptions.CodeBusiness, "startTime must be before endTime")
+	}
+
+	if payload.StartsAt != nil && payload.EndsAt != nil {
+		startsAt, err := time.Parse(time.RFC3339, *payload.StartsAt)
+		if err != nil {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+
+		endsAt, err := time.Parse(time.RFC3339, *payload.EndsAt)
+		if err != nil {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+
+		if !startsAt.Before(endsAt) {
+			return exceptions.Error(exceptions.CodeBusiness, "startsAt must be before endsAt")
+		}
+	}
+
+	if len(payload.DaysOfWeek) == 0 {
+		payload.DaysOfWeek = nil
+	}
+
+	return nil
+}
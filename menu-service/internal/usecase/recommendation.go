@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"food-story/menu-service/internal/domain"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RecommendationRefreshInterval is how often the background job recomputes "frequently ordered together"
+	RecommendationRefreshInterval = time.Hour
+
+	// only recent orders count, so seasonal menus are not recommended long after they change
+	_recommendationLookbackDays = 90
+
+	// a pair must appear in at least this many orders before it is recommended
+	_recommendationMinOrderCount = 3
+
+	_recommendationDefaultLimit = 5
+)
+
+func (i *Implement) RefreshRecommendations(ctx context.Context) (err error) {
+	rows, refreshed, err := i.repository.RefreshProductAssociations(ctx, _recommendationLookbackDays, _recommendationMinOrderCount)
+	if err != nil {
+		return err
+	}
+
+	if !refreshed {
+		slog.Info("product associations are being refreshed by another instance")
+		return nil
+	}
+
+	slog.Info("product associations refreshed", "pairs", rows)
+	i.invalidateRecommendationCache()
+	return nil
+}
+
+func (i *Implement) ListProductRecommendations(ctx context.Context, productID int64, payload domain.Recommendation, published bool) (result []*domain.Product, err error) {
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.ProductIDs = []int64{productID}
+	return i.listRecommendations(ctx, payload, published)
+}
+
+// ListSessionRecommendations recommends on top of what the session has already ordered plus payload.ProductIDs,
+// the items still in the customer's cart.
+func (i *Implement) ListSessionRecommendations(ctx context.Context, sessionID uuid.UUID, payload domain.Recommendation, published bool) (result []*domain.Product, err error) {
+	ordered, err := i.repository.ListSessionOrderedProductIDs(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, productID := range ordered {
+		if !slices.Contains(payload.ProductIDs, productID) {
+			payload.ProductIDs = append(payload.ProductIDs, productID)
+		}
+	}
+
+	if len(payload.ProductIDs) == 0 {
+		return []*domain.Product{}, nil
+	}
+
+	return i.listRecommendations(ctx, payload, published)
+}
+
+func (i *Implement) listRecommendations(ctx context.Context, payload domain.Recommendation, published bool) (result []*domain.Product, err error) {
+	payload.MenuVersionID, err = i.menuVersionID(ctx, published)
+	if err != nil {
+		return nil, err
+	}
+
+	payload.Locales, err = i.menuLocales(ctx, payload.Locales)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Limit <= 0 {
+		payload.Limit = _recommendationDefaultLimit
+	}
+
+	slices.Sort(payload.ProductIDs)
+	cacheKey := recommendationCacheKey(payload)
+	if i.getCachedRecommendations(cacheKey, &result) {
+		return result, nil
+	}
+
+	result, err = i.repository.ListRecommendedProducts(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.localizeProducts(ctx, payload.Locales, result)
+	if err != nil {
+		return nil, err
+	}
+
+	i.setCachedRecommendations(cacheKey, result)
+	return result, nil
+}
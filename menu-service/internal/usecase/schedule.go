@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+)
+
+func (i *Implement) ListProductSchedules(ctx context.Context, productID int64) (result []*domain.MenuSchedule, err error) {
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.ListProductSchedules(ctx, productID)
+}
+
+func (i *Implement) UpdateProductSchedules(ctx context.Context, productID int64, schedules []*domain.MenuSchedule) (err error) {
+	err = validateSchedules(schedules)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.ReplaceProductSchedules(ctx, productID, schedules)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) ListCategorySchedules(ctx context.Context, categoryID int64) (result []*domain.MenuSchedule, err error) {
+	err = i.repository.IsCategoryExists(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.ListCategorySchedules(ctx, categoryID)
+}
+
+func (i *Implement) UpdateCategorySchedules(ctx context.Context, categoryID int64, schedules []*domain.MenuSchedule) (err error) {
+	err = validateSchedules(schedules)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.IsCategoryExists(ctx, categoryID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.ReplaceCategorySchedules(ctx, categoryID, schedules)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func validateSchedules(schedules []*domain.MenuSchedule) error {
+	for _, schedule := range schedules {
+		// start time หลัง end time คือช่วงข้ามเที่ยงคืน ห้ามแค่ช่วงที่ยาวเป็นศูนย์
+		if schedule.StartTime == schedule.EndTime {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("schedule start time '%s' must differ from end time '%s'", schedule.StartTime, schedule.EndTime))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"strings"
+)
+
+func (i *Implement) ListSearchAliases(ctx context.Context) (result []*domain.SearchAlias, err error) {
+	return i.repository.ListSearchAliases(ctx)
+}
+
+func (i *Implement) CreateSearchAlias(ctx context.Context, payload domain.SearchAlias) (result int64, err error) {
+	payload.Alias = strings.ToLower(strings.TrimSpace(payload.Alias))
+	payload.Term = strings.ToLower(strings.TrimSpace(payload.Term))
+	if payload.Alias == payload.Term {
+		return 0, exceptions.Error(exceptions.CodeBusiness, "alias must differ from term")
+	}
+
+	result, err = i.repository.CreateSearchAlias(ctx, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	i.invalidateMenuCache()
+	return result, nil
+}
+
+func (i *Implement) DeleteSearchAlias(ctx context.Context, id int64) (err error) {
+	err = i.repository.DeleteSearchAlias(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+func (i *Implement) ListProductSearchKeywords(ctx context.Context, productID int64) (result []string, err error) {
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.repository.ListProductSearchKeywords(ctx, productID)
+}
+
+func (i *Implement) UpdateProductSearchKeywords(ctx context.Context, productID int64, keywords []string) (err error) {
+	normalized := make([]string, 0, len(keywords))
+	seen := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" {
+			return exceptions.Error(exceptions.CodeBusiness, "keyword must not be empty")
+		}
+		if seen[keyword] {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("keyword '%s' is duplicated", keyword))
+		}
+		seen[keyword] = true
+		normalized = append(normalized, keyword)
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.ReplaceProductSearchKeywords(ctx, productID, normalized)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
@@ -4,6 +4,6 @@ import (
 	"github.com/google/uuid"
 )
 
-func (i *Implement) IsSessionValid(sessionID uuid.UUID) error {
-	return i.cache.IsCachedTableExist(sessionID)
+func (i *Implement) GetSessionLocale(sessionID uuid.UUID) (result string, err error) {
+	return i.cache.GetCachedSessionLocale(sessionID)
 }
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	"food-story/shared/i18n"
+	"slices"
+	"strings"
+)
+
+func (i *Implement) ListTranslations(ctx context.Context, entityType string, entityID int64) (result []*domain.Translation, err error) {
+	return i.repository.ListTranslations(ctx, entityType, entityID)
+}
+
+func (i *Implement) UpdateTranslations(ctx context.Context, entityType string, entityID int64, translations []*domain.Translation) (err error) {
+	seen := make(map[string]bool, len(translations))
+	for _, translation := range translations {
+		translation.Locale = utils.NormalizeLocale(translation.Locale)
+		translation.Value = strings.TrimSpace(translation.Value)
+
+		if translation.Locale == utils.LocaleThai || translation.Locale == utils.LocaleEnglish {
+			return exceptions.Error(exceptions.CodeBusiness, "thai and english are taken from name and nameEN")
+		}
+
+		if translation.Field == i18n.FieldDescription && entityType != i18n.EntityProduct {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("%s has no description", entityType))
+		}
+
+		if translation.Value == "" {
+			return exceptions.Error(exceptions.CodeBusiness, "translation value must not be empty")
+		}
+
+		key := translation.Field + ":" + translation.Locale
+		if seen[key] {
+			return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("translation '%s' for locale '%s' is duplicated", translation.Field, translation.Locale))
+		}
+		seen[key] = true
+	}
+
+	err = i.repository.ReplaceTranslations(ctx, entityType, entityID, translations)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
+
+// menuLocales drops the locales nobody has translated the menu into, so a made-up Accept-Language
+// reads the same cached menu as plain English instead of creating a cache entry of its own.
+func (i *Implement) menuLocales(ctx context.Context, locales []string) ([]string, error) {
+	var translated []string
+	if !i.getCachedMenu(_menuCacheKeyLocales, &translated) {
+		var err error
+		translated, err = i.repository.ListTranslationLocales(ctx)
+		if err != nil {
+			return nil, err
+		}
+		i.setCachedMenu(_menuCacheKeyLocales, translated)
+	}
+
+	result := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		if locale == utils.LocaleThai || locale == utils.LocaleEnglish || slices.Contains(translated, locale) {
+			result = append(result, locale)
+		}
+	}
+
+	return result, nil
+}
+
+func (i *Implement) localizeCategories(ctx context.Context, locales []string, categories []*domain.Category) error {
+	entityIDs := make([]int64, len(categories))
+	for index, category := range categories {
+		entityIDs[index] = category.ID
+	}
+
+	translations, err := i.repository.LoadTranslations(ctx, locales, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, category := range categories {
+		category.LocalizedName = translations.Name(i18n.EntityCategory, category.ID, category.Name, category.NameEn)
+	}
+
+	return nil
+}
+
+func (i *Implement) localizeProducts(ctx context.Context, locales []string, products []*domain.Product) error {
+	var entityIDs []int64
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		entityIDs = append(entityIDs, product.ID, product.CategoryID)
+		for _, variant := range product.Variants {
+			entityIDs = append(entityIDs, variant.ID)
+		}
+		for _, group := range product.ModifierGroups {
+			entityIDs = append(entityIDs, group.ID)
+			for _, modifier := range group.Modifiers {
+				entityIDs = append(entityIDs, modifier.ID)
+			}
+		}
+		for _, slot := range product.ComboSlots {
+			entityIDs = append(entityIDs, slot.ID)
+			for _, option := range slot.Options {
+				entityIDs = append(entityIDs, option.ProductID)
+			}
+		}
+	}
+
+	translations, err := i.repository.LoadTranslations(ctx, locales, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+
+		product.LocalizedName = translations.Name(i18n.EntityProduct, product.ID, product.Name, product.NameEN)
+		product.LocalizedCategoryName = translations.Name(i18n.EntityCategory, product.CategoryID, product.CategoryName, product.CategoryNameEN)
+		if product.Description != nil {
+			description := translations.Text(i18n.EntityProduct, product.ID, i18n.FieldDescription, *product.Description, "")
+			product.LocalizedDescription = &description
+		}
+
+		for _, variant := range product.Variants {
+			variant.LocalizedName = translations.Name(i18n.EntityVariant, variant.ID, variant.Name, variant.NameEN)
+		}
+		for _, group := range product.ModifierGroups {
+			group.LocalizedName = translations.Name(i18n.EntityModifierGroup, group.ID, group.Name, group.NameEN)
+			for _, modifier := range group.Modifiers {
+				modifier.LocalizedName = translations.Name(i18n.EntityModifier, modifier.ID, modifier.Name, modifier.NameEN)
+			}
+		}
+		for _, slot := range product.ComboSlots {
+			slot.LocalizedName = translations.Name(i18n.EntityComboSlot, slot.ID, slot.Name, slot.NameEN)
+			for _, option := range slot.Options {
+				option.LocalizedName = translations.Name(i18n.EntityProduct, option.ProductID, option.Name, option.NameEN)
+			}
+		}
+	}
+
+	return nil
+}
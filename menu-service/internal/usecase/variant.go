@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"food-story/menu-service/internal/domain"
+	"food-story/pkg/exceptions"
+)
+
+func (i *Implement) UpdateVariants(ctx context.Context, productID int64, variants []*domain.Variant) (err error) {
+	names := make(map[string]bool, len(variants))
+	namesEN := make(map[string]bool, len(variants))
+	for _, variant := range variants {
+		if names[variant.Name] || namesEN[variant.NameEN] {
+			return exceptions.Error(exceptions.CodeConflict, fmt.Sprintf("variant '%s' is duplicated", variant.NameEN))
+		}
+		names[variant.Name] = true
+		namesEN[variant.NameEN] = true
+	}
+
+	err = i.repository.IsProductExists(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	err = i.repository.ReplaceVariants(ctx, productID, variants)
+	if err != nil {
+		return err
+	}
+
+	i.invalidateMenuCache()
+	return nil
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"food-story/order-service/docs"
+	"food-story/order-service/internal/adapter/job"
+	"food-story/order-service/internal/adapter/queue/consumer"
 	"food-story/order-service/internal/app"
+	"food-story/order-service/internal/usecase"
 	"food-story/pkg/common"
 	"food-story/shared/config"
+	"food-story/shared/kafka"
 	"log"
 	"os/signal"
 	"strconv"
@@ -15,7 +19,7 @@ import (
 	"time"
 )
 
-func gracefulShutdown(fiberServer *app.FiberServer, done chan bool) {
+func gracefulShutdown(fiberServer *app.FiberServer, cancelRelay context.CancelFunc, cancelConsumer context.CancelFunc, done chan bool) {
 	// Create context that listens for the interrupt signal from the OS.
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -31,6 +35,19 @@ func gracefulShutdown(fiberServer *app.FiberServer, done chan bool) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// stop the outbox relay before the producer closes, unsent events are picked up on the next start
+	cancelRelay()
+	log.Println("Outbox relay stopped")
+
+	// close consumer and end open streams
+	if err := fiberServer.KafkaConsumer.Close(); err != nil {
+		log.Printf("close consumer error: %v", err)
+	}
+	cancelConsumer()
+	fiberServer.OrderStatusHub.Shutdown()
+	fiberServer.ServiceRequestHub.Shutdown()
+	log.Println("Kafka Consumer closed")
+
 	// close all connection
 	fiberServer.CloseAllConnection()
 
@@ -56,6 +73,14 @@ func main() {
 	port, _ := strconv.Atoi(server.Config.AppPort)
 	initSwagger(server.Config)
 
+	// start outbox relay
+	ctxRelay, cancelRelay := context.WithCancel(context.Background())
+	go job.RunOutboxRelay(ctxRelay, server.UseCase, usecase.OutboxRelayInterval, usecase.OutboxCleanupInterval)
+
+	// เริ่มต้น Kafka Consumer สำหรับส่งสถานะรายการอาหารให้ลูกค้าและคำขอจากโต๊ะให้พนักงาน
+	ctxConsumer, cancelConsumer := context.WithCancel(context.Background())
+	go consumer.Run(ctxConsumer, []string{kafka.OrderItemsStatusChangedTopic, kafka.TableServiceRequestsChangedTopic}, server.KafkaConsumer, server.OrderStatusHub, server.ServiceRequestHub)
+
 	// Create a done channel to signal when the shutdown is complete
 	done := make(chan bool, 1)
 
@@ -67,7 +92,7 @@ func main() {
 	}()
 
 	// Run graceful shutdown in a separate goroutine
-	go gracefulShutdown(server, done)
+	go gracefulShutdown(server, cancelRelay, cancelConsumer, done)
 
 	// Wait for the graceful shutdown to complete
 	<-done
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/shared/redis"
+	"time"
+)
+
+const (
+	// idempotencyTTL is how long a retry with the same key replays the first response
+	idempotencyTTL = 24 * time.Hour
+
+	// idempotencyLockTTL releases the key of a request that died mid-way, so the client can retry it
+	idempotencyLockTTL = time.Minute
+)
+
+type RedisIdempotencyCacheInterface interface {
+	ReserveIdempotencyKey(key string, fingerprint string) (bool, error)
+	GetIdempotentResponse(key string) (*domain.IdempotentResponse, error)
+	SaveIdempotentResponse(key string, response domain.IdempotentResponse) error
+	ReleaseIdempotencyKey(key string) error
+}
+
+type RedisIdempotencyCache struct {
+	client redis.RedisInterface
+}
+
+func NewRedisIdempotencyCache(client *redis.RedisClient) *RedisIdempotencyCache {
+	return &RedisIdempotencyCache{
+		client: client,
+	}
+}
+
+func (r *RedisIdempotencyCache) ReserveIdempotencyKey(key string, fingerprint string) (bool, error) {
+	data, err := json.Marshal(domain.IdempotentResponse{Fingerprint: fingerprint})
+	if err != nil {
+		return false, exceptions.Errorf(exceptions.CodeSystem, "failed to marshal idempotency key", err)
+	}
+
+	reserved, err := r.client.SetNX(redis.KeyIdempotency+key, string(data), idempotencyLockTTL)
+	if err != nil {
+		return false, exceptions.Errorf(exceptions.CodeRedis, "failed to reserve idempotency key", err)
+	}
+
+	return reserved, nil
+}
+
+func (r *RedisIdempotencyCache) GetIdempotentResponse(key string) (*domain.IdempotentResponse, error) {
+	data, err := r.client.Get(redis.KeyIdempotency + key)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRedisKeyNotFound) {
+			return nil, nil
+		}
+		return nil, exceptions.Errorf(exceptions.CodeRedis, "failed to get idempotent response", err)
+	}
+
+	var response domain.IdempotentResponse
+	err = json.Unmarshal([]byte(data), &response)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRedis, "failed to unmarshal idempotent response", err)
+	}
+
+	return &response, nil
+}
+
+func (r *RedisIdempotencyCache) SaveIdempotentResponse(key string, response domain.IdempotentResponse) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeSystem, "failed to marshal idempotent response", err)
+	}
+
+	err = r.client.Set(redis.KeyIdempotency+key, string(data), idempotencyTTL)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRedis, "failed to save idempotent response", err)
+	}
+
+	return nil
+}
+
+func (r *RedisIdempotencyCache) ReleaseIdempotencyKey(key string) error {
+	err := r.client.Del(redis.KeyIdempotency + key)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRedis, "failed to release idempotency key", err)
+	}
+	return nil
+}
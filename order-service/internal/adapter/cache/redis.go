@@ -14,7 +14,6 @@ import (
 type RedisTableCacheInterface interface {
 	GetCachedTable(sessionID uuid.UUID) (*domain.CurrentTableSession, error)
 	DeleteCachedTable(sessionID uuid.UUID) error
-	IsCachedTableExist(sessionID uuid.UUID) error
 	UpdateOrderID(sessionID uuid.UUID, orderID int64) error
 }
 
@@ -46,19 +45,6 @@ func (r *RedisTableCache) GetCachedTable(sessionID uuid.UUID) (*domain.CurrentTa
 	return &table, nil
 }
 
-func (r *RedisTableCache) IsCachedTableExist(sessionID uuid.UUID) error {
-	_, err := r.client.Get(redis.KeyTable + sessionID.String())
-	if err != nil {
-		if errors.Is(err, exceptions.ErrRedisKeyNotFound) {
-			return exceptions.ErrRedisKeyNotFoundException
-		}
-
-		return exceptions.Errorf(exceptions.CodeRedis, "failed to get cached table", err)
-	}
-
-	return nil
-}
-
 func (r *RedisTableCache) DeleteCachedTable(sessionID uuid.UUID) error {
 	err := r.client.Del(redis.KeyTable + sessionID.String())
 	if err != nil {
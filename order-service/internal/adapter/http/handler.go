@@ -6,6 +6,7 @@ import (
 	"food-story/pkg/middleware"
 	"food-story/pkg/utils"
 	shareModel "food-story/shared/model"
+	"food-story/shared/orderstatus"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -18,11 +19,13 @@ import (
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Idempotency-Key header string false "Retries with the same key replay the first response instead of ordering again"
 // @Param order body OrderItems true "Order item details"
 // @Success 201 {object} middleware.SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /current [post]
 func (s *Handler) CreateOrder(c *fiber.Ctx) error {
@@ -47,10 +50,25 @@ func (s *Handler) CreateOrder(c *fiber.Ctx) error {
 		if err != nil {
 			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 		}
+		variantID, err := parseVariantID(item.VariantID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		modifiers, err := parseModifiers(item.Modifiers)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		comboSelections, err := parseComboSelections(item.ComboSelections)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
 		items = append(items, shareModel.OrderItems{
-			ProductID: productID,
-			Quantity:  item.Quantity,
-			Note:      item.Note,
+			ProductID:       productID,
+			Quantity:        item.Quantity,
+			Note:            item.Note,
+			VariantID:       variantID,
+			Modifiers:       modifiers,
+			ComboSelections: comboSelections,
 		})
 	}
 
@@ -69,6 +87,7 @@ func (s *Handler) CreateOrder(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
 // @Success 200 {object} CurrentOrderResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
@@ -81,7 +100,7 @@ func (s *Handler) GetCurrentOrderByID(c *fiber.Ctx) error {
 		return err
 	}
 
-	result, err := s.useCase.GetOrderByID(c.Context(), sessionID)
+	result, err := s.useCase.GetOrderByID(c.Context(), sessionID, middleware.RequestLocales(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
@@ -101,11 +120,13 @@ func (s *Handler) GetCurrentOrderByID(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Idempotency-Key header string false "Retries with the same key replay the first response instead of ordering again"
 // @Param order body OrderItems true "Order items to add"
 // @Success 201 {object} middleware.SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /current/items [post]
 func (s *Handler) CreateOrderItems(c *fiber.Ctx) error {
@@ -129,10 +150,25 @@ func (s *Handler) CreateOrderItems(c *fiber.Ctx) error {
 		if err != nil {
 			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 		}
+		variantID, err := parseVariantID(item.VariantID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		modifiers, err := parseModifiers(item.Modifiers)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		comboSelections, err := parseComboSelections(item.ComboSelections)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
 		items = append(items, shareModel.OrderItems{
-			ProductID: productID,
-			Quantity:  item.Quantity,
-			Note:      item.Note,
+			ProductID:       productID,
+			Quantity:        item.Quantity,
+			Note:            item.Note,
+			VariantID:       variantID,
+			Modifiers:       modifiers,
+			ComboSelections: comboSelections,
 		})
 	}
 	err = s.useCase.CreateOrderItems(c.Context(), sessionID, items)
@@ -151,6 +187,7 @@ func (s *Handler) CreateOrderItems(c *fiber.Ctx) error {
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
 // @Param page_number query int false "Page number for pagination" default(1)
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
 // @Success 200 {object} middleware.SuccessResponse{data=domain.SearchCurrentOrderItemsResult}
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
@@ -172,7 +209,7 @@ func (s *Handler) GetCurrentOrderItems(c *fiber.Ctx) error {
 		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 	}
 
-	result, err := s.useCase.GetCurrentOrderItems(c.Context(), sessionID, body.PageNumber, body.PageSize)
+	result, err := s.useCase.GetCurrentOrderItems(c.Context(), sessionID, body.PageNumber, body.PageSize, middleware.RequestLocales(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
@@ -194,6 +231,7 @@ func (s *Handler) GetCurrentOrderItems(c *fiber.Ctx) error {
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
 // @Param orderItemsID path string true "Order Item ID"
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
 // @Success 200 {object} middleware.SuccessResponse{data=model.OrderItems}
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
@@ -212,7 +250,7 @@ func (s *Handler) GetCurrentOrderItemsByID(c *fiber.Ctx) error {
 		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 	}
 
-	result, err := s.useCase.GetCurrentOrderItemsByID(c.Context(), sessionID, orderItemsID)
+	result, err := s.useCase.GetCurrentOrderItemsByID(c.Context(), sessionID, orderItemsID, middleware.RequestLocales(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
@@ -233,6 +271,7 @@ func (s *Handler) GetCurrentOrderItemsByID(c *fiber.Ctx) error {
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /current/items/{orderItemsID}/status/cancel [patch]
 func (s *Handler) UpdateCurrentOrderItemsStatusCancel(c *fiber.Ctx) error {
@@ -248,7 +287,8 @@ func (s *Handler) UpdateCurrentOrderItemsStatusCancel(c *fiber.Ctx) error {
 
 	err = s.useCase.UpdateOrderItemsStatus(c.Context(), sessionID, shareModel.OrderItemsStatus{
 		ID:         orderItemsID,
-		StatusCode: "CANCELLED",
+		StatusCode: orderstatus.Cancelled,
+		Actor:      orderstatus.ActorCustomer,
 	})
 	if err != nil {
 		return middleware.ResponseError(c, err)
@@ -257,6 +297,47 @@ func (s *Handler) UpdateCurrentOrderItemsStatusCancel(c *fiber.Ctx) error {
 	return middleware.ResponseOK(c, nil)
 }
 
+// AmendCurrentOrderItem godoc
+// @Summary Amend order item
+// @Description Change quantity, note and modifiers of an item in the current table session. Only items that are still pending or confirmed can be changed, the kitchen display updates the ticket in place
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param X-Session-Id header string true "Session ID"
+// @Param orderItemsID path string true "Order Item ID"
+// @Param item body AmendOrderItem true "New quantity, note and modifiers"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /current/items/{orderItemsID} [put]
+func (s *Handler) AmendCurrentOrderItem(c *fiber.Ctx) error {
+	sessionID, err := getSession(c)
+	if err != nil {
+		return err
+	}
+
+	orderItemsID, err := utils.StrToInt64(c.Params("orderItemsID"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	payload, err := s.parseAmendOrderItem(c, orderItemsID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	err = s.useCase.AmendCurrentOrderItem(c.Context(), sessionID, payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
 // SearchOrderItemsInComplete godoc
 // @Summary Search incomplete order items
 // @Description Search incomplete order items with filters
@@ -271,6 +352,7 @@ func (s *Handler) UpdateCurrentOrderItemsStatusCancel(c *fiber.Ctx) error {
 // @Param statusCode query []string false "Filter by status codes"
 // @Param orderBy query string false "Order by field"
 // @Param orderType query string false "Order direction (asc, desc)"
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
 // @Success 200 {object} middleware.SuccessResponse{data=domain.SearchOrderItemsResult}
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
@@ -306,7 +388,7 @@ func (s *Handler) SearchOrderItemsInComplete(c *fiber.Ctx) error {
 		PageNumber:  body.PageNumber,
 	}
 
-	result, err := s.useCase.SearchOrderItemsIncomplete(c.Context(), orderID, payload)
+	result, err := s.useCase.SearchOrderItemsIncomplete(c.Context(), orderID, payload, middleware.RequestLocales(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
@@ -328,6 +410,7 @@ func (s *Handler) SearchOrderItemsInComplete(c *fiber.Ctx) error {
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
 // @Param page_number query int false "Page number for pagination" default(1)
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
 // @Success 200 {object} middleware.SuccessResponse{data=domain.SearchCurrentOrderItemsResult}
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
@@ -349,7 +432,7 @@ func (s *Handler) GetOrderItems(c *fiber.Ctx) error {
 		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 	}
 
-	result, err := s.useCase.GetOrderItems(c.Context(), orderID, body.PageNumber, body.PageSize)
+	result, err := s.useCase.GetOrderItems(c.Context(), orderID, body.PageNumber, body.PageSize, middleware.RequestLocales(c))
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
@@ -363,6 +446,63 @@ func (s *Handler) GetOrderItems(c *fiber.Ctx) error {
 	})
 }
 
+// GetCurrentBill godoc
+// @Summary Get bill for current session
+// @Description Get billable lines of the current order. Combo products appear as a single line at the bundle price
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param X-Session-Id header string true "Session ID"
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
+// @Success 200 {object} middleware.SuccessResponse{data=domain.Bill}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /current/bill [get]
+func (s *Handler) GetCurrentBill(c *fiber.Ctx) error {
+	sessionID, err := getSession(c)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.useCase.GetCurrentBill(c.Context(), sessionID, middleware.RequestLocales(c))
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// GetBill godoc
+// @Summary Get bill by order ID
+// @Description Get billable lines of the order. Combo products appear as a single line at the bundle price
+// @Tags Order
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
+// @Success 200 {object} middleware.SuccessResponse{data=domain.Bill}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /{id}/bill [get]
+func (s *Handler) GetBill(c *fiber.Ctx) error {
+	orderID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.GetBill(c.Context(), orderID, middleware.RequestLocales(c))
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
 // UpdateOrderItemsStatusCancel godoc
 // @Summary Cancel order item
 // @Description Update order item status to cancelled for current table session
@@ -376,6 +516,7 @@ func (s *Handler) GetOrderItems(c *fiber.Ctx) error {
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /{id}/items/{orderItemsID}/status/cancel [patch]
 func (s *Handler) UpdateOrderItemsStatusCancel(c *fiber.Ctx) error {
@@ -393,7 +534,8 @@ func (s *Handler) UpdateOrderItemsStatusCancel(c *fiber.Ctx) error {
 	err = s.useCase.UpdateOrderItemsStatusByID(c.Context(), shareModel.OrderItemsStatus{
 		ID:         orderItemsID,
 		OrderID:    orderID,
-		StatusCode: "CANCELLED",
+		StatusCode: orderstatus.Cancelled,
+		Actor:      orderstatus.ActorStaff,
 	})
 	if err != nil {
 		return middleware.ResponseError(c, err)
@@ -402,6 +544,49 @@ func (s *Handler) UpdateOrderItemsStatusCancel(c *fiber.Ctx) error {
 	return middleware.ResponseOK(c, nil)
 }
 
+// AmendOrderItem godoc
+// @Summary Amend order item
+// @Description Change quantity, note and modifiers of an order item that is still pending or confirmed
+// @Tags Order
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param orderItemsID path string true "Order Item ID"
+// @Param item body AmendOrderItem true "New quantity, note and modifiers"
+// @Success 200 {object} middleware.SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /{id}/items/{orderItemsID} [put]
+func (s *Handler) AmendOrderItem(c *fiber.Ctx) error {
+	orderID, err := utils.StrToInt64(c.Params("id"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	orderItemsID, err := utils.StrToInt64(c.Params("orderItemsID"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	payload, err := s.parseAmendOrderItem(c, orderItemsID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	payload.OrderID = orderID
+	err = s.useCase.AmendOrderItem(c.Context(), payload)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, nil)
+}
+
 // UpdateOrderItemsStatusServed godoc
 // @Summary Cancel order item
 // @Description Update order item status to cancelled for current table session
@@ -414,6 +599,7 @@ func (s *Handler) UpdateOrderItemsStatusCancel(c *fiber.Ctx) error {
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /{id}/items/{orderItemsID}/status/serve [patch]
 func (s *Handler) UpdateOrderItemsStatusServed(c *fiber.Ctx) error {
@@ -431,7 +617,8 @@ func (s *Handler) UpdateOrderItemsStatusServed(c *fiber.Ctx) error {
 	err = s.useCase.UpdateOrderItemsStatusByID(c.Context(), shareModel.OrderItemsStatus{
 		ID:         orderItemsID,
 		OrderID:    orderID,
-		StatusCode: "SERVED",
+		StatusCode: orderstatus.Served,
+		Actor:      orderstatus.ActorStaff,
 	})
 	if err != nil {
 		return middleware.ResponseError(c, err)
@@ -447,11 +634,13 @@ func (s *Handler) UpdateOrderItemsStatusServed(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Idempotency-Key header string false "Retries with the same key replay the first response instead of ordering again"
 // @Param order body OrderItems true "Order item details"
 // @Success 201 {object} middleware.SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /{id} [post]
 func (s *Handler) CreateOrderByStaff(c *fiber.Ctx) error {
@@ -481,10 +670,25 @@ func (s *Handler) CreateOrderByStaff(c *fiber.Ctx) error {
 		if err != nil {
 			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 		}
+		variantID, err := parseVariantID(item.VariantID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		modifiers, err := parseModifiers(item.Modifiers)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		comboSelections, err := parseComboSelections(item.ComboSelections)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
 		items = append(items, shareModel.OrderItems{
-			ProductID: productID,
-			Quantity:  item.Quantity,
-			Note:      item.Note,
+			ProductID:       productID,
+			Quantity:        item.Quantity,
+			Note:            item.Note,
+			VariantID:       variantID,
+			Modifiers:       modifiers,
+			ComboSelections: comboSelections,
 		})
 	}
 
@@ -503,11 +707,13 @@ func (s *Handler) CreateOrderByStaff(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param X-Session-Id header string true "Session ID"
+// @Param Idempotency-Key header string false "Retries with the same key replay the first response instead of ordering again"
 // @Param order body OrderItems true "Order items to add"
 // @Success 201 {object} middleware.SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /{id}/items [post]
 func (s *Handler) CreateOrderItemsByStaff(c *fiber.Ctx) error {
@@ -536,10 +742,25 @@ func (s *Handler) CreateOrderItemsByStaff(c *fiber.Ctx) error {
 		if err != nil {
 			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
 		}
+		variantID, err := parseVariantID(item.VariantID)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		modifiers, err := parseModifiers(item.Modifiers)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
+		comboSelections, err := parseComboSelections(item.ComboSelections)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+		}
 		items = append(items, shareModel.OrderItems{
-			ProductID: productID,
-			Quantity:  item.Quantity,
-			Note:      item.Note,
+			ProductID:       productID,
+			Quantity:        item.Quantity,
+			Note:            item.Note,
+			VariantID:       variantID,
+			Modifiers:       modifiers,
+			ComboSelections: comboSelections,
 		})
 	}
 	err = s.useCase.CreateOrderItems(c.Context(), sessionID, items)
@@ -550,6 +771,68 @@ func (s *Handler) CreateOrderItemsByStaff(c *fiber.Ctx) error {
 	return middleware.ResponseCreated(c, nil)
 }
 
+func parseVariantID(value *string) (*int64, error) {
+	if value == nil {
+		return nil, nil
+	}
+	variantID, err := utils.StrToInt64(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &variantID, nil
+}
+
+func (s *Handler) parseAmendOrderItem(c *fiber.Ctx, orderItemsID int64) (shareModel.OrderItemsAmendment, error) {
+	body := new(AmendOrderItem)
+	if err := c.BodyParser(body); err != nil {
+		return shareModel.OrderItemsAmendment{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return shareModel.OrderItemsAmendment{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	modifiers, err := parseModifiers(body.Modifiers)
+	if err != nil {
+		return shareModel.OrderItemsAmendment{}, exceptions.Error(exceptions.CodeBusiness, err.Error())
+	}
+
+	return shareModel.OrderItemsAmendment{
+		ID:        orderItemsID,
+		Quantity:  body.Quantity,
+		Note:      body.Note,
+		Modifiers: modifiers,
+	}, nil
+}
+
+func parseModifiers(modifierIDs []string) ([]shareModel.OrderItemModifier, error) {
+	modifiers := make([]shareModel.OrderItemModifier, len(modifierIDs))
+	for index, value := range modifierIDs {
+		modifierID, err := utils.StrToInt64(value)
+		if err != nil {
+			return nil, err
+		}
+		modifiers[index] = shareModel.OrderItemModifier{ID: modifierID}
+	}
+	return modifiers, nil
+}
+
+func parseComboSelections(selections []comboSelection) ([]shareModel.OrderItemComboSelection, error) {
+	result := make([]shareModel.OrderItemComboSelection, len(selections))
+	for index, selection := range selections {
+		slotID, err := utils.StrToInt64(selection.SlotID)
+		if err != nil {
+			return nil, err
+		}
+		productID, err := utils.StrToInt64(selection.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		result[index] = shareModel.OrderItemComboSelection{SlotID: slotID, ProductID: productID}
+	}
+	return result, nil
+}
+
 func getSession(c *fiber.Ctx) (uuid.UUID, error) {
 	sessionIDAny, ok := c.Locals("sessionID").(string)
 	if !ok {
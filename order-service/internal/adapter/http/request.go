@@ -10,9 +10,23 @@ type OrderItemsByStaff struct {
 }
 
 type OrderItemsData struct {
-	ProductID string  `json:"productID" validate:"required,gt=0" example:"1921828287366041600"`
-	Quantity  int32   `json:"quantity" validate:"required,gt=0" example:"1"`
-	Note      *string `json:"note" example:"lorem ipsum"`
+	ProductID       string           `json:"productID" validate:"required,gt=0" example:"1921828287366041600"`
+	Quantity        int32            `json:"quantity" validate:"required,gt=0" example:"1"`
+	Note            *string          `json:"note" example:"lorem ipsum"`
+	VariantID       *string          `json:"variantID" validate:"omitempty,gt=0" example:"1921822053405560834"`
+	Modifiers       []string         `json:"modifiers" validate:"omitempty,dive,required" example:"1921822053405560833"`
+	ComboSelections []comboSelection `json:"comboSelections" validate:"omitempty,dive"`
+}
+
+type AmendOrderItem struct {
+	Quantity  int32    `json:"quantity" validate:"required,gt=0" example:"2"`
+	Note      *string  `json:"note" example:"lorem ipsum"`
+	Modifiers []string `json:"modifiers" validate:"omitempty,dive,required" example:"1921822053405560833"`
+}
+
+type comboSelection struct {
+	SlotID    string `json:"slotID" validate:"required" example:"1921822053405560835"`
+	ProductID string `json:"productID" validate:"required" example:"1921822053405560836"`
 }
 
 type SearchOrderItemsIncomplete struct {
@@ -28,3 +42,7 @@ type SearchCurrentOrderItems struct {
 	PageNumber int64 `query:"pageNumber" example:"1"`
 	PageSize   int64 `query:"pageSize" example:"10"`
 }
+
+type CallWaiter struct {
+	Reason string `json:"reason" validate:"required,oneof=WATER CUTLERY HELP" example:"WATER"`
+}
@@ -1,6 +1,11 @@
 package http
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"food-story/order-service/internal/domain"
 	"food-story/order-service/internal/usecase"
 	"food-story/pkg/exceptions"
 	"food-story/pkg/middleware"
@@ -40,21 +45,35 @@ func (s *Handler) setupRoutes() {
 
 	// ใช้ middleware header ทีละ endpoint เพราะมีข้อจำกัดเรื่อง router group authentication
 	groupCustomer := s.router.Group("/current", s.handleSessionID)
-	groupCustomer.Post("/", s.CreateOrder)
+	groupCustomer.Post("/", s.handleIdempotencyKey, s.CreateOrder)
 	groupCustomer.Get("/", s.GetCurrentOrderByID)
-	groupCustomer.Post("/items", s.CreateOrderItems)
+	groupCustomer.Post("/items", s.handleIdempotencyKey, s.CreateOrderItems)
 	groupCustomer.Get("/items", s.GetCurrentOrderItems)
+	groupCustomer.Get("/items/status/stream", s.StreamCurrentOrderItemsStatus)
 	groupCustomer.Get("/items/:orderItemsID<int>", s.GetCurrentOrderItemsByID)
+	groupCustomer.Put("/items/:orderItemsID<int>", s.AmendCurrentOrderItem)
 	groupCustomer.Patch("/items/:orderItemsID<int>/status/cancel", s.UpdateCurrentOrderItemsStatusCancel)
+	groupCustomer.Get("/bill", s.GetCurrentBill)
+	groupCustomer.Post("/service-requests/call-waiter", s.CallWaiter)
+	groupCustomer.Post("/service-requests/bill", s.RequestBill)
 
 	//groupStaff := s.router.Group("")
 
-	s.router.Post("/", s.CreateOrderByStaff)
-	s.router.Post("/:id<int>/items", s.CreateOrderItemsByStaff)
+	s.router.Post("/", s.handleIdempotencyKey, s.CreateOrderByStaff)
+	s.router.Post("/:id<int>/items", s.handleIdempotencyKey, s.CreateOrderItemsByStaff)
 	s.router.Get("/:id<int>/items/status/incomplete", s.SearchOrderItemsInComplete)
 	s.router.Get("/:id<int>/items", s.GetOrderItems)
+	s.router.Get("/:id<int>/bill", s.GetBill)
 	s.router.Patch("/:id<int>/items/:orderItemsID<int>/status/cancel", s.UpdateOrderItemsStatusCancel)
 	s.router.Patch("/:id<int>/items/:orderItemsID<int>/status/serve", s.UpdateOrderItemsStatusServed)
+
+	// ลงทะเบียนไว้ท้ายสุด middleware ของ group ไม่มีชื่อจะครอบทุก route ที่ลงทะเบียนหลังจากนี้
+	groupStaffAuth := s.router.Group("", s.auth.JWTMiddleware(), s.auth.RequireRole([]string{"CASHIER", "WAITER"}))
+	groupStaffAuth.Put("/:id<int>/items/:orderItemsID<int>", s.AmendOrderItem)
+	groupStaffAuth.Get("/service-requests", s.ListOpenTableServiceRequests)
+	groupStaffAuth.Get("/service-requests/stream", s.StreamTableServiceRequests)
+	groupStaffAuth.Patch("/service-requests/:requestID<int>/acknowledge", s.AcknowledgeTableServiceRequest)
+	groupStaffAuth.Patch("/service-requests/:requestID<int>/resolve", s.ResolveTableServiceRequest)
 }
 
 func (s *Handler) handleSessionID(c *fiber.Ctx) error {
@@ -68,11 +87,62 @@ func (s *Handler) handleSessionID(c *fiber.Ctx) error {
 		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeSystem, exceptions.ErrFailedToReadSession.Error()))
 	}
 
-	err = s.useCase.IsSessionValid(sessionID)
+	locale, err := s.useCase.GetSessionLocale(sessionID)
 	if err != nil {
 		return middleware.ResponseError(c, err)
 	}
 
 	c.Locals("sessionID", sessionID.String())
+	c.Locals(middleware.LocalsSessionLocale, locale)
 	return c.Next()
 }
+
+const (
+	headerIdempotencyKey     = "Idempotency-Key"
+	headerIdempotentReplayed = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+// handleIdempotencyKey makes a retried request with the same Idempotency-Key replay the first response instead
+// of creating the order again. Keys are scoped to the endpoint and session, requests without one run as usual.
+func (s *Handler) handleIdempotencyKey(c *fiber.Ctx) error {
+	idempotencyKey := c.Get(headerIdempotencyKey)
+	if idempotencyKey == "" {
+		return c.Next()
+	}
+
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("%s must be at most %d characters", headerIdempotencyKey, maxIdempotencyKeyLength)))
+	}
+
+	sessionID, _ := c.Locals("sessionID").(string)
+	scope := sha256.Sum256([]byte(c.Method() + " " + c.Path() + " " + sessionID + " " + idempotencyKey))
+	key := hex.EncodeToString(scope[:])
+
+	body := sha256.Sum256(c.Body())
+	fingerprint := hex.EncodeToString(body[:])
+
+	stored, err := s.useCase.BeginIdempotentRequest(key, fingerprint)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	if stored != nil {
+		c.Set(headerIdempotentReplayed, "true")
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Status(stored.StatusCode).Send(stored.Body)
+	}
+
+	err = c.Next()
+	if err != nil {
+		s.useCase.ReleaseIdempotentRequest(key)
+		return err
+	}
+
+	s.useCase.CompleteIdempotentRequest(key, domain.IdempotentResponse{
+		Fingerprint: fingerprint,
+		StatusCode:  c.Response().StatusCode(),
+		Body:        bytes.Clone(c.Response().Body()),
+	})
+	return nil
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/middleware"
+	"food-story/pkg/utils"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	headerLastEventID = "Last-Event-ID"
+
+	eventSnapshot      = "snapshot"
+	eventStatusChanged = "status-changed"
+
+	// proxies close idle connections, a comment line keeps the stream open and detects clients that left
+	streamHeartbeatInterval = 15 * time.Second
+)
+
+// StreamCurrentOrderItemsStatus godoc
+// @Summary Stream order item status changes
+// @Description Server-sent events with the status changes of the current table session's order. The first event is a "snapshot" of every item, then each change is sent as a "status-changed" event whose id can be sent back in Last-Event-ID to resume after a reconnect
+// @Tags Order
+// @Produce text/event-stream
+// @Param X-Session-Id header string true "Session ID"
+// @Param Last-Event-ID header string false "ID of the last event received, missed events are replayed instead of a snapshot"
+// @Param Accept-Language header string false "Preferred languages for localized fields, e.g. zh-TW,zh;q=0.9"
+// @Success 200 {object} domain.OrderItemsStatusSnapshot "snapshot event, followed by shareModel.OrderItemsStatusChanged events"
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /current/items/status/stream [get]
+func (s *Handler) StreamCurrentOrderItemsStatus(c *fiber.Ctx) error {
+	sessionID, err := getSession(c)
+	if err != nil {
+		return err
+	}
+
+	var lastEventID int64
+	if value := c.Get(headerLastEventID); value != "" {
+		lastEventID, err = utils.StrToInt64(value)
+		if err != nil {
+			return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("invalid %s", headerLastEventID)))
+		}
+	}
+
+	stream, err := s.useCase.SubscribeOrderItemsStatus(c.Context(), sessionID, lastEventID, middleware.RequestLocales(c))
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer s.useCase.UnsubscribeOrderItemsStatus(stream)
+		s.writeOrderItemsStatusStream(w, stream)
+	})
+
+	return nil
+}
+
+// writeOrderItemsStatusStream runs after the handler returned, so it must not touch the fiber context.
+func (s *Handler) writeOrderItemsStatusStream(w *bufio.Writer, stream *domain.OrderItemsStatusStream) {
+	replayed := make(map[int64]bool, len(stream.Missed))
+
+	if stream.Snapshot != nil {
+		writeServerSentEvent(w, stream.Snapshot.LastEventID, eventSnapshot, stream.Snapshot)
+	}
+
+	for _, event := range stream.Missed {
+		replayed[event.EventID] = true
+		writeServerSentEvent(w, event.EventID, eventStatusChanged, event)
+	}
+
+	if w.Flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-stream.Events:
+			if !ok {
+				return
+			}
+
+			// an event committed while the replay was read arrives both ways
+			if replayed[event.EventID] {
+				continue
+			}
+
+			writeServerSentEvent(w, event.EventID, eventStatusChanged, event)
+		case <-heartbeat.C:
+			_, _ = w.WriteString(": ping\n\n")
+		}
+
+		if w.Flush() != nil {
+			return
+		}
+	}
+}
+
+func writeServerSentEvent(w *bufio.Writer, id int64, event string, data any) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		slog.Error("failed to marshal server-sent event", "event", event, "error", err)
+		return
+	}
+
+	if id > 0 {
+		_, _ = fmt.Fprintf(w, "id: %d\n", id)
+	}
+	_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+}
@@ -0,0 +1,225 @@
+package http
+
+import (
+	"bufio"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/middleware"
+	"food-story/pkg/utils"
+	shareModel "food-story/shared/model"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	eventServiceRequestsSnapshot = "snapshot"
+	eventServiceRequestChanged   = "service-request"
+)
+
+// CallWaiter godoc
+// @Summary Call waiter
+// @Description Ask a waiter to come to the table of the current session, the table status changes to CALL_WAITER until staff resolve the request. Calling again while a call is open returns the open request with 200
+// @Tags Service Request
+// @Accept json
+// @Produce json
+// @Param X-Session-Id header string true "Session ID"
+// @Param request body CallWaiter true "Reason of the call"
+// @Success 200 {object} middleware.SuccessResponse{data=shareModel.TableServiceRequest}
+// @Success 201 {object} middleware.SuccessResponse{data=shareModel.TableServiceRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /current/service-requests/call-waiter [post]
+func (s *Handler) CallWaiter(c *fiber.Ctx) error {
+	sessionID, err := getSession(c)
+	if err != nil {
+		return err
+	}
+
+	body := new(CallWaiter)
+	if err := c.BodyParser(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	if err := s.validator.Validate(body); err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, created, err := s.useCase.CallWaiter(c.Context(), sessionID, body.Reason)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return responseTableServiceRequest(c, result, created)
+}
+
+// RequestBill godoc
+// @Summary Request the bill
+// @Description Ask staff to bring the bill of the current session, the table status changes to WAITING_PAYMENT. Requesting again while a request is open returns the open request with 200
+// @Tags Service Request
+// @Produce json
+// @Param X-Session-Id header string true "Session ID"
+// @Success 200 {object} middleware.SuccessResponse{data=shareModel.TableServiceRequest}
+// @Success 201 {object} middleware.SuccessResponse{data=shareModel.TableServiceRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /current/service-requests/bill [post]
+func (s *Handler) RequestBill(c *fiber.Ctx) error {
+	sessionID, err := getSession(c)
+	if err != nil {
+		return err
+	}
+
+	result, created, err := s.useCase.RequestBill(c.Context(), sessionID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return responseTableServiceRequest(c, result, created)
+}
+
+// ListOpenTableServiceRequests godoc
+// @Summary List open service requests
+// @Description List the waiter calls and bill requests of every table that staff have not resolved yet, oldest first
+// @Tags Service Request
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]shareModel.TableServiceRequest}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /service-requests [get]
+func (s *Handler) ListOpenTableServiceRequests(c *fiber.Ctx) error {
+	result, err := s.useCase.ListOpenTableServiceRequests(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// AcknowledgeTableServiceRequest godoc
+// @Summary Acknowledge service request
+// @Description Let the customer know a staff member is on the way, only a PENDING request can be acknowledged
+// @Tags Service Request
+// @Security BearerAuth
+// @Produce json
+// @Param requestID path string true "Service Request ID"
+// @Success 200 {object} middleware.SuccessResponse{data=shareModel.TableServiceRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /service-requests/{requestID}/acknowledge [patch]
+func (s *Handler) AcknowledgeTableServiceRequest(c *fiber.Ctx) error {
+	requestID, err := utils.StrToInt64(c.Params("requestID"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.AcknowledgeTableServiceRequest(c.Context(), requestID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// ResolveTableServiceRequest godoc
+// @Summary Resolve service request
+// @Description Close a request once handled. Resolving a waiter call or a bill request puts the table back to the status it had before the request, unless the table status changed since
+// @Tags Service Request
+// @Security BearerAuth
+// @Produce json
+// @Param requestID path string true "Service Request ID"
+// @Success 200 {object} middleware.SuccessResponse{data=shareModel.TableServiceRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /service-requests/{requestID}/resolve [patch]
+func (s *Handler) ResolveTableServiceRequest(c *fiber.Ctx) error {
+	requestID, err := utils.StrToInt64(c.Params("requestID"))
+	if err != nil {
+		return middleware.ResponseError(c, exceptions.Error(exceptions.CodeBusiness, err.Error()))
+	}
+
+	result, err := s.useCase.ResolveTableServiceRequest(c.Context(), requestID)
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	return middleware.ResponseOK(c, result)
+}
+
+// StreamTableServiceRequests godoc
+// @Summary Stream service requests
+// @Description Server-sent events for staff screens. The first event is a "snapshot" of the open requests, then every created, acknowledged or resolved request is sent as a "service-request" event. A client that reconnects starts again from a snapshot
+// @Tags Service Request
+// @Security BearerAuth
+// @Produce text/event-stream
+// @Success 200 {object} domain.TableServiceRequestsSnapshot "snapshot event, followed by shareModel.TableServiceRequestChanged events"
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /service-requests/stream [get]
+func (s *Handler) StreamTableServiceRequests(c *fiber.Ctx) error {
+	stream, err := s.useCase.SubscribeTableServiceRequests(c.Context())
+	if err != nil {
+		return middleware.ResponseError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer s.useCase.UnsubscribeTableServiceRequests(stream)
+		writeTableServiceRequestsStream(w, stream)
+	})
+
+	return nil
+}
+
+func writeTableServiceRequestsStream(w *bufio.Writer, stream *domain.TableServiceRequestsStream) {
+	writeServerSentEvent(w, 0, eventServiceRequestsSnapshot, stream.Snapshot)
+	if w.Flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-stream.Events:
+			if !ok {
+				return
+			}
+
+			writeServerSentEvent(w, event.EventID, eventServiceRequestChanged, event)
+		case <-heartbeat.C:
+			_, _ = w.WriteString(": ping\n\n")
+		}
+
+		if w.Flush() != nil {
+			return
+		}
+	}
+}
+
+func responseTableServiceRequest(c *fiber.Ctx, result *shareModel.TableServiceRequest, created bool) error {
+	if created {
+		return middleware.ResponseCreated(c, result)
+	}
+	return middleware.ResponseOK(c, result)
+}
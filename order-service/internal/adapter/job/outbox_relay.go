@@ -0,0 +1,35 @@
+package job
+
+import (
+	"context"
+	"food-story/order-service/internal/usecase"
+	"log/slog"
+	"time"
+)
+
+// RunOutboxRelay publishes pending outbox events every interval and deletes old sent events every
+// cleanupInterval, until ctx is cancelled.
+func RunOutboxRelay(ctx context.Context, useCase usecase.Usecase, interval, cleanupInterval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	cleanup := time.NewTicker(cleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := useCase.RelayOutboxEvents(ctx)
+			if err != nil {
+				slog.Error("failed to relay outbox events", "error", err)
+			}
+		case <-cleanup.C:
+			err := useCase.CleanupOutboxEvents(ctx)
+			if err != nil {
+				slog.Error("failed to clean up outbox events", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"food-story/order-service/internal/adapter/stream"
+	"food-story/shared/kafka"
+	shareModel "food-story/shared/model"
+	"log"
+	"log/slog"
+
+	"github.com/IBM/sarama"
+)
+
+type Consumer struct {
+	Ready             chan bool
+	OrderStatusHub    stream.OrderStatusHubInterface
+	ServiceRequestHub stream.ServiceRequestHubInterface
+}
+
+func (c *Consumer) Setup(sarama.ConsumerGroupSession) error {
+	close(c.Ready)
+	return nil
+}
+func (c *Consumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		switch msg.Topic {
+		case kafka.OrderItemsStatusChangedTopic:
+			c.publishOrderItemsStatus(msg)
+		case kafka.TableServiceRequestsChangedTopic:
+			c.publishTableServiceRequest(msg)
+		}
+
+		session.MarkMessage(msg, "")
+	}
+
+	return nil
+}
+
+func (c *Consumer) publishOrderItemsStatus(msg *sarama.ConsumerMessage) {
+	event := new(shareModel.OrderItemsStatusChanged)
+	err := json.Unmarshal(msg.Value, event)
+	if err != nil {
+		slog.Error("failed to unmarshal order items status event", "offset", msg.Offset, "error", err)
+		return
+	}
+
+	// ส่งต่อให้ลูกค้าที่เชื่อมต่อกับออเดอร์นี้
+	c.OrderStatusHub.Publish(event)
+}
+
+func (c *Consumer) publishTableServiceRequest(msg *sarama.ConsumerMessage) {
+	event := new(shareModel.TableServiceRequestChanged)
+	err := json.Unmarshal(msg.Value, event)
+	if err != nil {
+		slog.Error("failed to unmarshal table service request event", "offset", msg.Offset, "error", err)
+		return
+	}
+
+	// ส่งต่อให้หน้าจอพนักงานทุกเครื่อง
+	c.ServiceRequestHub.Publish(event)
+}
+
+func Run(ctx context.Context, topics []string, client sarama.ConsumerGroup, orderStatusHub stream.OrderStatusHubInterface, serviceRequestHub stream.ServiceRequestHubInterface) {
+	consumer := Consumer{
+		Ready:             make(chan bool),
+		OrderStatusHub:    orderStatusHub,
+		ServiceRequestHub: serviceRequestHub,
+	}
+
+	go func() {
+		for {
+			if err := client.Consume(ctx, topics, &consumer); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
+				}
+				log.Panicf("Error from consumer: %v", err)
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			consumer.Ready = make(chan bool)
+		}
+	}()
+	<-consumer.Ready
+	log.Println("Sarama consumer up and running!...")
+}
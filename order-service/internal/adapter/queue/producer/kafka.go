@@ -1,15 +1,15 @@
 package producer
 
 import (
-	"encoding/json"
+	"errors"
 	"food-story/shared/kafka"
-	shareModel "food-story/shared/model"
 
 	"github.com/IBM/sarama"
 )
 
 type QueueProducerInterface interface {
-	PublishOrder(item shareModel.OrderItems) error
+	PublishMessage(message kafka.Message) error
+	PublishMessages(messages []kafka.Message) []error
 }
 type OrderProducer struct {
 	Producer sarama.SyncProducer
@@ -23,16 +23,59 @@ func NewQueue(producer sarama.SyncProducer) *OrderProducer {
 
 var _ QueueProducerInterface = (*OrderProducer)(nil)
 
-func (p *OrderProducer) PublishOrder(item shareModel.OrderItems) error {
-	message, err := json.Marshal(item)
-	if err != nil {
-		return err
+// PublishMessage sends an already encoded message, payloads are built when they are written to the outbox.
+func (p *OrderProducer) PublishMessage(message kafka.Message) error {
+	_, _, err := p.Producer.SendMessage(toProducerMessage(message, 0))
+	return err
+}
+
+// PublishMessages sends the messages in one batch and returns one error per message, nil for those that were
+// delivered, so the caller can retry only the failed ones.
+func (p *OrderProducer) PublishMessages(messages []kafka.Message) []error {
+	result := make([]error, len(messages))
+	if len(messages) == 0 {
+		return result
+	}
+
+	producerMessages := make([]*sarama.ProducerMessage, len(messages))
+	for index, message := range messages {
+		producerMessages[index] = toProducerMessage(message, index)
+	}
+
+	err := p.Producer.SendMessages(producerMessages)
+	if err == nil {
+		return result
+	}
+
+	var producerErrors sarama.ProducerErrors
+	if !errors.As(err, &producerErrors) {
+		for index := range result {
+			result[index] = err
+		}
+		return result
 	}
 
+	for _, producerError := range producerErrors {
+		index, ok := producerError.Msg.Metadata.(int)
+		if !ok {
+			continue
+		}
+		result[index] = producerError.Err
+	}
+
+	return result
+}
+
+func toProducerMessage(message kafka.Message, index int) *sarama.ProducerMessage {
 	msg := &sarama.ProducerMessage{
-		Topic: kafka.OrderItemsCreatedTopic,
-		Value: sarama.ByteEncoder(message),
+		Topic:    message.Topic,
+		Value:    sarama.ByteEncoder(message.Value),
+		Metadata: index,
 	}
-	_, _, err = p.Producer.SendMessage(msg)
-	return err
+
+	if message.Key != nil {
+		msg.Key = sarama.StringEncoder(*message.Key)
+	}
+
+	return msg
 }
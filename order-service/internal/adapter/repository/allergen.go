@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"food-story/pkg/exceptions"
+	database "food-story/shared/database/sqlc"
+	shareModel "food-story/shared/model"
+)
+
+// attachOrderItemAllergens snapshots the allergens of each line so the kitchen can warn about them.
+// A combo line (the first item) carries the allergens of all of its components.
+func (i *Implement) attachOrderItemAllergens(ctx context.Context, items []database.CreateOrderItemsParams) error {
+	productIDs := make([]int64, len(items))
+	for index, item := range items {
+		productIDs[index] = item.ProductID
+	}
+
+	tags, err := i.repository.ListDietaryTagsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product allergens", err)
+	}
+
+	allergenMap := make(map[int64][]shareModel.OrderItemAllergen)
+	for _, tag := range tags {
+		if !tag.IsAllergen {
+			continue
+		}
+		allergenMap[tag.ProductID] = append(allergenMap[tag.ProductID], shareModel.OrderItemAllergen{
+			Code:   tag.Code,
+			Name:   tag.Name,
+			NameEN: tag.NameEN,
+		})
+	}
+
+	for index := range items {
+		lineProductIDs := []int64{items[index].ProductID}
+		if index == 0 {
+			lineProductIDs = productIDs
+		}
+
+		items[index].Allergens, err = marshalOrderItemAllergens(allergenMap, lineProductIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func marshalOrderItemAllergens(allergenMap map[int64][]shareModel.OrderItemAllergen, productIDs []int64) ([]byte, error) {
+	allergens := []shareModel.OrderItemAllergen{}
+	seen := make(map[string]bool)
+	for _, productID := range productIDs {
+		for _, allergen := range allergenMap[productID] {
+			if seen[allergen.Code] {
+				continue
+			}
+			seen[allergen.Code] = true
+			allergens = append(allergens, allergen)
+		}
+	}
+
+	data, err := json.Marshal(allergens)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeSystem, "failed to marshal allergens", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+)
+
+// GetBill lists billable lines only, so a combo appears once at its bundle price instead of per component.
+// Item amounts are net of their line discount, buy-X-get-Y free items are subtracted from the total.
+func (i *Implement) GetBill(ctx context.Context, orderID int64) (result *domain.Bill, err error) {
+	err = i.validateAndCheckOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := i.repository.ListBillItemsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to get bill items", err)
+	}
+
+	result = &domain.Bill{
+		OrderID: orderID,
+		Items:   make([]*domain.BillItem, len(items)),
+	}
+	for index, item := range items {
+		amount := utils.PgNumericToFloat64(item.Amount)
+		result.TotalAmount += amount
+		result.Items[index] = &domain.BillItem{
+			ID:            item.ID,
+			ProductID:     item.ProductID,
+			ProductName:   item.ProductName,
+			ProductNameEN: item.ProductNameEN,
+			VariantID:     utils.PgInt8ToInt64Ptr(item.VariantID),
+			VariantName:   utils.PgTextToStringPtr(item.VariantName),
+			VariantNameEN: utils.PgTextToStringPtr(item.VariantNameEN),
+			Price:         utils.PgNumericToFloat64(item.Price),
+			Quantity:      item.Quantity,
+			Discount:      utils.PgNumericToFloat64(item.DiscountAmount),
+			Amount:        amount,
+			StatusCode:    item.StatusCode,
+
+			PromotionID:     utils.PgInt8ToInt64Ptr(item.PromotionID),
+			PromotionName:   utils.PgTextToStringPtr(item.PromotionName),
+			PromotionNameEN: utils.PgTextToStringPtr(item.PromotionNameEN),
+			PromotionType:   utils.PgTextToStringPtr(item.PromotionType),
+		}
+	}
+
+	buyXGetYDiscount, err := i.repository.GetOrderBuyXGetYDiscount(ctx, orderID)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to get buy x get y discount", err)
+	}
+
+	result.BuyXGetYDiscount = utils.PgNumericToFloat64(buyXGetYDiscount)
+	result.TotalAmount -= result.BuyXGetYDiscount
+
+	return result, nil
+}
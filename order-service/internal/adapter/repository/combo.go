@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"fmt"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	shareModel "food-story/shared/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// buildOrderItemCombo expands a combo line into its kitchen components. Components are billed
+// through the combo line, so they carry no price of their own.
+func (i *Implement) buildOrderItemCombo(menu *productMenu, parent database.CreateOrderItemsParams, selected []shareModel.OrderItemComboSelection) ([]database.CreateOrderItemsParams, float64, error) {
+	slots := menu.comboSlots
+	if len(slots) == 0 {
+		if len(selected) > 0 {
+			return nil, 0, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' is not a combo", parent.ProductID))
+		}
+		return nil, 0, nil
+	}
+
+	optionMap := make(map[int64]map[int64]*database.ListComboSlotOptionsBySlotIDsRow, len(slots))
+	for _, option := range menu.comboOptions {
+		if optionMap[option.SlotID] == nil {
+			optionMap[option.SlotID] = make(map[int64]*database.ListComboSlotOptionsBySlotIDsRow)
+		}
+		optionMap[option.SlotID][option.ProductID] = option
+	}
+
+	selectedMap := make(map[int64]int64, len(selected))
+	for _, item := range selected {
+		if _, ok := optionMap[item.SlotID]; !ok {
+			return nil, 0, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("combo slot id '%d' does not belong to product id '%d'", item.SlotID, parent.ProductID))
+		}
+
+		if _, ok := selectedMap[item.SlotID]; ok {
+			return nil, 0, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("combo slot id '%d' is selected more than once", item.SlotID))
+		}
+		selectedMap[item.SlotID] = item.ProductID
+	}
+
+	emptyModifiers := []byte("[]")
+	components := make([]database.CreateOrderItemsParams, 0, len(slots))
+	var priceDelta float64
+	for _, slot := range slots {
+		productID, ok := selectedMap[slot.ID]
+		if !ok {
+			return nil, 0, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("combo slot '%s' requires a selection", slot.NameEN))
+		}
+
+		option, ok := optionMap[slot.ID][productID]
+		if !ok {
+			return nil, 0, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' is not an option of combo slot '%s'", productID, slot.NameEN))
+		}
+
+		if !option.IsAvailable {
+			return nil, 0, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product '%s' is not available", option.NameEN))
+		}
+
+		priceDelta += utils.PgNumericToFloat64(option.PriceDelta)
+		components = append(components, database.CreateOrderItemsParams{
+			ID:                i.snowflakeID.Generate(),
+			OrderID:           parent.OrderID,
+			ProductID:         option.ProductID,
+			StatusID:          parent.StatusID,
+			ProductName:       option.Name,
+			ProductNameEn:     option.NameEN,
+			Price:             utils.Float64ToPgNumeric(0),
+			DiscountAmount:    utils.Float64ToPgNumeric(0),
+			Quantity:          parent.Quantity,
+			Note:              parent.Note,
+			CreatedAt:         parent.CreatedAt,
+			ProductImageUrl:   option.ImageURL,
+			IsVisible:         true,
+			Modifiers:         emptyModifiers,
+			ParentOrderItemID: pgtype.Int8{Int64: parent.ID, Valid: true},
+		})
+	}
+
+	return components, priceDelta, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"fmt"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	shareModel "food-story/shared/model"
+)
+
+func buildOrderItemModifiers(menu *productMenu, productID int64, selected []shareModel.OrderItemModifier) ([]shareModel.OrderItemModifier, error) {
+	groups := menu.modifierGroups
+	if len(groups) == 0 {
+		if len(selected) > 0 {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' has no modifiers", productID))
+		}
+		return []shareModel.OrderItemModifier{}, nil
+	}
+
+	modifierMap := make(map[int64]shareModel.OrderItemModifier, len(menu.modifiers))
+	availableMap := make(map[int64]bool, len(menu.modifiers))
+	for _, modifier := range menu.modifiers {
+		modifierMap[modifier.ID] = shareModel.OrderItemModifier{
+			ID:         modifier.ID,
+			GroupID:    modifier.GroupID,
+			Name:       modifier.Name,
+			NameEN:     modifier.NameEN,
+			PriceDelta: utils.PgNumericToFloat64(modifier.PriceDelta),
+		}
+		availableMap[modifier.ID] = modifier.IsAvailable
+	}
+
+	result := make([]shareModel.OrderItemModifier, 0, len(selected))
+	selectedPerGroup := make(map[int64]int32)
+	seen := make(map[int64]bool, len(selected))
+	for _, item := range selected {
+		modifier, ok := modifierMap[item.ID]
+		if !ok {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("modifier id '%d' does not belong to product id '%d'", item.ID, productID))
+		}
+
+		if !availableMap[item.ID] {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("modifier '%s' is not available", modifier.NameEN))
+		}
+
+		if seen[item.ID] {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("modifier '%s' is selected more than once", modifier.NameEN))
+		}
+		seen[item.ID] = true
+
+		selectedPerGroup[modifier.GroupID]++
+		result = append(result, modifier)
+	}
+
+	groupNames := make(map[int64][2]string, len(groups))
+	for _, group := range groups {
+		count := selectedPerGroup[group.ID]
+		if count < group.MinSelections || count > group.MaxSelections {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("modifier group '%s' requires between %d and %d selections", group.NameEN, group.MinSelections, group.MaxSelections))
+		}
+		groupNames[group.ID] = [2]string{group.Name, group.NameEN}
+	}
+
+	for index := range result {
+		names := groupNames[result[index].GroupID]
+		result[index].GroupName = names[0]
+		result[index].GroupNameEN = names[1]
+	}
+
+	return result, nil
+}
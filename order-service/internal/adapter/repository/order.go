@@ -8,6 +8,7 @@ import (
 	"food-story/pkg/exceptions"
 	"food-story/pkg/utils"
 	database "food-story/shared/database/sqlc"
+	"food-story/shared/kafka"
 	"time"
 
 	"github.com/google/uuid"
@@ -34,8 +35,13 @@ func (i *Implement) CreateOrder(ctx context.Context, order domain.CreateOrder) (
 			SessionID:   utils.UUIDToPgUUID(order.SessionID),
 			TableID:     order.TableID,
 		},
+		GenerateID:  i.snowflakeID.Generate,
+		OutboxTopic: kafka.OrderItemsCreatedTopic,
 	})
 	if err != nil {
+		if errors.Is(err, exceptions.ErrProductOutOfStock) || errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return 0, exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
 		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to create order", err)
 	}
 
@@ -2,12 +2,17 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"food-story/order-service/internal/domain"
 	"food-story/pkg/exceptions"
 	"food-story/pkg/utils"
 	database "food-story/shared/database/sqlc"
+	"food-story/shared/kafka"
 	shareModel "food-story/shared/model"
+	"food-story/shared/orderstatus"
+	"math"
 	"strings"
 	"sync"
 
@@ -16,73 +21,33 @@ import (
 
 const _failedToGetOrderItems = "failed to get order items"
 
-func (i *Implement) CreateOrderItems(ctx context.Context, orderItems []shareModel.OrderItems) (result []*shareModel.OrderItems, err error) {
+// CreateOrderItems queues the kitchen tickets in the outbox within the same transaction, the relay worker
+// publishes them.
+func (i *Implement) CreateOrderItems(ctx context.Context, orderItems []shareModel.OrderItems) (err error) {
 
 	err = validationOrderItems(orderItems)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	orderItemsPayload, buildParamError := i.buildPayloadOrderItems(ctx, orderItems)
 	if buildParamError != nil {
-		return nil, buildParamError
-	}
-
-	_, err = i.repository.CreateOrderItems(ctx, orderItemsPayload)
-	if err != nil {
-		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to create order items", err)
-	}
-
-	tableID, err := i.repository.GetTableIDByOrderID(ctx, orderItemsPayload[0].OrderID)
-	if err != nil {
-		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to get table id by order id", err)
-	}
-
-	err = i.repository.UpdateTablesStatusWaitingToBeServed(ctx, tableID)
-	if err != nil {
-		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update tables status waiting to be served", err)
-	}
-
-	var orderItemsID []int64
-	for _, item := range orderItemsPayload {
-		orderItemsID = append(orderItemsID, item.ID)
-	}
-
-	result, err = i.GetOderItemsGroupID(ctx, orderItemsID)
-	if err != nil {
-		return nil, err
-	}
-
-	return
-}
-
-func (i *Implement) GetOrderItemsByOrderID(ctx context.Context, orderID int64) (result []*shareModel.OrderItems, err error) {
-
-	err = i.validateAndCheckOrder(ctx, orderID)
-	if err != nil {
-		return nil, err
-	}
-
-	orderItems, err := i.repository.GetOrderItemsByOrderID(ctx, orderID)
-	if err != nil {
-		return nil, exceptions.Errorf(exceptions.CodeRepository, _failedToGetOrderItems, err)
-	}
-
-	return shareModel.TransformOrderItemsResults(orderItems), nil
-}
-
-func (i *Implement) GetOderItemsGroupID(ctx context.Context, orderItemsID []int64) (result []*shareModel.OrderItems, err error) {
-
-	if len(orderItemsID) == 0 {
-		return nil, exceptions.Error(exceptions.CodeBusiness, "order items id cannot be empty")
+		return buildParamError
 	}
 
-	orderItems, err := i.repository.GetOrderWithItemsGroupID(ctx, orderItemsID)
+	err = i.repository.TXCreateOrderItems(ctx, database.TXCreateOrderItemsParams{
+		CreateOrderItems: orderItemsPayload,
+		GenerateID:       i.snowflakeID.Generate,
+		OutboxTopic:      kafka.OrderItemsCreatedTopic,
+	})
 	if err != nil {
-		return nil, exceptions.Errorf(exceptions.CodeRepository, _failedToGetOrderItems, err)
+		if errors.Is(err, exceptions.ErrProductOutOfStock) || errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to create order items", err)
 	}
 
-	return shareModel.TransformOrderItemsResults(orderItems), nil
+	return nil
 }
 
 func (i *Implement) GetCurrentOrderItems(ctx context.Context, orderID int64, pageNumberParam, pageSizeParam int64) (result domain.SearchCurrentOrderItemsResult, err error) {
@@ -153,17 +118,109 @@ func (i *Implement) UpdateOrderItemsStatus(ctx context.Context, payload shareMod
 		return err
 	}
 
-	err = i.repository.UpdateOrderItemsStatus(ctx, database.UpdateOrderItemsStatusParams{
-		StatusCode: payload.StatusCode,
-		ID:         payload.ID,
+	err = i.repository.TXUpdateOrderItemsStatus(ctx, database.TXUpdateOrderItemsStatusParams{
+		UpdateOrderItemsStatus: database.UpdateOrderItemsStatusParams{
+			StatusCode: payload.StatusCode,
+			ID:         payload.ID,
+		},
+		Actor:       payload.Actor,
+		GenerateID:  i.snowflakeID.Generate,
+		OutboxTopic: kafka.OrderItemsStatusChangedTopic,
 	})
 	if err != nil {
+		if statusErr, ok := orderstatus.Error(err); ok {
+			return statusErr
+		}
+		if errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
 		return exceptions.Errorf(exceptions.CodeRepository, "failed to update order items status", err)
 	}
 
 	return
 }
 
+// AmendOrderItem changes the quantity, note and modifiers of an item the kitchen has not started. The unit price
+// keeps the price recorded at order time and only swaps the modifier surcharges. The line keeps the promotion it
+// was ordered with and its discount per unit, even if the promotion has since been changed or has ended.
+func (i *Implement) AmendOrderItem(ctx context.Context, payload shareModel.OrderItemsAmendment) (err error) {
+	orderItem, err := i.repository.GetOrderItemForAmend(ctx, database.GetOrderItemForAmendParams{
+		ID:      payload.ID,
+		OrderID: payload.OrderID,
+	})
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return exceptions.ErrorIDNotFound(exceptions.CodeOrderItemNotFound, payload.ID)
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, _failedToGetOrderItems, err)
+	}
+
+	if orderItem.ParentOrderItemID.Valid || !orderItem.IsVisible {
+		return exceptions.Error(exceptions.CodeBusiness, "combo items cannot be amended, cancel the item and order it again")
+	}
+
+	menu, err := i.getProductMenu(ctx, orderItem.ProductID)
+	if err != nil {
+		return err
+	}
+
+	modifiers, err := buildOrderItemModifiers(menu, orderItem.ProductID, payload.Modifiers)
+	if err != nil {
+		return err
+	}
+
+	modifiersJSON, err := json.Marshal(modifiers)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeSystem, "failed to marshal modifiers", err)
+	}
+
+	price := utils.PgNumericToFloat64(orderItem.Price)
+	for _, modifier := range shareModel.UnmarshalOrderItemModifiers(orderItem.Modifiers) {
+		price -= modifier.PriceDelta
+	}
+	for _, modifier := range modifiers {
+		price += modifier.PriceDelta
+	}
+
+	unitDiscount := math.Min(utils.PgNumericToFloat64(orderItem.DiscountAmount)/float64(orderItem.Quantity), price)
+
+	currentTime, err := i.repository.GetTimeNow(ctx)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to get current time", err)
+	}
+
+	amendedAt, err := utils.PgTimestampToThaiISO8601(currentTime)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeSystem, "failed to format amended time", err)
+	}
+
+	err = i.repository.TXAmendOrderItem(ctx, database.TXAmendOrderItemParams{
+		AmendOrderItem: database.AmendOrderItemParams{
+			Quantity:       payload.Quantity,
+			Note:           utils.StringPtrToPgText(payload.Note),
+			Modifiers:      modifiersJSON,
+			Price:          utils.Float64ToPgNumeric(price),
+			DiscountAmount: utils.Float64ToPgNumeric(unitDiscount * float64(payload.Quantity)),
+			ID:             payload.ID,
+		},
+		ProductNameEn: orderItem.ProductNameEn,
+		AmendedAt:     amendedAt,
+		GenerateID:    i.snowflakeID.Generate,
+		OutboxTopic:   kafka.OrderItemsAmendedTopic,
+	})
+	if err != nil {
+		if errors.Is(err, exceptions.ErrOrderItemNotAmendable) {
+			return exceptions.Error(exceptions.CodeConflict, err.Error())
+		}
+		if errors.Is(err, exceptions.ErrProductOutOfStock) || errors.Is(err, exceptions.ErrIngredientInsufficient) {
+			return exceptions.Error(exceptions.CodeBusiness, err.Error())
+		}
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to amend order item", err)
+	}
+
+	return nil
+}
+
 func (i *Implement) SearchOrderItemsIncomplete(ctx context.Context, orderID int64, search domain.SearchOrderItems) (result domain.SearchOrderItemsResult, err error) {
 	searchParams := buildSearchOrderItemsParams(orderID, search)
 
@@ -246,7 +303,8 @@ func (i *Implement) buildPayloadOrderItems(ctx context.Context, orderItems []sha
 		return []database.CreateOrderItemsParams{}, err
 	}
 
-	statusPreparingID, err := i.GetOrderStatusPreparing(ctx)
+	// new items wait for the kitchen, so the customer can still amend them until it starts preparing
+	statusPendingID, err := i.GetOrderStatusPending(ctx)
 	if err != nil {
 		return []database.CreateOrderItemsParams{}, err
 	}
@@ -256,12 +314,16 @@ func (i *Implement) buildPayloadOrderItems(ctx context.Context, orderItems []sha
 		return []database.CreateOrderItemsParams{}, exceptions.Errorf(exceptions.CodeRepository, "failed to get current time", err)
 	}
 
-	result := make([]database.CreateOrderItemsParams, len(orderItems))
-	for index, item := range orderItems {
-		product, err := i.repository.GetProductByID(ctx, item.ProductID)
+	result := make([]database.CreateOrderItemsParams, 0, len(orderItems))
+	for _, item := range orderItems {
+		// price history decides the price, so a change scheduled for later does not affect this order
+		product, err := i.repository.GetProductByID(ctx, database.GetProductByIDParams{
+			ID:      item.ProductID,
+			PriceAt: currentTime,
+		})
 		if err != nil {
 			if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
-				return []database.CreateOrderItemsParams{}, exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, item.ProductID)
+				return []database.CreateOrderItemsParams{}, i.productNotFoundError(ctx, item.ProductID)
 			}
 
 			return []database.CreateOrderItemsParams{}, exceptions.Errorf(exceptions.CodeRepository, "failed to get product by id", err)
@@ -271,20 +333,94 @@ func (i *Implement) buildPayloadOrderItems(ctx context.Context, orderItems []sha
 			return []database.CreateOrderItemsParams{}, exceptions.Error(exceptions.CodeRepository, "product is null")
 		}
 
-		result[index] = database.CreateOrderItemsParams{
+		isWithinSchedule, err := i.repository.IsProductWithinSchedule(ctx, database.IsProductWithinScheduleParams{
+			ID:       product.ID,
+			TimeZone: i.config.TimeZone,
+		})
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, exceptions.Errorf(exceptions.CodeRepository, "failed to check product schedule", err)
+		}
+
+		if !isWithinSchedule {
+			return []database.CreateOrderItemsParams{}, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product '%s' is not available at this time", product.NameEn))
+		}
+
+		// customers order from the published menu, edits to the draft do not reach them until it is published
+		menu, err := i.getProductMenu(ctx, product.ID)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
+		}
+
+		product, err = i.applyPublishedProduct(ctx, product, menu, currentTime)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
+		}
+
+		variant, err := buildOrderItemVariant(menu, product.ID, item.VariantID)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
+		}
+
+		modifiers, err := buildOrderItemModifiers(menu, product.ID, item.Modifiers)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
+		}
+
+		modifiersJSON, err := json.Marshal(modifiers)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, exceptions.Errorf(exceptions.CodeSystem, "failed to marshal modifiers", err)
+		}
+
+		price := utils.PgNumericToFloat64(product.Price)
+		if variant != nil {
+			price = utils.PgNumericToFloat64(variant.Price)
+		}
+		for _, modifier := range modifiers {
+			price += modifier.PriceDelta
+		}
+
+		orderItem := database.CreateOrderItemsParams{
 			ID:              i.snowflakeID.Generate(),
 			OrderID:         item.OrderID,
 			ProductID:       product.ID,
-			StatusID:        statusPreparingID,
+			StatusID:        statusPendingID,
 			ProductName:     product.Name,
 			ProductNameEn:   product.NameEn,
-			Price:           product.Price,
 			Quantity:        item.Quantity,
 			Note:            utils.StringPtrToPgText(item.Note),
 			CreatedAt:       currentTime,
 			ProductImageUrl: product.ImageUrl,
 			IsVisible:       true,
+			Modifiers:       modifiersJSON,
+		}
+
+		if variant != nil {
+			orderItem.VariantID = pgtype.Int8{Int64: variant.ID, Valid: true}
+			orderItem.VariantName = pgtype.Text{String: variant.Name, Valid: true}
+			orderItem.VariantNameEn = pgtype.Text{String: variant.NameEN, Valid: true}
+		}
+
+		// a combo line is billed at the bundle price but hidden from the kitchen, which prepares its components instead
+		components, comboPriceDelta, err := i.buildOrderItemCombo(menu, orderItem, item.ComboSelections)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
+		}
+
+		orderItem.Price = utils.Float64ToPgNumeric(price + comboPriceDelta)
+		orderItem.IsVisible = len(components) == 0
+
+		err = i.applyOrderItemPromotion(ctx, &orderItem, product.Categories)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
 		}
+
+		lines := append([]database.CreateOrderItemsParams{orderItem}, components...)
+		err = i.attachOrderItemAllergens(ctx, lines)
+		if err != nil {
+			return []database.CreateOrderItemsParams{}, err
+		}
+
+		result = append(result, lines...)
 	}
 
 	return result, nil
@@ -327,6 +463,7 @@ func (i *Implement) validateAndCheckOrder(ctx context.Context, orderID int64) er
 type CurrentOrderItemsRow interface {
 	GetID() int64
 	GetProductID() int64
+	GetStatusID() int64
 	GetStatusName() string
 	GetStatusNameEN() string
 	GetStatusCode() string
@@ -336,24 +473,35 @@ type CurrentOrderItemsRow interface {
 	GetPrice() pgtype.Numeric
 	GetQuantity() int32
 	GetNote() pgtype.Text
+	GetVariantID() pgtype.Int8
+	GetVariantName() pgtype.Text
+	GetVariantNameEN() pgtype.Text
+	GetParentOrderItemID() pgtype.Int8
+	GetModifiers() []byte
 	GetCreatedAt() pgtype.Timestamptz
 }
 
 func transformCurrentOrderItemsByIDResults[T CurrentOrderItemsRow](results T) *domain.CurrentOrderItems {
 	createdAt, _ := utils.PgTimestampToThaiISO8601(results.GetCreatedAt())
 	return &domain.CurrentOrderItems{
-		ID:            results.GetID(),
-		ProductID:     results.GetProductID(),
-		StatusName:    results.GetStatusName(),
-		StatusNameEN:  results.GetStatusNameEN(),
-		StatusCode:    results.GetStatusCode(),
-		ProductName:   results.GetProductName(),
-		ProductNameEN: results.GetProductNameEN(),
-		ImageURL:      utils.PgTextToStringPtr(results.GetImageURL()),
-		Price:         utils.PgNumericToFloat64(results.GetPrice()),
-		Quantity:      results.GetQuantity(),
-		Note:          utils.PgTextToStringPtr(results.GetNote()),
-		CreatedAt:     createdAt,
+		ID:                results.GetID(),
+		ProductID:         results.GetProductID(),
+		StatusID:          results.GetStatusID(),
+		StatusName:        results.GetStatusName(),
+		StatusNameEN:      results.GetStatusNameEN(),
+		StatusCode:        results.GetStatusCode(),
+		ProductName:       results.GetProductName(),
+		ProductNameEN:     results.GetProductNameEN(),
+		ImageURL:          utils.PgTextToStringPtr(results.GetImageURL()),
+		Price:             utils.PgNumericToFloat64(results.GetPrice()),
+		Quantity:          results.GetQuantity(),
+		Note:              utils.PgTextToStringPtr(results.GetNote()),
+		VariantID:         utils.PgInt8ToInt64Ptr(results.GetVariantID()),
+		VariantName:       utils.PgTextToStringPtr(results.GetVariantName()),
+		VariantNameEN:     utils.PgTextToStringPtr(results.GetVariantNameEN()),
+		ParentOrderItemID: utils.PgInt8ToInt64Ptr(results.GetParentOrderItemID()),
+		Modifiers:         shareModel.UnmarshalOrderItemModifiers(results.GetModifiers()),
+		CreatedAt:         createdAt,
 	}
 }
 
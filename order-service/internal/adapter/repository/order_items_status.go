@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"food-story/pkg/exceptions"
+	database "food-story/shared/database/sqlc"
+	"food-story/shared/kafka"
+	shareModel "food-story/shared/model"
+	"strconv"
+)
+
+// ListOrderItemsStatusEvents reads the status changes of an order after the given event from the outbox, which
+// keeps them until the retention cleanup.
+func (i *Implement) ListOrderItemsStatusEvents(ctx context.Context, orderID, afterEventID int64, maxResults int32) ([]*shareModel.OrderItemsStatusChanged, error) {
+	data, err := i.repository.ListOutboxEventsByKey(ctx, database.ListOutboxEventsByKeyParams{
+		Topic:      kafka.OrderItemsStatusChangedTopic,
+		MessageKey: strconv.FormatInt(orderID, 10),
+		AfterSeq:   afterEventID,
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to list order items status events", err)
+	}
+
+	result := make([]*shareModel.OrderItemsStatusChanged, 0, len(data))
+	for _, row := range data {
+		event := new(shareModel.OrderItemsStatusChanged)
+		err = json.Unmarshal(row.Payload, event)
+		if err != nil {
+			return nil, exceptions.Errorf(exceptions.CodeSystem, "failed to unmarshal order items status event", err)
+		}
+		result = append(result, event)
+	}
+
+	return result, nil
+}
+
+func (i *Implement) GetLastOrderItemsStatusEventID(ctx context.Context, orderID int64) (int64, error) {
+	lastID, err := i.repository.GetLastOutboxEventIDByKey(ctx, database.GetLastOutboxEventIDByKeyParams{
+		Topic:      kafka.OrderItemsStatusChangedTopic,
+		MessageKey: strconv.FormatInt(orderID, 10),
+	})
+	if err != nil {
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to get last order items status event", err)
+	}
+
+	return lastID, nil
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	"food-story/shared/config"
+	database "food-story/shared/database/sqlc"
+	mockdb "food-story/shared/mock/database"
+	mockshared "food-story/shared/mock/shared"
+	shareModel "food-story/shared/model"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testMenuVersionID   int64 = 100
+	testProductID       int64 = 1
+	testDrinkID         int64 = 2
+	testDraftProductID  int64 = 3
+	testVariantLarge    int64 = 12
+	testVariantJumbo    int64 = 13
+	testModifierGroup   int64 = 21
+	testModifierEgg     int64 = 22
+	testOrderID         int64 = 500
+	testOrderItemID     int64 = 900
+	testStatusPendingID int64 = 3
+)
+
+// the menu as it was published: a large bowl is 65 and an extra egg costs 10
+const testPublishedProduct = `{
+	"id": "1",
+	"name": "ก๋วยเตี๋ยว",
+	"nameEN": "Noodle soup",
+	"categoryID": "2",
+	"price": 50,
+	"variants": [
+		{"id": "11", "name": "ธรรมดา", "nameEN": "Regular", "price": 50, "isAvailable": true},
+		{"id": "12", "name": "ใหญ่", "nameEN": "Large", "price": 65, "isAvailable": true}
+	],
+	"modifierGroups": [
+		{"id": "21", "name": "เพิ่ม", "nameEN": "Extras", "minSelections": 0, "maxSelections": 1, "modifiers": [
+			{"id": "22", "name": "ไข่", "nameEN": "Egg", "priceDelta": 10, "isAvailable": true}
+		]}
+	],
+	"comboSlots": []
+}`
+
+// expectPublishedThenEditedMenu publishes testPublishedProduct, then edits the draft: the large bowl is renamed
+// and goes up to 80, a jumbo bowl is added and an extra egg goes up to 15. None of it is published.
+func expectPublishedThenEditedMenu(store *mockdb.MockStore) {
+	store.EXPECT().GetPublishedMenuVersionID(gomock.Any()).Return(testMenuVersionID, nil).AnyTimes()
+	store.EXPECT().GetProductPriceAt(gomock.Any(), gomock.Any()).Return(utils.Float64ToPgNumeric(50), nil).AnyTimes()
+	store.EXPECT().ListPublishedProducts(gomock.Any(), database.ListPublishedProductsParams{
+		MenuVersionID: testMenuVersionID,
+		ProductIds:    []int64{testProductID},
+	}).Return([][]byte{[]byte(testPublishedProduct)}, nil).AnyTimes()
+
+	store.EXPECT().ListVariantsByProductIDs(gomock.Any(), gomock.Any()).Return([]*database.ListVariantsByProductIDsRow{
+		{ID: 11, ProductID: testProductID, Name: "ธรรมดา", NameEN: "Regular", Price: utils.Float64ToPgNumeric(50), IsAvailable: true},
+		{ID: testVariantLarge, ProductID: testProductID, Name: "ใหญ่พิเศษ", NameEN: "Extra large", Price: utils.Float64ToPgNumeric(80), IsAvailable: true},
+		{ID: testVariantJumbo, ProductID: testProductID, Name: "จัมโบ้", NameEN: "Jumbo", Price: utils.Float64ToPgNumeric(100), IsAvailable: true},
+	}, nil).AnyTimes()
+	store.EXPECT().ListModifierGroupsByProductIDs(gomock.Any(), gomock.Any()).Return([]*database.ListModifierGroupsByProductIDsRow{
+		{ID: testModifierGroup, ProductID: testProductID, Name: "เพิ่ม", NameEN: "Extras", MinSelections: 0, MaxSelections: 1},
+	}, nil).AnyTimes()
+	store.EXPECT().ListModifiersByGroupIDs(gomock.Any(), gomock.Any()).Return([]*database.ListModifiersByGroupIDsRow{
+		{ID: testModifierEgg, GroupID: testModifierGroup, Name: "ไข่", NameEN: "Egg", PriceDelta: utils.Float64ToPgNumeric(15), IsAvailable: true},
+	}, nil).AnyTimes()
+	store.EXPECT().ListComboSlotsByProductIDs(gomock.Any(), gomock.Any()).Return([]*database.ListComboSlotsByProductIDsRow{}, nil).AnyTimes()
+}
+
+func expectOrderItemPricing(store *mockdb.MockStore, snowflake *mockshared.MockSnowflakeInterface, now pgtype.Timestamptz) {
+	store.EXPECT().GetOrderStatusPending(gomock.Any()).Return(testStatusPendingID, nil)
+	store.EXPECT().GetTimeNow(gomock.Any()).Return(now, nil)
+	store.EXPECT().GetProductByID(gomock.Any(), database.GetProductByIDParams{ID: testProductID, PriceAt: now}).Return(&database.GetProductByIDRow{
+		ID:          testProductID,
+		Name:        "ก๋วยเตี๋ยว",
+		NameEn:      "Noodle soup",
+		Categories:  2,
+		Price:       utils.Float64ToPgNumeric(50),
+		IsAvailable: true,
+	}, nil)
+	store.EXPECT().IsProductWithinSchedule(gomock.Any(), gomock.Any()).Return(true, nil)
+	store.EXPECT().ListActivePromotionsForProduct(gomock.Any(), gomock.Any()).Return([]*database.ListActivePromotionsForProductRow{}, nil).AnyTimes()
+	store.EXPECT().ListDietaryTagsByProductIDs(gomock.Any(), gomock.Any()).Return([]*database.ListDietaryTagsByProductIDsRow{}, nil).AnyTimes()
+	snowflake.EXPECT().Generate().Return(testOrderItemID).AnyTimes()
+}
+
+// the published iced tea is 40, the draft has since renamed it and raised it to 45
+const testPublishedDrink = `{
+	"id": "2",
+	"name": "ชาเย็น",
+	"nameEN": "Iced tea",
+	"categoryID": "3",
+	"price": 40,
+	"variants": [],
+	"modifierGroups": [],
+	"comboSlots": []
+}`
+
+func expectPublishedDrinkEditedInDraft(store *mockdb.MockStore, snowflake *mockshared.MockSnowflakeInterface, now pgtype.Timestamptz, priceHistory pgtype.Numeric) {
+	store.EXPECT().GetOrderStatusPending(gomock.Any()).Return(testStatusPendingID, nil)
+	store.EXPECT().GetTimeNow(gomock.Any()).Return(now, nil)
+	store.EXPECT().GetProductByID(gomock.Any(), database.GetProductByIDParams{ID: testDrinkID, PriceAt: now}).Return(&database.GetProductByIDRow{
+		ID:          testDrinkID,
+		Name:        "ชาเย็นสูตรใหม่",
+		NameEn:      "New iced tea",
+		Categories:  3,
+		Price:       utils.Float64ToPgNumeric(45),
+		IsAvailable: true,
+	}, nil)
+	store.EXPECT().IsProductWithinSchedule(gomock.Any(), gomock.Any()).Return(true, nil)
+	store.EXPECT().GetPublishedMenuVersionID(gomock.Any()).Return(testMenuVersionID, nil)
+	store.EXPECT().ListPublishedProducts(gomock.Any(), database.ListPublishedProductsParams{
+		MenuVersionID: testMenuVersionID,
+		ProductIds:    []int64{testDrinkID},
+	}).Return([][]byte{[]byte(testPublishedDrink)}, nil)
+	store.EXPECT().GetProductPriceAt(gomock.Any(), database.GetProductPriceAtParams{ProductID: testDrinkID, PriceAt: now}).Return(priceHistory, nil)
+	store.EXPECT().ListActivePromotionsForProduct(gomock.Any(), gomock.Any()).Return([]*database.ListActivePromotionsForProductRow{}, nil).AnyTimes()
+	store.EXPECT().ListDietaryTagsByProductIDs(gomock.Any(), gomock.Any()).Return([]*database.ListDietaryTagsByProductIDsRow{}, nil).AnyTimes()
+	snowflake.EXPECT().Generate().Return(testOrderItemID).AnyTimes()
+}
+
+func TestBuildPayloadOrderItemsChargesPublishedPrice(t *testing.T) {
+	now := pgtype.Timestamptz{Time: time.Date(2025, 5, 23, 12, 0, 0, 0, time.UTC), Valid: true}
+
+	tests := []struct {
+		name         string
+		priceHistory pgtype.Numeric
+		wantPrice    float64
+	}{
+		{
+			// the drink predates the price history, so only the snapshot knows its published price
+			name:         "a draft price edit is not charged",
+			priceHistory: pgtype.Numeric{},
+			wantPrice:    40,
+		},
+		{
+			name:         "a price scheduled after publishing is charged",
+			priceHistory: utils.Float64ToPgNumeric(42),
+			wantPrice:    42,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			snowflake := mockshared.NewMockSnowflakeInterface(ctrl)
+			expectPublishedDrinkEditedInDraft(store, snowflake, now, tt.priceHistory)
+
+			repo := NewRepository(config.Config{}, store, snowflake)
+			result, err := repo.buildPayloadOrderItems(context.Background(), []shareModel.OrderItems{{
+				OrderID:   testOrderID,
+				ProductID: testDrinkID,
+				Quantity:  1,
+			}})
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+
+			item := result[0]
+			assert.Equal(t, tt.wantPrice, utils.PgNumericToFloat64(item.Price))
+			assert.Equal(t, "ชาเย็น", item.ProductName)
+			assert.Equal(t, "Iced tea", item.ProductNameEn)
+		})
+	}
+}
+
+func TestBuildPayloadOrderItemsRejectsDraftOnlyProduct(t *testing.T) {
+	now := pgtype.Timestamptz{Time: time.Date(2025, 5, 23, 12, 0, 0, 0, time.UTC), Valid: true}
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	snowflake := mockshared.NewMockSnowflakeInterface(ctrl)
+
+	store.EXPECT().GetOrderStatusPending(gomock.Any()).Return(testStatusPendingID, nil)
+	store.EXPECT().GetTimeNow(gomock.Any()).Return(now, nil)
+	store.EXPECT().GetProductByID(gomock.Any(), database.GetProductByIDParams{ID: testDraftProductID, PriceAt: now}).Return(&database.GetProductByIDRow{
+		ID:          testDraftProductID,
+		Name:        "ข้าวผัด",
+		NameEn:      "Fried rice",
+		Categories:  2,
+		Price:       utils.Float64ToPgNumeric(60),
+		IsAvailable: true,
+	}, nil)
+	store.EXPECT().IsProductWithinSchedule(gomock.Any(), gomock.Any()).Return(true, nil)
+	store.EXPECT().GetPublishedMenuVersionID(gomock.Any()).Return(testMenuVersionID, nil)
+	store.EXPECT().ListPublishedProducts(gomock.Any(), database.ListPublishedProductsParams{
+		MenuVersionID: testMenuVersionID,
+		ProductIds:    []int64{testDraftProductID},
+	}).Return([][]byte{}, nil)
+
+	repo := NewRepository(config.Config{}, store, snowflake)
+	_, err := repo.buildPayloadOrderItems(context.Background(), []shareModel.OrderItems{{
+		OrderID:   testOrderID,
+		ProductID: testDraftProductID,
+		Quantity:  1,
+	}})
+
+	var appErr *exceptions.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, exceptions.CodeProductNotFound, appErr.Code)
+}
+
+func TestBuildPayloadOrderItemsFromPublishedMenu(t *testing.T) {
+	now := pgtype.Timestamptz{Time: time.Date(2025, 5, 23, 12, 0, 0, 0, time.UTC), Valid: true}
+	variantLarge, variantJumbo := testVariantLarge, testVariantJumbo
+
+	t.Run("draft edits do not change what the customer pays", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := mockdb.NewMockStore(ctrl)
+		snowflake := mockshared.NewMockSnowflakeInterface(ctrl)
+		expectPublishedThenEditedMenu(store)
+		expectOrderItemPricing(store, snowflake, now)
+
+		repo := NewRepository(config.Config{}, store, snowflake)
+		result, err := repo.buildPayloadOrderItems(context.Background(), []shareModel.OrderItems{{
+			OrderID:   testOrderID,
+			ProductID: testProductID,
+			VariantID: &variantLarge,
+			Modifiers: []shareModel.OrderItemModifier{{ID: testModifierEgg}},
+			Quantity:  2,
+		}})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+
+		item := result[0]
+		assert.Equal(t, 75.0, utils.PgNumericToFloat64(item.Price))
+		assert.Equal(t, pgtype.Int8{Int64: testVariantLarge, Valid: true}, item.VariantID)
+		assert.Equal(t, "ใหญ่", item.VariantName.String)
+		assert.Equal(t, "Large", item.VariantNameEn.String)
+
+		var modifiers []shareModel.OrderItemModifier
+		require.NoError(t, json.Unmarshal(item.Modifiers, &modifiers))
+		require.Len(t, modifiers, 1)
+		assert.Equal(t, 10.0, modifiers[0].PriceDelta)
+		assert.Equal(t, "Extras", modifiers[0].GroupNameEN)
+	})
+
+	t.Run("a variant only in the draft cannot be ordered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := mockdb.NewMockStore(ctrl)
+		snowflake := mockshared.NewMockSnowflakeInterface(ctrl)
+		expectPublishedThenEditedMenu(store)
+		expectOrderItemPricing(store, snowflake, now)
+
+		repo := NewRepository(config.Config{}, store, snowflake)
+		_, err := repo.buildPayloadOrderItems(context.Background(), []shareModel.OrderItems{{
+			OrderID:   testOrderID,
+			ProductID: testProductID,
+			VariantID: &variantJumbo,
+			Quantity:  1,
+		}})
+
+		var appErr *exceptions.AppError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, exceptions.CodeBusiness, appErr.Code)
+	})
+}
@@ -5,10 +5,10 @@ import (
 	"food-story/pkg/exceptions"
 )
 
-func (i *Implement) GetOrderStatusPreparing(ctx context.Context) (result int64, err error) {
-	id, err := i.repository.GetOrderStatusPreparing(ctx)
+func (i *Implement) GetOrderStatusPending(ctx context.Context) (result int64, err error) {
+	id, err := i.repository.GetOrderStatusPending(ctx)
 	if err != nil {
-		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to get order status preparing", err)
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to get order status pending", err)
 	}
 
 	return id, nil
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	"sort"
+)
+
+// ClaimOutboxEvents leases up to batchSize pending events, another relay skips them until the lease ends. The
+// events come back in the order they were written.
+func (i *Implement) ClaimOutboxEvents(ctx context.Context, batchSize, leaseSeconds int32) ([]*domain.OutboxEvent, error) {
+	data, err := i.repository.ClaimOutboxEvents(ctx, database.ClaimOutboxEventsParams{
+		LeaseSeconds: leaseSeconds,
+		BatchSize:    batchSize,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to claim outbox events", err)
+	}
+
+	result := make([]*domain.OutboxEvent, len(data))
+	for index, row := range data {
+		result[index] = &domain.OutboxEvent{
+			ID:         row.ID,
+			Seq:        row.Seq,
+			Topic:      row.Topic,
+			MessageKey: utils.PgTextToStringPtr(row.MessageKey),
+			Payload:    row.Payload,
+			Attempts:   row.Attempts,
+		}
+	}
+
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Seq < result[b].Seq
+	})
+
+	return result, nil
+}
+
+func (i *Implement) MarkOutboxEventSent(ctx context.Context, id int64) error {
+	err := i.repository.MarkOutboxEventSent(ctx, id)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to mark outbox event sent", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) MarkOutboxEventFailed(ctx context.Context, id int64, lastError string, maxBackoffSeconds int32) error {
+	err := i.repository.MarkOutboxEventFailed(ctx, database.MarkOutboxEventFailedParams{
+		ID:                id,
+		LastError:         lastError,
+		MaxBackoffSeconds: maxBackoffSeconds,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to mark outbox event failed", err)
+	}
+
+	return nil
+}
+
+func (i *Implement) DeleteSentOutboxEvents(ctx context.Context, retentionDays int32) (int64, error) {
+	rows, err := i.repository.DeleteSentOutboxEvents(ctx, retentionDays)
+	if err != nil {
+		return 0, exceptions.Errorf(exceptions.CodeRepository, "failed to delete sent outbox events", err)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/exceptions"
+)
+
+// productNotFoundError แยกกรณีสินค้าหมด (stock = 0) ออกจากกรณีไม่พบสินค้า
+func (i *Implement) productNotFoundError(ctx context.Context, productID int64) error {
+	isSoldOut, err := i.repository.IsProductSoldOut(ctx, productID)
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to check product stock", err)
+	}
+
+	if isSoldOut {
+		return exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("%s: product id '%d'", exceptions.ErrProductOutOfStock.Error(), productID))
+	}
+
+	return exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, productID)
+}
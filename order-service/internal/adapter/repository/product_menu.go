@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// productMenu holds the variants, modifiers and combo slots a customer can pick for a product. It comes from
+// the published menu version when there is one, the live tables are only a draft until they are published.
+type productMenu struct {
+	// published is the product as it was published, nil until the first menu version is published
+	published      *publishedProduct
+	variants       []*database.ListVariantsByProductIDsRow
+	modifierGroups []*database.ListModifierGroupsByProductIDsRow
+	modifiers      []*database.ListModifiersByGroupIDsRow
+	comboSlots     []*database.ListComboSlotsByProductIDsRow
+	comboOptions   []*database.ListComboSlotOptionsBySlotIDsRow
+}
+
+// publishedProduct decodes the part of a menu snapshot product that ordering needs
+type publishedProduct struct {
+	ID             int64                     `json:"id,string"`
+	Name           string                    `json:"name"`
+	NameEN         string                    `json:"nameEN"`
+	CategoryID     int64                     `json:"categoryID,string"`
+	Price          float64                   `json:"price"`
+	ImageURL       *string                   `json:"imageURL"`
+	Variants       []*publishedVariant       `json:"variants"`
+	ModifierGroups []*publishedModifierGroup `json:"modifierGroups"`
+	ComboSlots     []*publishedComboSlot     `json:"comboSlots"`
+}
+
+type publishedVariant struct {
+	ID          int64   `json:"id,string"`
+	Name        string  `json:"name"`
+	NameEN      string  `json:"nameEN"`
+	Price       float64 `json:"price"`
+	IsAvailable bool    `json:"isAvailable"`
+}
+
+type publishedModifierGroup struct {
+	ID            int64                `json:"id,string"`
+	Name          string               `json:"name"`
+	NameEN        string               `json:"nameEN"`
+	MinSelections int32                `json:"minSelections"`
+	MaxSelections int32                `json:"maxSelections"`
+	Modifiers     []*publishedModifier `json:"modifiers"`
+}
+
+type publishedModifier struct {
+	ID          int64   `json:"id,string"`
+	Name        string  `json:"name"`
+	NameEN      string  `json:"nameEN"`
+	PriceDelta  float64 `json:"priceDelta"`
+	IsAvailable bool    `json:"isAvailable"`
+}
+
+type publishedComboSlot struct {
+	ID      int64                   `json:"id,string"`
+	Name    string                  `json:"name"`
+	NameEN  string                  `json:"nameEN"`
+	Options []*publishedComboOption `json:"options"`
+}
+
+type publishedComboOption struct {
+	ProductID   int64   `json:"productID,string"`
+	Name        string  `json:"name"`
+	NameEN      string  `json:"nameEN"`
+	ImageURL    *string `json:"imageURL"`
+	PriceDelta  float64 `json:"priceDelta"`
+	IsAvailable bool    `json:"isAvailable"`
+}
+
+func (i *Implement) getProductMenu(ctx context.Context, productID int64) (*productMenu, error) {
+	menuVersionID, err := i.repository.GetPublishedMenuVersionID(ctx)
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return i.getLiveProductMenu(ctx, productID)
+		}
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to get published menu version", err)
+	}
+
+	return i.getPublishedProductMenu(ctx, menuVersionID, productID)
+}
+
+// applyPublishedProduct bills the product under its published name, category and price. The price history holds
+// the published price from the moment the version went live and any price scheduled since, a draft edit never
+// reaches it. Availability and stock stay live.
+func (i *Implement) applyPublishedProduct(ctx context.Context, product *database.GetProductByIDRow, menu *productMenu, priceAt pgtype.Timestamptz) (*database.GetProductByIDRow, error) {
+	if menu.published == nil {
+		return product, nil
+	}
+
+	price, err := i.repository.GetProductPriceAt(ctx, database.GetProductPriceAtParams{
+		ProductID: product.ID,
+		PriceAt:   priceAt,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to get product price", err)
+	}
+
+	if !price.Valid {
+		price = utils.Float64ToPgNumeric(menu.published.Price)
+	}
+
+	published := *product
+	published.Name = menu.published.Name
+	published.NameEn = menu.published.NameEN
+	published.Categories = menu.published.CategoryID
+	published.Price = price
+	published.ImageUrl = utils.StringPtrToPgText(menu.published.ImageURL)
+	return &published, nil
+}
+
+// getLiveProductMenu is used until the first menu version is published
+func (i *Implement) getLiveProductMenu(ctx context.Context, productID int64) (*productMenu, error) {
+	menu := new(productMenu)
+
+	var err error
+	menu.variants, err = i.repository.ListVariantsByProductIDs(ctx, []int64{productID})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch product variants", err)
+	}
+
+	menu.modifierGroups, err = i.repository.ListModifierGroupsByProductIDs(ctx, []int64{productID})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch modifier groups", err)
+	}
+
+	if len(menu.modifierGroups) > 0 {
+		groupIDs := make([]int64, len(menu.modifierGroups))
+		for index, group := range menu.modifierGroups {
+			groupIDs[index] = group.ID
+		}
+
+		menu.modifiers, err = i.repository.ListModifiersByGroupIDs(ctx, groupIDs)
+		if err != nil {
+			return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch modifiers", err)
+		}
+	}
+
+	menu.comboSlots, err = i.repository.ListComboSlotsByProductIDs(ctx, []int64{productID})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch combo slots", err)
+	}
+
+	if len(menu.comboSlots) > 0 {
+		slotIDs := make([]int64, len(menu.comboSlots))
+		for index, slot := range menu.comboSlots {
+			slotIDs[index] = slot.ID
+		}
+
+		menu.comboOptions, err = i.repository.ListComboSlotOptionsBySlotIDs(ctx, slotIDs)
+		if err != nil {
+			return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch combo slot options", err)
+		}
+	}
+
+	return menu, nil
+}
+
+// getPublishedProductMenu reads the options from the snapshot customers are shown. Like the menu-service, only
+// availability of the products themselves stays live, so a sold out drink cannot be picked in a combo.
+func (i *Implement) getPublishedProductMenu(ctx context.Context, menuVersionID, productID int64) (*productMenu, error) {
+	data, err := i.repository.ListPublishedProducts(ctx, database.ListPublishedProductsParams{
+		MenuVersionID: menuVersionID,
+		ProductIds:    []int64{productID},
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch published product", err)
+	}
+
+	if len(data) == 0 {
+		return nil, exceptions.ErrorIDNotFound(exceptions.CodeProductNotFound, productID)
+	}
+
+	product := new(publishedProduct)
+	err = json.Unmarshal(data[0], product)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeSystem, "failed to decode menu snapshot", err)
+	}
+
+	menu := &productMenu{published: product}
+	for _, variant := range product.Variants {
+		menu.variants = append(menu.variants, &database.ListVariantsByProductIDsRow{
+			ID:          variant.ID,
+			ProductID:   product.ID,
+			Name:        variant.Name,
+			NameEN:      variant.NameEN,
+			Price:       utils.Float64ToPgNumeric(variant.Price),
+			IsAvailable: variant.IsAvailable,
+		})
+	}
+
+	for _, group := range product.ModifierGroups {
+		menu.modifierGroups = append(menu.modifierGroups, &database.ListModifierGroupsByProductIDsRow{
+			ID:            group.ID,
+			ProductID:     product.ID,
+			Name:          group.Name,
+			NameEN:        group.NameEN,
+			MinSelections: group.MinSelections,
+			MaxSelections: group.MaxSelections,
+		})
+
+		for _, modifier := range group.Modifiers {
+			menu.modifiers = append(menu.modifiers, &database.ListModifiersByGroupIDsRow{
+				ID:          modifier.ID,
+				GroupID:     group.ID,
+				Name:        modifier.Name,
+				NameEN:      modifier.NameEN,
+				PriceDelta:  utils.Float64ToPgNumeric(modifier.PriceDelta),
+				IsAvailable: modifier.IsAvailable,
+			})
+		}
+	}
+
+	if len(product.ComboSlots) == 0 {
+		return menu, nil
+	}
+
+	var optionProductIDs []int64
+	for _, slot := range product.ComboSlots {
+		for _, option := range slot.Options {
+			optionProductIDs = append(optionProductIDs, option.ProductID)
+		}
+	}
+
+	availableIDs, err := i.repository.ListAvailableProductIDs(ctx, optionProductIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to check combo option availability", err)
+	}
+
+	available := make(map[int64]bool, len(availableIDs))
+	for _, id := range availableIDs {
+		available[id] = true
+	}
+
+	for _, slot := range product.ComboSlots {
+		menu.comboSlots = append(menu.comboSlots, &database.ListComboSlotsByProductIDsRow{
+			ID:             slot.ID,
+			ComboProductID: product.ID,
+			Name:           slot.Name,
+			NameEN:         slot.NameEN,
+		})
+
+		for _, option := range slot.Options {
+			menu.comboOptions = append(menu.comboOptions, &database.ListComboSlotOptionsBySlotIDsRow{
+				SlotID:      slot.ID,
+				ProductID:   option.ProductID,
+				Name:        option.Name,
+				NameEN:      option.NameEN,
+				ImageURL:    utils.StringPtrToPgText(option.ImageURL),
+				PriceDelta:  utils.Float64ToPgNumeric(option.PriceDelta),
+				IsAvailable: option.IsAvailable && available[option.ProductID],
+			})
+		}
+	}
+
+	return menu, nil
+}
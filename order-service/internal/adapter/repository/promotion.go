@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	shareModel "food-story/shared/model"
+	"math"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// applyOrderItemPromotion records the promotion of a new billable line. The largest percentage or fixed-amount
+// discount wins; a buy-X-get-Y rule is only recorded because its discount depends on every line of the order,
+// it is settled when the bill is totalled from the buy and free quantities copied onto the line here.
+func (i *Implement) applyOrderItemPromotion(ctx context.Context, orderItem *database.CreateOrderItemsParams, categoryID int64) error {
+	orderItem.DiscountAmount = utils.Float64ToPgNumeric(0)
+
+	promotions, err := i.repository.ListActivePromotionsForProduct(ctx, database.ListActivePromotionsForProductParams{
+		ProductID:  orderItem.ProductID,
+		CategoryID: categoryID,
+		At:         orderItem.CreatedAt,
+		TimeZone:   i.config.TimeZone,
+	})
+	if err != nil {
+		return exceptions.Errorf(exceptions.CodeRepository, "failed to get active promotions", err)
+	}
+
+	price := utils.PgNumericToFloat64(orderItem.Price)
+	var (
+		promotionID  int64
+		unitDiscount float64
+		buyXGetY     *database.ListActivePromotionsForProductRow
+		hasPromotion bool
+	)
+	for _, promotion := range promotions {
+		discountValue := utils.PgNumericToFloat64(promotion.DiscountValue)
+
+		var discount float64
+		switch promotion.PromotionType {
+		case shareModel.PromotionTypePercentage:
+			discount = math.Round(price*discountValue) / 100
+		case shareModel.PromotionTypeFixedAmount:
+			discount = math.Min(discountValue, price)
+		case shareModel.PromotionTypeBuyXGetY:
+			if buyXGetY == nil {
+				buyXGetY = promotion
+			}
+			continue
+		}
+
+		if discount > unitDiscount {
+			promotionID, unitDiscount, hasPromotion = promotion.ID, discount, true
+		}
+	}
+
+	if !hasPromotion && buyXGetY != nil {
+		promotionID, hasPromotion = buyXGetY.ID, true
+		orderItem.PromotionBuyQuantity = buyXGetY.BuyQuantity
+		orderItem.PromotionFreeQuantity = buyXGetY.FreeQuantity
+	}
+
+	if hasPromotion {
+		orderItem.PromotionID = pgtype.Int8{Int64: promotionID, Valid: true}
+		orderItem.DiscountAmount = utils.Float64ToPgNumeric(unitDiscount * float64(orderItem.Quantity))
+	}
+
+	return nil
+}
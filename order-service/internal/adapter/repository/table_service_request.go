@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+	"food-story/shared/kafka"
+	shareModel "food-story/shared/model"
+)
+
+func (i *Implement) CreateTableServiceRequest(ctx context.Context, payload domain.CreateTableServiceRequest) (result *shareModel.TableServiceRequest, created bool, err error) {
+	result, created, err = i.repository.TXCreateTableServiceRequest(ctx, database.TXCreateTableServiceRequestParams{
+		CreateTableServiceRequest: database.CreateTableServiceRequestParams{
+			ID:          i.snowflakeID.Generate(),
+			SessionID:   utils.UUIDToPgUUID(payload.SessionID),
+			TableID:     payload.TableID,
+			OrderID:     utils.Int64PtrToPgInt8(payload.OrderID),
+			RequestType: payload.RequestType,
+			Reason:      utils.StringPtrToPgText(payload.Reason),
+		},
+		GenerateID:  i.snowflakeID.Generate,
+		OutboxTopic: kafka.TableServiceRequestsChangedTopic,
+	})
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return nil, false, exceptions.ErrorIDNotFound(exceptions.CodeTableNotFound, payload.TableID)
+		}
+		return nil, false, exceptions.Errorf(exceptions.CodeRepository, "failed to create table service request", err)
+	}
+
+	return result, created, nil
+}
+
+func (i *Implement) UpdateTableServiceRequestStatus(ctx context.Context, id int64, status string) (result *shareModel.TableServiceRequest, err error) {
+	result, err = i.repository.TXUpdateTableServiceRequestStatus(ctx, database.TXUpdateTableServiceRequestStatusParams{
+		ID:          id,
+		Status:      status,
+		GenerateID:  i.snowflakeID.Generate,
+		OutboxTopic: kafka.TableServiceRequestsChangedTopic,
+	})
+	if err != nil {
+		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return nil, exceptions.ErrorIDNotFound(exceptions.CodeServiceRequestNotFound, id)
+		}
+		if errors.Is(err, exceptions.ErrServiceRequestStatus) {
+			return nil, exceptions.Error(exceptions.CodeConflict, err.Error())
+		}
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update table service request status", err)
+	}
+
+	return result, nil
+}
+
+func (i *Implement) ListOpenTableServiceRequests(ctx context.Context) (result []*shareModel.TableServiceRequest, err error) {
+	data, err := i.repository.ListTableServiceRequests(ctx, database.ListTableServiceRequestsParams{
+		OpenOnly: true,
+	})
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to list table service requests", err)
+	}
+
+	result = make([]*shareModel.TableServiceRequest, len(data))
+	for index, row := range data {
+		result[index], err = row.ToModel()
+		if err != nil {
+			return nil, exceptions.Errorf(exceptions.CodeSystem, "failed to transform table service request", err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"food-story/pkg/exceptions"
+	"food-story/shared/i18n"
+)
+
+func (i *Implement) LoadTranslations(ctx context.Context, locales []string, entityIDs []int64) (*i18n.Translations, error) {
+	translations, err := i18n.Load(ctx, i.repository, locales, entityIDs)
+	if err != nil {
+		return nil, exceptions.Errorf(exceptions.CodeRepository, "failed to fetch translations", err)
+	}
+
+	return translations, nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"fmt"
+	"food-story/pkg/exceptions"
+	database "food-story/shared/database/sqlc"
+)
+
+func buildOrderItemVariant(menu *productMenu, productID int64, variantID *int64) (*database.ListVariantsByProductIDsRow, error) {
+	variants := menu.variants
+	if len(variants) == 0 {
+		if variantID != nil {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' has no variants", productID))
+		}
+		return nil, nil
+	}
+
+	if variantID == nil {
+		return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("product id '%d' requires a variant", productID))
+	}
+
+	for _, variant := range variants {
+		if variant.ID != *variantID {
+			continue
+		}
+
+		if !variant.IsAvailable {
+			return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("variant '%s' is not available", variant.NameEN))
+		}
+
+		return variant, nil
+	}
+
+	return nil, exceptions.Error(exceptions.CodeBusiness, fmt.Sprintf("variant id '%d' does not belong to product id '%d'", *variantID, productID))
+}
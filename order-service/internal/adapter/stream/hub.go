@@ -0,0 +1,97 @@
+package stream
+
+import (
+	shareModel "food-story/shared/model"
+	"sync"
+)
+
+// _subscriberBuffer is how many events a slow client may fall behind before it is dropped, it reconnects with
+// Last-Event-ID and catches up from the outbox.
+const _subscriberBuffer = 64
+
+type OrderStatusHubInterface interface {
+	Subscribe(orderID int64) <-chan *shareModel.OrderItemsStatusChanged
+	Unsubscribe(orderID int64, events <-chan *shareModel.OrderItemsStatusChanged)
+	Publish(event *shareModel.OrderItemsStatusChanged)
+	Shutdown()
+}
+
+// OrderStatusHub fans status changes out to the customers of each order connected to this instance.
+type OrderStatusHub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[<-chan *shareModel.OrderItemsStatusChanged]chan *shareModel.OrderItemsStatusChanged
+	closed      bool
+}
+
+func NewOrderStatusHub() *OrderStatusHub {
+	return &OrderStatusHub{
+		subscribers: make(map[int64]map[<-chan *shareModel.OrderItemsStatusChanged]chan *shareModel.OrderItemsStatusChanged),
+	}
+}
+
+var _ OrderStatusHubInterface = (*OrderStatusHub)(nil)
+
+func (h *OrderStatusHub) Subscribe(orderID int64) <-chan *shareModel.OrderItemsStatusChanged {
+	events := make(chan *shareModel.OrderItemsStatusChanged, _subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(events)
+		return events
+	}
+
+	if h.subscribers[orderID] == nil {
+		h.subscribers[orderID] = make(map[<-chan *shareModel.OrderItemsStatusChanged]chan *shareModel.OrderItemsStatusChanged)
+	}
+	h.subscribers[orderID][events] = events
+
+	return events
+}
+
+func (h *OrderStatusHub) Unsubscribe(orderID int64, events <-chan *shareModel.OrderItemsStatusChanged) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(orderID, events)
+}
+
+func (h *OrderStatusHub) Publish(event *shareModel.OrderItemsStatusChanged) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key, events := range h.subscribers[event.OrderID] {
+		select {
+		case events <- event:
+		default:
+			h.remove(event.OrderID, key)
+		}
+	}
+}
+
+// Shutdown ends every stream, clients reconnect to another instance.
+func (h *OrderStatusHub) Shutdown() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for orderID, subscribers := range h.subscribers {
+		for key := range subscribers {
+			h.remove(orderID, key)
+		}
+	}
+	h.closed = true
+}
+
+func (h *OrderStatusHub) remove(orderID int64, key <-chan *shareModel.OrderItemsStatusChanged) {
+	events, ok := h.subscribers[orderID][key]
+	if !ok {
+		return
+	}
+
+	close(events)
+	delete(h.subscribers[orderID], key)
+	if len(h.subscribers[orderID]) == 0 {
+		delete(h.subscribers, orderID)
+	}
+}
@@ -0,0 +1,84 @@
+package stream
+
+import (
+	shareModel "food-story/shared/model"
+	"sync"
+)
+
+type ServiceRequestHubInterface interface {
+	Subscribe() <-chan *shareModel.TableServiceRequestChanged
+	Unsubscribe(events <-chan *shareModel.TableServiceRequestChanged)
+	Publish(event *shareModel.TableServiceRequestChanged)
+	Shutdown()
+}
+
+// ServiceRequestHub fans table service requests out to every staff screen connected to this instance.
+type ServiceRequestHub struct {
+	mu          sync.Mutex
+	subscribers map[<-chan *shareModel.TableServiceRequestChanged]chan *shareModel.TableServiceRequestChanged
+	closed      bool
+}
+
+func NewServiceRequestHub() *ServiceRequestHub {
+	return &ServiceRequestHub{
+		subscribers: make(map[<-chan *shareModel.TableServiceRequestChanged]chan *shareModel.TableServiceRequestChanged),
+	}
+}
+
+var _ ServiceRequestHubInterface = (*ServiceRequestHub)(nil)
+
+func (h *ServiceRequestHub) Subscribe() <-chan *shareModel.TableServiceRequestChanged {
+	events := make(chan *shareModel.TableServiceRequestChanged, _subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(events)
+		return events
+	}
+
+	h.subscribers[events] = events
+	return events
+}
+
+func (h *ServiceRequestHub) Unsubscribe(events <-chan *shareModel.TableServiceRequestChanged) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(events)
+}
+
+// Publish drops a screen that fell behind, it reconnects and starts again from a snapshot.
+func (h *ServiceRequestHub) Publish(event *shareModel.TableServiceRequestChanged) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key, events := range h.subscribers {
+		select {
+		case events <- event:
+		default:
+			h.remove(key)
+		}
+	}
+}
+
+func (h *ServiceRequestHub) Shutdown() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for key := range h.subscribers {
+		h.remove(key)
+	}
+	h.closed = true
+}
+
+func (h *ServiceRequestHub) remove(key <-chan *shareModel.TableServiceRequestChanged) {
+	events, ok := h.subscribers[key]
+	if !ok {
+		return
+	}
+
+	close(events)
+	delete(h.subscribers, key)
+}
@@ -9,6 +9,7 @@ import (
 	orderhd "food-story/order-service/internal/adapter/http"
 	"food-story/order-service/internal/adapter/queue/producer"
 	"food-story/order-service/internal/adapter/repository"
+	"food-story/order-service/internal/adapter/stream"
 	"food-story/order-service/internal/usecase"
 	"food-story/pkg/common"
 	"food-story/pkg/middleware"
@@ -19,6 +20,7 @@ import (
 	"food-story/shared/snowflakeid"
 	"log"
 	"log/slog"
+	"os"
 	"strings"
 
 	"github.com/IBM/sarama"
@@ -34,13 +36,18 @@ const EnvFile = ".env"
 const ServiceName = "order-service"
 
 type FiberServer struct {
-	App    *fiber.App
-	Config config.Config
-
-	db            *pgxpool.Pool
-	redis         *redis.RedisClient
-	kafkaProducer sarama.SyncProducer
-	clientKafka   sarama.Client
+	App               *fiber.App
+	Config            config.Config
+	UseCase           usecase.Usecase
+	KafkaConsumer     sarama.ConsumerGroup
+	OrderStatusHub    *stream.OrderStatusHub
+	ServiceRequestHub *stream.ServiceRequestHub
+
+	db                  *pgxpool.Pool
+	redis               *redis.RedisClient
+	kafkaProducer       sarama.SyncProducer
+	clientKafka         sarama.Client
+	clientKafkaConsumer sarama.Client
 }
 
 func (s *FiberServer) CloseAllConnection() {
@@ -72,6 +79,15 @@ func (s *FiberServer) CloseAllConnection() {
 		log.Println("Kafka Client closed")
 	}
 
+	if s.clientKafkaConsumer != nil {
+		err := s.clientKafkaConsumer.Close()
+		if err != nil {
+			log.Fatal(err)
+			return
+		}
+		log.Println("Kafka Consumer Client closed")
+	}
+
 }
 
 func New() (*FiberServer, error) {
@@ -123,13 +139,33 @@ func New() (*FiberServer, error) {
 
 	// connect to kafka
 	brokers := strings.Split(configApp.KafkaBrokers, ",")
-	producerKafka, clientKafka, err := kafka.InitProducer(brokers)
+	producerConfig, err := kafka.ParseProducerConfig(configApp.KafkaProducerAcks, configApp.KafkaIdempotent, configApp.KafkaProducerRetries, configApp.KafkaCompression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init kafka producer config: %w", err)
+	}
+
+	producerKafka, clientKafka, err := kafka.InitProducer(brokers, producerConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to init kafka producer: %w", err)
 	}
 
-	// Create a new Node with a Node number of 1
-	node, err := snowflakeid.CreateSnowflakeNode(1)
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+	consumerKafka, clientKafkaConsumer, err := kafka.InitConsumer(kafka.OrderStatusStreamGroup+"-"+hostname, brokers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init kafka consumer: %w", err)
+	}
+
+	// สร้าง hub สำหรับส่งสถานะรายการอาหารให้ลูกค้า
+	orderStatusHub := stream.NewOrderStatusHub()
+
+	// สร้าง hub สำหรับแจ้งคำขอจากโต๊ะให้พนักงาน
+	serviceRequestHub := stream.NewServiceRequestHub()
+
+	// each service has its own node number so IDs written to the shared database never collide
+	node, err := snowflakeid.CreateSnowflakeNode(snowflakeid.NodeNumber(configApp.SnowflakeNodeID, snowflakeid.NodeOrderService))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snowflake: %w", err)
 	}
@@ -156,14 +192,19 @@ func New() (*FiberServer, error) {
 		ReadinessEndpoint: common.ReadinessEndpoint,
 	}))
 
-	registerHandlers(apiV1, store, validator, snowflakeNode, configApp, redisConn, producerKafka, authInstance)
+	orderUseCase := registerHandlers(apiV1, store, validator, snowflakeNode, configApp, redisConn, producerKafka, orderStatusHub, serviceRequestHub, authInstance)
 	return &FiberServer{
-		App:           app,
-		Config:        configApp,
-		db:            dbConn,
-		redis:         redisConn,
-		kafkaProducer: producerKafka,
-		clientKafka:   clientKafka,
+		App:                 app,
+		Config:              configApp,
+		UseCase:             orderUseCase,
+		KafkaConsumer:       consumerKafka,
+		OrderStatusHub:      orderStatusHub,
+		ServiceRequestHub:   serviceRequestHub,
+		db:                  dbConn,
+		redis:               redisConn,
+		kafkaProducer:       producerKafka,
+		clientKafka:         clientKafka,
+		clientKafkaConsumer: clientKafkaConsumer,
 	}, nil
 }
 
@@ -189,11 +230,13 @@ func readiness(ctx context.Context, dbConn *pgxpool.Pool, redisConn *redis.Redis
 	return true
 }
 
-func registerHandlers(router fiber.Router, store database.Store, validator *middleware.CustomValidator, snowflakeNode *snowflakeid.SnowflakeImpl, configApp config.Config, redisConn *redis.RedisClient, producerKafka sarama.SyncProducer, authInstance *middleware.AuthInstance) {
+func registerHandlers(router fiber.Router, store database.Store, validator *middleware.CustomValidator, snowflakeNode *snowflakeid.SnowflakeImpl, configApp config.Config, redisConn *redis.RedisClient, producerKafka sarama.SyncProducer, orderStatusHub stream.OrderStatusHubInterface, serviceRequestHub stream.ServiceRequestHubInterface, authInstance *middleware.AuthInstance) usecase.Usecase {
 	orderQueue := producer.NewQueue(producerKafka)
 	orderCache := cache.NewRedisTableCache(redisConn)
+	idempotencyCache := cache.NewRedisIdempotencyCache(redisConn)
 	orderRepo := repository.NewRepository(configApp, store, snowflakeNode)
-	orderUseCase := usecase.NewUsecase(configApp, *orderRepo, orderCache, orderQueue)
+	orderUseCase := usecase.NewUsecase(configApp, *orderRepo, orderCache, idempotencyCache, orderQueue, orderStatusHub, serviceRequestHub)
 
 	orderhd.NewHTTPHandler(router, orderUseCase, validator, configApp, authInstance)
+	return orderUseCase
 }
@@ -0,0 +1,32 @@
+package domain
+
+type Bill struct {
+	OrderID int64 `json:"orderID,string" example:"1921828287366041600"`
+	// BuyXGetYDiscount is the value of free items across the order, line discounts are already in each item's amount
+	BuyXGetYDiscount float64     `json:"buyXGetYDiscount" example:"0"`
+	TotalAmount      float64     `json:"totalAmount" example:"189"`
+	Items            []*BillItem `json:"items"`
+}
+
+type BillItem struct {
+	ID            int64   `json:"id,string" example:"1920153361642950656"`
+	ProductID     int64   `json:"productID,string" example:"1921822053405560832"`
+	ProductName   string  `json:"productName" example:"ชุด A"`
+	ProductNameEN string  `json:"productNameEN" example:"Set A"`
+	VariantID     *int64  `json:"variantID,string" example:"1921822053405560834"`
+	VariantName   *string `json:"variantName" example:"ใหญ่"`
+	VariantNameEN *string `json:"variantNameEN" example:"Large"`
+	Price         float64 `json:"price" example:"189"`
+	Quantity      int32   `json:"quantity" example:"1"`
+	Discount      float64 `json:"discount" example:"0"`
+	Amount        float64 `json:"amount" example:"189"`
+	StatusCode    string  `json:"statusCode" example:"SERVED"`
+
+	PromotionID     *int64  `json:"promotionID,string" example:"1921822053405560838"`
+	PromotionName   *string `json:"promotionName" example:"แฮปปี้อาวร์"`
+	PromotionNameEN *string `json:"promotionNameEN" example:"Happy hour"`
+	PromotionType   *string `json:"promotionType" example:"PERCENTAGE"`
+
+	LocalizedProductName string  `json:"localizedProductName" example:"套餐 A"`
+	LocalizedVariantName *string `json:"localizedVariantName" example:"大份"`
+}
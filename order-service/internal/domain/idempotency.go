@@ -0,0 +1,9 @@
+package domain
+
+// IdempotentResponse is what a request sent with an Idempotency-Key answered, StatusCode 0 means the first
+// request is still being processed.
+type IdempotentResponse struct {
+	Fingerprint string `json:"fingerprint"`
+	StatusCode  int    `json:"statusCode"`
+	Body        []byte `json:"body"`
+}
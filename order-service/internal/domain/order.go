@@ -14,6 +14,8 @@ type Order struct {
 	StatusName   string `json:"statusName"`
 	StatusNameEN string `json:"statusNameEN"`
 	StatusCode   string `json:"statusCode"`
+
+	LocalizedStatusName string `json:"localizedStatusName"`
 }
 
 type OrderStatus struct {
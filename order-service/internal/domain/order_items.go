@@ -3,18 +3,28 @@ package domain
 import shareModel "food-story/shared/model"
 
 type CurrentOrderItems struct {
-	ID            int64   `json:"id,string" example:"1920153361642950656"`
-	ProductID     int64   `json:"productID,string" example:"1920153361642950656"`
-	StatusName    string  `json:"statusName" example:"กำลังเตรียมอาหาร"`
-	StatusNameEN  string  `json:"statusNameEN" example:"Preparing"`
-	StatusCode    string  `json:"statusCode" example:"PREPARING"`
-	ProductName   string  `json:"productName" example:"ข้าวผัด"`
-	ProductNameEN string  `json:"productNameEN" example:"Fried rice"`
-	ImageURL      *string `json:"imageURL" example:"https://example.com/image.jpg"`
-	Price         float64 `json:"price" example:"60"`
-	Quantity      int32   `json:"quantity" example:"1"`
-	Note          *string `json:"note" example:"lorem ipsum"`
-	CreatedAt     string  `json:"createdAt" example:"2025-05-23T11:59:50.010316+07:00"`
+	ID                int64                          `json:"id,string" example:"1920153361642950656"`
+	ProductID         int64                          `json:"productID,string" example:"1920153361642950656"`
+	StatusID          int64                          `json:"statusID,string" example:"1921868485739155458"`
+	StatusName        string                         `json:"statusName" example:"กำลังเตรียมอาหาร"`
+	StatusNameEN      string                         `json:"statusNameEN" example:"Preparing"`
+	StatusCode        string                         `json:"statusCode" example:"PREPARING"`
+	ProductName       string                         `json:"productName" example:"ข้าวผัด"`
+	ProductNameEN     string                         `json:"productNameEN" example:"Fried rice"`
+	ImageURL          *string                        `json:"imageURL" example:"https://example.com/image.jpg"`
+	Price             float64                        `json:"price" example:"60"`
+	Quantity          int32                          `json:"quantity" example:"1"`
+	Note              *string                        `json:"note" example:"lorem ipsum"`
+	VariantID         *int64                         `json:"variantID,string" example:"1921822053405560834"`
+	VariantName       *string                        `json:"variantName" example:"ใหญ่"`
+	VariantNameEN     *string                        `json:"variantNameEN" example:"Large"`
+	ParentOrderItemID *int64                         `json:"parentOrderItemID,string" example:"1920153361642950655"`
+	Modifiers         []shareModel.OrderItemModifier `json:"modifiers"`
+	CreatedAt         string                         `json:"createdAt" example:"2025-05-23T11:59:50.010316+07:00"`
+
+	LocalizedProductName string  `json:"localizedProductName" example:"炒饭"`
+	LocalizedStatusName  string  `json:"localizedStatusName" example:"准备中"`
+	LocalizedVariantName *string `json:"localizedVariantName" example:"大份"`
 }
 
 type SearchOrderItems struct {
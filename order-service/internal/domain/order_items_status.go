@@ -0,0 +1,19 @@
+package domain
+
+import shareModel "food-story/shared/model"
+
+// OrderItemsStatusSnapshot is the first event of a status stream, it carries every item of the order and the
+// last status event ID the items already reflect.
+type OrderItemsStatusSnapshot struct {
+	LastEventID int64                `json:"lastEventID,string" example:"1024"`
+	Items       []*CurrentOrderItems `json:"items"`
+}
+
+// OrderItemsStatusStream is an open subscription to the status changes of one order. Snapshot is nil when the
+// client resumed and Missed holds the events it did not receive, Events is closed when the stream ends.
+type OrderItemsStatusStream struct {
+	OrderID  int64
+	Snapshot *OrderItemsStatusSnapshot
+	Missed   []*shareModel.OrderItemsStatusChanged
+	Events   <-chan *shareModel.OrderItemsStatusChanged
+}
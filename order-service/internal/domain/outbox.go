@@ -0,0 +1,10 @@
+package domain
+
+type OutboxEvent struct {
+	ID         int64
+	Seq        int64
+	Topic      string
+	MessageKey *string
+	Payload    []byte
+	Attempts   int32
+}
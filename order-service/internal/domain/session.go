@@ -13,4 +13,5 @@ type CurrentTableSession struct {
 	Status      string    `json:"status" example:"active"`
 	StartedAt   time.Time `json:"startedAt" example:"2025-05-23T11:59:50.010316+07:00"`
 	OrderID     *string   `json:"orderID" example:"1922535048335069184"`
+	Locale      *string   `json:"locale" example:"zh-tw"`
 }
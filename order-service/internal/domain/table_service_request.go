@@ -0,0 +1,26 @@
+package domain
+
+import (
+	shareModel "food-story/shared/model"
+
+	"github.com/google/uuid"
+)
+
+type CreateTableServiceRequest struct {
+	SessionID   uuid.UUID
+	TableID     int64
+	OrderID     *int64
+	RequestType string
+	Reason      *string
+}
+
+// TableServiceRequestsSnapshot is the first event of the staff stream, every request that is not resolved yet.
+type TableServiceRequestsSnapshot struct {
+	Requests []*shareModel.TableServiceRequest `json:"requests"`
+}
+
+// TableServiceRequestsStream is an open subscription of a staff screen, Events is closed when the stream ends.
+type TableServiceRequestsStream struct {
+	Snapshot *TableServiceRequestsSnapshot
+	Events   <-chan *shareModel.TableServiceRequestChanged
+}
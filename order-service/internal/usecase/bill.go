@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"food-story/order-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+func (i *Implement) GetCurrentBill(ctx context.Context, sessionID uuid.UUID, locales []string) (result *domain.Bill, err error) {
+	tableSession, err := i.GetCurrentTableSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := convertOrderID(*tableSession.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.GetBill(ctx, orderID, locales)
+}
+
+func (i *Implement) GetBill(ctx context.Context, orderID int64, locales []string) (result *domain.Bill, err error) {
+	result, err = i.repository.GetBill(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.localizeBill(ctx, locales, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"log/slog"
+)
+
+// BeginIdempotentRequest reserves key for a new request and returns nil, or returns the stored response of the
+// request that already used it. A key reused for a different request or still in flight is a conflict.
+func (i *Implement) BeginIdempotentRequest(key, fingerprint string) (result *domain.IdempotentResponse, err error) {
+	reserved, err := i.idempotency.ReserveIdempotencyKey(key, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	if reserved {
+		return nil, nil
+	}
+
+	result, err = i.idempotency.GetIdempotentResponse(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil || result.StatusCode == 0 {
+		return nil, exceptions.Error(exceptions.CodeConflict, "a request with this Idempotency-Key is still being processed")
+	}
+
+	if result.Fingerprint != fingerprint {
+		return nil, exceptions.Error(exceptions.CodeConflict, "Idempotency-Key was already used for a different request")
+	}
+
+	return result, nil
+}
+
+// CompleteIdempotentRequest stores the response for replay. A server error releases the key instead so the
+// client can retry for real. Failures are only logged, the request itself has already been handled.
+func (i *Implement) CompleteIdempotentRequest(key string, response domain.IdempotentResponse) {
+	if response.StatusCode >= 500 {
+		i.ReleaseIdempotentRequest(key)
+		return
+	}
+
+	err := i.idempotency.SaveIdempotentResponse(key, response)
+	if err != nil {
+		slog.Error("failed to save idempotent response", "error", err)
+	}
+}
+
+func (i *Implement) ReleaseIdempotentRequest(key string) {
+	err := i.idempotency.ReleaseIdempotencyKey(key)
+	if err != nil {
+		slog.Error("failed to release idempotency key", "error", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"food-story/order-service/internal/adapter/cache"
 	"food-story/order-service/internal/adapter/queue/producer"
+	"food-story/order-service/internal/adapter/stream"
 	"food-story/shared/config"
 	shareModel "food-story/shared/model"
 
@@ -15,32 +16,56 @@ import (
 
 type Usecase interface {
 	CreateOrder(ctx context.Context, sessionID uuid.UUID, items []shareModel.OrderItems) (result int64, err error)
-	GetOrderByID(ctx context.Context, sessionID uuid.UUID) (result *domain.Order, err error)
+	GetOrderByID(ctx context.Context, sessionID uuid.UUID, locales []string) (result *domain.Order, err error)
 	CreateOrderItems(ctx context.Context, sessionID uuid.UUID, items []shareModel.OrderItems) (err error)
-	GetCurrentOrderItems(ctx context.Context, sessionID uuid.UUID, pageNumber, pageSize int64) (result domain.SearchCurrentOrderItemsResult, err error)
-	GetCurrentOrderItemsByID(ctx context.Context, sessionID uuid.UUID, orderItemsID int64) (result *domain.CurrentOrderItems, err error)
+	GetCurrentOrderItems(ctx context.Context, sessionID uuid.UUID, pageNumber, pageSize int64, locales []string) (result domain.SearchCurrentOrderItemsResult, err error)
+	GetCurrentOrderItemsByID(ctx context.Context, sessionID uuid.UUID, orderItemsID int64, locales []string) (result *domain.CurrentOrderItems, err error)
 	UpdateOrderItemsStatus(ctx context.Context, sessionID uuid.UUID, payload shareModel.OrderItemsStatus) (err error)
-	GetOrderItems(ctx context.Context, orderID int64, pageNumber, pageSize int64) (result domain.SearchCurrentOrderItemsResult, err error)
+	GetOrderItems(ctx context.Context, orderID int64, pageNumber, pageSize int64, locales []string) (result domain.SearchCurrentOrderItemsResult, err error)
 	UpdateOrderItemsStatusByID(ctx context.Context, payload shareModel.OrderItemsStatus) (err error)
-	SearchOrderItemsIncomplete(ctx context.Context, orderID int64, payload domain.SearchOrderItems) (result domain.SearchOrderItemsResult, err error)
-	IsSessionValid(sessionID uuid.UUID) error
+	AmendCurrentOrderItem(ctx context.Context, sessionID uuid.UUID, payload shareModel.OrderItemsAmendment) (err error)
+	AmendOrderItem(ctx context.Context, payload shareModel.OrderItemsAmendment) (err error)
+	SearchOrderItemsIncomplete(ctx context.Context, orderID int64, payload domain.SearchOrderItems, locales []string) (result domain.SearchOrderItemsResult, err error)
+	GetSessionLocale(sessionID uuid.UUID) (result string, err error)
 	GetSessionIDByOrderID(ctx context.Context, orderID int64) (result uuid.UUID, err error)
 	GetSessionIDByTableID(ctx context.Context, tableID int64) (result uuid.UUID, err error)
+	GetCurrentBill(ctx context.Context, sessionID uuid.UUID, locales []string) (result *domain.Bill, err error)
+	GetBill(ctx context.Context, orderID int64, locales []string) (result *domain.Bill, err error)
+	BeginIdempotentRequest(key, fingerprint string) (result *domain.IdempotentResponse, err error)
+	CompleteIdempotentRequest(key string, response domain.IdempotentResponse)
+	ReleaseIdempotentRequest(key string)
+	SubscribeOrderItemsStatus(ctx context.Context, sessionID uuid.UUID, lastEventID int64, locales []string) (result *domain.OrderItemsStatusStream, err error)
+	UnsubscribeOrderItemsStatus(stream *domain.OrderItemsStatusStream)
+	CallWaiter(ctx context.Context, sessionID uuid.UUID, reason string) (result *shareModel.TableServiceRequest, created bool, err error)
+	RequestBill(ctx context.Context, sessionID uuid.UUID) (result *shareModel.TableServiceRequest, created bool, err error)
+	ListOpenTableServiceRequests(ctx context.Context) (result []*shareModel.TableServiceRequest, err error)
+	AcknowledgeTableServiceRequest(ctx context.Context, id int64) (result *shareModel.TableServiceRequest, err error)
+	ResolveTableServiceRequest(ctx context.Context, id int64) (result *shareModel.TableServiceRequest, err error)
+	SubscribeTableServiceRequests(ctx context.Context) (result *domain.TableServiceRequestsStream, err error)
+	UnsubscribeTableServiceRequests(stream *domain.TableServiceRequestsStream)
+	RelayOutboxEvents(ctx context.Context) (err error)
+	CleanupOutboxEvents(ctx context.Context) (err error)
 }
 
 type Implement struct {
-	config     config.Config
-	repository repository.Implement
-	cache      cache.RedisTableCacheInterface
-	queue      producer.QueueProducerInterface
+	config            config.Config
+	repository        repository.Implement
+	cache             cache.RedisTableCacheInterface
+	idempotency       cache.RedisIdempotencyCacheInterface
+	queue             producer.QueueProducerInterface
+	orderStatusHub    stream.OrderStatusHubInterface
+	serviceRequestHub stream.ServiceRequestHubInterface
 }
 
-func NewUsecase(config config.Config, repository repository.Implement, cache cache.RedisTableCacheInterface, queue producer.QueueProducerInterface) *Implement {
+func NewUsecase(config config.Config, repository repository.Implement, cache cache.RedisTableCacheInterface, idempotency cache.RedisIdempotencyCacheInterface, queue producer.QueueProducerInterface, orderStatusHub stream.OrderStatusHubInterface, serviceRequestHub stream.ServiceRequestHubInterface) *Implement {
 	return &Implement{
 		config,
 		repository,
 		cache,
+		idempotency,
 		queue,
+		orderStatusHub,
+		serviceRequestHub,
 	}
 }
 
@@ -59,26 +59,26 @@ func (i *Implement) CreateOrder(ctx context.Context, sessionID uuid.UUID, orderI
 		return 0, err
 	}
 
-	newOrderItems, err := i.repository.GetOrderItemsByOrderID(ctx, orderID)
+	return orderID, nil
+}
+
+func (i *Implement) GetOrderByID(ctx context.Context, sessionID uuid.UUID, locales []string) (result *domain.Order, err error) {
+	orderID, err := i.GetOrderIDFromSession(sessionID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	err = i.PublishOrderToQueue(newOrderItems)
+	result, err = i.repository.GetOrderByID(ctx, orderID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return orderID, nil
-}
-
-func (i *Implement) GetOrderByID(ctx context.Context, sessionID uuid.UUID) (result *domain.Order, err error) {
-	orderID, err := i.GetOrderIDFromSession(sessionID)
+	err = i.localizeOrder(ctx, locales, result)
 	if err != nil {
 		return nil, err
 	}
 
-	return i.repository.GetOrderByID(ctx, orderID)
+	return result, nil
 }
 
 func (i *Implement) GetSessionIDByOrderID(ctx context.Context, orderID int64) (result uuid.UUID, err error) {
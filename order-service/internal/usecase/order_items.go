@@ -25,20 +25,10 @@ func (i *Implement) CreateOrderItems(ctx context.Context, sessionID uuid.UUID, i
 		items[index].OrderID = orderID
 	}
 
-	orderItems, err := i.repository.CreateOrderItems(ctx, items)
-	if err != nil {
-		return
-	}
-
-	err = i.PublishOrderToQueue(orderItems)
-	if err != nil {
-		return err
-	}
-
-	return
+	return i.repository.CreateOrderItems(ctx, items)
 }
 
-func (i *Implement) GetCurrentOrderItems(ctx context.Context, sessionID uuid.UUID, pageNumber, pageSize int64) (result domain.SearchCurrentOrderItemsResult, err error) {
+func (i *Implement) GetCurrentOrderItems(ctx context.Context, sessionID uuid.UUID, pageNumber, pageSize int64, locales []string) (result domain.SearchCurrentOrderItemsResult, err error) {
 	tableSession, err := i.GetCurrentTableSession(sessionID)
 	if err != nil {
 		return domain.SearchCurrentOrderItemsResult{}, err
@@ -49,10 +39,10 @@ func (i *Implement) GetCurrentOrderItems(ctx context.Context, sessionID uuid.UUI
 		return domain.SearchCurrentOrderItemsResult{}, err
 	}
 
-	return i.repository.GetCurrentOrderItems(ctx, orderID, pageNumber, pageSize)
+	return i.GetOrderItems(ctx, orderID, pageNumber, pageSize, locales)
 }
 
-func (i *Implement) GetCurrentOrderItemsByID(ctx context.Context, sessionID uuid.UUID, orderItemsID int64) (result *domain.CurrentOrderItems, err error) {
+func (i *Implement) GetCurrentOrderItemsByID(ctx context.Context, sessionID uuid.UUID, orderItemsID int64, locales []string) (result *domain.CurrentOrderItems, err error) {
 	tableSession, err := i.GetCurrentTableSession(sessionID)
 	if err != nil {
 		return nil, err
@@ -63,11 +53,31 @@ func (i *Implement) GetCurrentOrderItemsByID(ctx context.Context, sessionID uuid
 		return nil, err
 	}
 
-	return i.repository.GetCurrentOrderItemsByID(ctx, orderID, orderItemsID)
+	result, err = i.repository.GetCurrentOrderItemsByID(ctx, orderID, orderItemsID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.localizeCurrentOrderItems(ctx, locales, []*domain.CurrentOrderItems{result})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
-func (i *Implement) GetOrderItems(ctx context.Context, orderID, pageNumber, pageSize int64) (result domain.SearchCurrentOrderItemsResult, err error) {
-	return i.repository.GetCurrentOrderItems(ctx, orderID, pageNumber, pageSize)
+func (i *Implement) GetOrderItems(ctx context.Context, orderID, pageNumber, pageSize int64, locales []string) (result domain.SearchCurrentOrderItemsResult, err error) {
+	result, err = i.repository.GetCurrentOrderItems(ctx, orderID, pageNumber, pageSize)
+	if err != nil {
+		return domain.SearchCurrentOrderItemsResult{}, err
+	}
+
+	err = i.localizeCurrentOrderItems(ctx, locales, result.Data)
+	if err != nil {
+		return domain.SearchCurrentOrderItemsResult{}, err
+	}
+
+	return result, nil
 }
 
 func (i *Implement) UpdateOrderItemsStatusByID(ctx context.Context, payload shareModel.OrderItemsStatus) (err error) {
@@ -107,8 +117,33 @@ func (i *Implement) UpdateOrderItemsStatus(ctx context.Context, sessionID uuid.U
 	return i.UpdateOrderItemsStatusByID(ctx, payload)
 }
 
-func (i *Implement) SearchOrderItemsIncomplete(ctx context.Context, orderID int64, payload domain.SearchOrderItems) (result domain.SearchOrderItemsResult, err error) {
-	return i.repository.SearchOrderItemsIncomplete(ctx, orderID, payload)
+func (i *Implement) AmendOrderItem(ctx context.Context, payload shareModel.OrderItemsAmendment) (err error) {
+	return i.repository.AmendOrderItem(ctx, payload)
+}
+
+func (i *Implement) AmendCurrentOrderItem(ctx context.Context, sessionID uuid.UUID, payload shareModel.OrderItemsAmendment) (err error) {
+	orderID, err := i.GetOrderIDFromSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	payload.OrderID = orderID
+
+	return i.AmendOrderItem(ctx, payload)
+}
+
+func (i *Implement) SearchOrderItemsIncomplete(ctx context.Context, orderID int64, payload domain.SearchOrderItems, locales []string) (result domain.SearchOrderItemsResult, err error) {
+	result, err = i.repository.SearchOrderItemsIncomplete(ctx, orderID, payload)
+	if err != nil {
+		return domain.SearchOrderItemsResult{}, err
+	}
+
+	err = i.localizeOrderItems(ctx, locales, result.Data)
+	if err != nil {
+		return domain.SearchOrderItemsResult{}, err
+	}
+
+	return result, nil
 }
 
 func convertOrderID(orderID string) (int64, error) {
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/common"
+
+	"github.com/google/uuid"
+)
+
+// _orderItemsStatusReplayLimit caps how far back a client may resume, a client further behind gets a snapshot.
+const _orderItemsStatusReplayLimit = 500
+
+// SubscribeOrderItemsStatus opens a status stream for the order of the session. The subscription starts before
+// the snapshot or replay is read so no change is lost in between, the client may see a change twice.
+func (i *Implement) SubscribeOrderItemsStatus(ctx context.Context, sessionID uuid.UUID, lastEventID int64, locales []string) (result *domain.OrderItemsStatusStream, err error) {
+	orderID, err := i.GetOrderIDFromSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &domain.OrderItemsStatusStream{
+		OrderID: orderID,
+		Events:  i.orderStatusHub.Subscribe(orderID),
+	}
+	defer func() {
+		if err != nil {
+			i.UnsubscribeOrderItemsStatus(result)
+		}
+	}()
+
+	if lastEventID > 0 {
+		missed, err := i.repository.ListOrderItemsStatusEvents(ctx, orderID, lastEventID, _orderItemsStatusReplayLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(missed) < _orderItemsStatusReplayLimit {
+			result.Missed = missed
+			return result, nil
+		}
+	}
+
+	result.Snapshot, err = i.getOrderItemsStatusSnapshot(ctx, orderID, locales)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (i *Implement) UnsubscribeOrderItemsStatus(stream *domain.OrderItemsStatusStream) {
+	i.orderStatusHub.Unsubscribe(stream.OrderID, stream.Events)
+}
+
+func (i *Implement) getOrderItemsStatusSnapshot(ctx context.Context, orderID int64, locales []string) (*domain.OrderItemsStatusSnapshot, error) {
+	lastEventID, err := i.repository.GetLastOrderItemsStatusEventID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &domain.OrderItemsStatusSnapshot{
+		LastEventID: lastEventID,
+		Items:       []*domain.CurrentOrderItems{},
+	}
+	for pageNumber := int64(1); ; pageNumber++ {
+		page, err := i.GetOrderItems(ctx, orderID, pageNumber, common.MaxPageSize, locales)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.Items = append(snapshot.Items, page.Data...)
+		if pageNumber >= page.TotalPages {
+			return snapshot, nil
+		}
+	}
+}
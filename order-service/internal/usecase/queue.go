@@ -1,29 +1,106 @@
 package usecase
 
 import (
-	"food-story/pkg/exceptions"
-	shareModel "food-story/shared/model"
+	"context"
+	"fmt"
+	"food-story/order-service/internal/domain"
+	"food-story/shared/kafka"
 	"log/slog"
-	"sort"
+	"time"
 )
 
-func (i *Implement) PublishOrderToQueue(orderItems []*shareModel.OrderItems) error {
+const (
+	// OutboxRelayInterval is how often the relay looks for kitchen tickets that still have to be published
+	OutboxRelayInterval = time.Second
 
-	if orderItems == nil {
-		return exceptions.Error(exceptions.CodeBusiness, "order items is empty")
-	}
+	// OutboxCleanupInterval is how often sent outbox events past their retention are deleted
+	OutboxCleanupInterval = time.Hour
+
+	_outboxBatchSize = 100
+
+	// a claimed event is retried by any relay once its lease runs out, e.g. when this instance dies mid-batch
+	_outboxLeaseSeconds = 30
 
-	sort.Slice(orderItems, func(i, j int) bool {
-		return orderItems[i].ID < orderItems[j].ID
-	})
+	_outboxMaxBackoffSeconds = 60
 
-	for _, v := range orderItems {
-		err := i.queue.PublishOrder(*v)
+	_outboxRetentionDays = 7
+)
+
+// RelayOutboxEvents publishes pending outbox events in batches, in the order they were written. A failed event
+// is retried with exponential backoff and never dropped, so a committed order always reaches the kitchen.
+// The pending events of a key are claimed together and sent in one batch. When one of them fails, the later
+// events of its key are retried after it even if they went through, so the last message of a key is never an
+// older one.
+func (i *Implement) RelayOutboxEvents(ctx context.Context) (err error) {
+	for {
+		events, err := i.repository.ClaimOutboxEvents(ctx, _outboxBatchSize, _outboxLeaseSeconds)
 		if err != nil {
-			slog.Error("failed to publish order to queue: ", " order items: ", err)
-			continue
+			return err
 		}
+
+		messages := make([]kafka.Message, len(events))
+		for index, event := range events {
+			messages[index] = kafka.Message{
+				Topic: event.Topic,
+				Key:   event.MessageKey,
+				Value: event.Payload,
+			}
+		}
+
+		publishErrors := i.queue.PublishMessages(messages)
+		// the first failed event of each key
+		failedKeys := make(map[string]int64)
+		var sent int
+		for index, event := range events {
+			publishErr := publishErrors[index]
+			key, hasKey := outboxEventKey(event)
+			failedID, keyFailed := failedKeys[key]
+			if publishErr == nil && hasKey && keyFailed {
+				publishErr = fmt.Errorf("held back behind outbox event %d", failedID)
+			}
+
+			if publishErr != nil {
+				slog.Error("failed to publish outbox event", "id", event.ID, "topic", event.Topic, "attempts", event.Attempts+1, "error", publishErr)
+				if hasKey && !keyFailed {
+					failedKeys[key] = event.ID
+				}
+
+				err = i.repository.MarkOutboxEventFailed(ctx, event.ID, publishErr.Error(), _outboxMaxBackoffSeconds)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			err = i.repository.MarkOutboxEventSent(ctx, event.ID)
+			if err != nil {
+				return err
+			}
+			sent++
+		}
+
+		// events written while this batch was sent can be claimed straight away
+		if len(events) == 0 || sent == 0 {
+			return nil
+		}
+	}
+}
+
+func outboxEventKey(event *domain.OutboxEvent) (string, bool) {
+	if event.MessageKey == nil {
+		return "", false
 	}
+	return event.Topic + ":" + *event.MessageKey, true
+}
 
+func (i *Implement) CleanupOutboxEvents(ctx context.Context) (err error) {
+	rows, err := i.repository.DeleteSentOutboxEvents(ctx, _outboxRetentionDays)
+	if err != nil {
+		return err
+	}
+
+	if rows > 0 {
+		slog.Info("sent outbox events deleted", "rows", rows)
+	}
 	return nil
 }
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"food-story/order-service/internal/domain"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	shareModel "food-story/shared/model"
+
+	"github.com/google/uuid"
+)
+
+func (i *Implement) CallWaiter(ctx context.Context, sessionID uuid.UUID, reason string) (result *shareModel.TableServiceRequest, created bool, err error) {
+	session, err := i.cache.GetCachedTable(sessionID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return i.repository.CreateTableServiceRequest(ctx, domain.CreateTableServiceRequest{
+		SessionID:   sessionID,
+		TableID:     session.TableID,
+		RequestType: shareModel.ServiceRequestCallWaiter,
+		Reason:      &reason,
+	})
+}
+
+// RequestBill needs an order, there is nothing to pay before the first order is placed.
+func (i *Implement) RequestBill(ctx context.Context, sessionID uuid.UUID) (result *shareModel.TableServiceRequest, created bool, err error) {
+	session, err := i.cache.GetCachedTable(sessionID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if session.OrderID == nil {
+		return nil, false, exceptions.ErrorIDNotFound(exceptions.CodeOrderNotFound, 0)
+	}
+
+	orderID, err := utils.StrToInt64(*session.OrderID)
+	if err != nil {
+		return nil, false, exceptions.Errorf(exceptions.CodeSystem, "failed to convert oder id", err)
+	}
+
+	return i.repository.CreateTableServiceRequest(ctx, domain.CreateTableServiceRequest{
+		SessionID:   sessionID,
+		TableID:     session.TableID,
+		OrderID:     &orderID,
+		RequestType: shareModel.ServiceRequestRequestBill,
+	})
+}
+
+func (i *Implement) ListOpenTableServiceRequests(ctx context.Context) (result []*shareModel.TableServiceRequest, err error) {
+	return i.repository.ListOpenTableServiceRequests(ctx)
+}
+
+func (i *Implement) AcknowledgeTableServiceRequest(ctx context.Context, id int64) (result *shareModel.TableServiceRequest, err error) {
+	return i.repository.UpdateTableServiceRequestStatus(ctx, id, shareModel.ServiceRequestAcknowledged)
+}
+
+func (i *Implement) ResolveTableServiceRequest(ctx context.Context, id int64) (result *shareModel.TableServiceRequest, err error) {
+	return i.repository.UpdateTableServiceRequestStatus(ctx, id, shareModel.ServiceRequestResolved)
+}
+
+// SubscribeTableServiceRequests opens the staff stream. Like the order items stream it subscribes before the
+// snapshot is read, a change made in between may arrive twice.
+func (i *Implement) SubscribeTableServiceRequests(ctx context.Context) (result *domain.TableServiceRequestsStream, err error) {
+	result = &domain.TableServiceRequestsStream{
+		Events: i.serviceRequestHub.Subscribe(),
+	}
+
+	requests, err := i.repository.ListOpenTableServiceRequests(ctx)
+	if err != nil {
+		i.UnsubscribeTableServiceRequests(result)
+		return nil, err
+	}
+
+	result.Snapshot = &domain.TableServiceRequestsSnapshot{Requests: requests}
+	return result, nil
+}
+
+func (i *Implement) UnsubscribeTableServiceRequests(stream *domain.TableServiceRequestsStream) {
+	i.serviceRequestHub.Unsubscribe(stream.Events)
+}
@@ -48,6 +48,15 @@ func (i *Implement) GetSessionIDByTableID(ctx context.Context, tableID int64) (r
 	return i.repository.GetSessionIDByTableID(ctx, tableID)
 }
 
-func (i *Implement) IsSessionValid(sessionID uuid.UUID) error {
-	return i.cache.IsCachedTableExist(sessionID)
+func (i *Implement) GetSessionLocale(sessionID uuid.UUID) (result string, err error) {
+	session, err := i.cache.GetCachedTable(sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	if session.Locale == nil {
+		return "", nil
+	}
+
+	return *session.Locale, nil
 }
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"food-story/order-service/internal/domain"
+	"food-story/shared/i18n"
+	shareModel "food-story/shared/model"
+)
+
+func localizeVariantName(translations *i18n.Translations, variantID *int64, name, nameEN *string) *string {
+	if variantID == nil || name == nil {
+		return nil
+	}
+
+	english := ""
+	if nameEN != nil {
+		english = *nameEN
+	}
+
+	localized := translations.Name(i18n.EntityVariant, *variantID, *name, english)
+	return &localized
+}
+
+func (i *Implement) localizeOrder(ctx context.Context, locales []string, order *domain.Order) error {
+	translations, err := i.repository.LoadTranslations(ctx, locales, []int64{order.StatusID})
+	if err != nil {
+		return err
+	}
+
+	order.LocalizedStatusName = translations.Name(i18n.EntityOrderStatus, order.StatusID, order.StatusName, order.StatusNameEN)
+	return nil
+}
+
+func (i *Implement) localizeCurrentOrderItems(ctx context.Context, locales []string, items []*domain.CurrentOrderItems) error {
+	var entityIDs []int64
+	for _, item := range items {
+		entityIDs = append(entityIDs, item.ProductID, item.StatusID)
+		if item.VariantID != nil {
+			entityIDs = append(entityIDs, *item.VariantID)
+		}
+	}
+
+	translations, err := i.repository.LoadTranslations(ctx, locales, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		item.LocalizedProductName = translations.Name(i18n.EntityProduct, item.ProductID, item.ProductName, item.ProductNameEN)
+		item.LocalizedStatusName = translations.Name(i18n.EntityOrderStatus, item.StatusID, item.StatusName, item.StatusNameEN)
+		item.LocalizedVariantName = localizeVariantName(translations, item.VariantID, item.VariantName, item.VariantNameEN)
+	}
+
+	return nil
+}
+
+func (i *Implement) localizeOrderItems(ctx context.Context, locales []string, items []*shareModel.OrderItems) error {
+	var entityIDs []int64
+	for _, item := range items {
+		entityIDs = append(entityIDs, item.ProductID, item.StatusID)
+		if item.VariantID != nil {
+			entityIDs = append(entityIDs, *item.VariantID)
+		}
+	}
+
+	translations, err := i.repository.LoadTranslations(ctx, locales, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		item.LocalizedProductName = translations.Name(i18n.EntityProduct, item.ProductID, item.ProductName, item.ProductNameEN)
+		item.LocalizedStatusName = translations.Name(i18n.EntityOrderStatus, item.StatusID, item.StatusName, item.StatusNameEN)
+		item.LocalizedVariantName = localizeVariantName(translations, item.VariantID, item.VariantName, item.VariantNameEN)
+	}
+
+	return nil
+}
+
+func (i *Implement) localizeBill(ctx context.Context, locales []string, bill *domain.Bill) error {
+	var entityIDs []int64
+	for _, item := range bill.Items {
+		entityIDs = append(entityIDs, item.ProductID)
+		if item.VariantID != nil {
+			entityIDs = append(entityIDs, *item.VariantID)
+		}
+	}
+
+	translations, err := i.repository.LoadTranslations(ctx, locales, entityIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range bill.Items {
+		item.LocalizedProductName = translations.Name(i18n.EntityProduct, item.ProductID, item.ProductName, item.ProductNameEN)
+		item.LocalizedVariantName = localizeVariantName(translations, item.VariantID, item.VariantName, item.VariantNameEN)
+	}
+
+	return nil
+}
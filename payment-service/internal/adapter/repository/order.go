@@ -32,6 +32,7 @@ func (i *Implement) IsOrderExist(ctx context.Context, orderID int64) (err error)
 
 	return nil
 }
+
 func (i *Implement) GetTableIDByOrderID(ctx context.Context, orderID int64) (result int64, err error) {
 	tableID, err := i.repository.GetTableIDByOrderID(ctx, orderID)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"food-story/payment-service/internal/domain"
 	"food-story/pkg/exceptions"
 	database "food-story/shared/database/sqlc"
+	"food-story/shared/orderstatus"
 	"log/slog"
 	"math/big"
 	"strings"
@@ -27,45 +28,24 @@ func (i *Implement) CreatePaymentTransaction(ctx context.Context, payload domain
 		note.String = *payload.Note
 	}
 
-	amount, err := i.repository.GetTotalAmountToPayForServedItems(ctx, payload.OrderID)
-	if err != nil {
-		return "", exceptions.Errorf(exceptions.CodeRepository, FailToGetTotalAmount, err)
-	}
-
 	transactionID = uuid.New().String()
 	pendingStatus, customError := i.GetPaymentStatusPending(ctx)
 	if customError != nil {
 		return "", customError
 	}
-	arg := database.CreatePaymentParams{
+
+	// the order status is checked and changed under a lock on the order row, inside the same transaction
+	err = i.repository.TXCreatePayment(ctx, database.CreatePaymentParams{
 		ID:            i.snowflakeID.Generate(),
 		OrderID:       payload.OrderID,
 		Method:        payload.Method,
 		Status:        pendingStatus,
 		Note:          note,
-		Amount:        amount,
 		TransactionID: transactionID,
 		RefCode:       GenerateRefCode(),
-	}
-
-	_, err = i.repository.CreatePayment(ctx, arg)
-	if err != nil {
-		return "", exceptions.Errorf(exceptions.CodeRepository, "failed to create payment", err)
-	}
-
-	err = i.repository.UpdateOrderStatusWaitForPayment(ctx, payload.OrderID)
+	})
 	if err != nil {
-		return "", exceptions.Errorf(exceptions.CodeRepository, "failed to update order status", err)
-	}
-
-	tableID, customError := i.GetTableIDByOrderID(ctx, payload.OrderID)
-	if customError != nil {
-		return "", customError
-	}
-
-	customError = i.UpdateTablesStatusWaitingForPayment(ctx, tableID)
-	if customError != nil {
-		return "", customError
+		return "", paymentTransactionError(err, payload.OrderID, "failed to create payment")
 	}
 
 	return transactionID, nil
@@ -90,37 +70,11 @@ func (i *Implement) GetPaymentLastStatusCodeByTransaction(ctx context.Context, t
 
 func (i *Implement) CallbackPaymentTransaction(ctx context.Context, transactionID, statusCode string) (sessionID uuid.UUID, err error) {
 
+	statusCode = strings.ToUpper(statusCode)
 	if statusCode == "" || statusCode == "PENDING" {
 		return uuid.Nil, nil
 	}
 
-	if strings.ToUpper(statusCode) != "SUCCESS" {
-		switch statusCode {
-		case "CANCELLED":
-			err := i.repository.UpdateStatusPaymentCancelledByTransactionID(ctx, transactionID)
-			if err != nil {
-				return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update payment status cancelled", err)
-			}
-		case "FAILED":
-			err := i.repository.UpdateStatusPaymentFailedByTransactionID(ctx, transactionID)
-			if err != nil {
-				return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update payment status failed", err)
-			}
-		case "TIMEOUT":
-			err := i.repository.UpdateStatusPaymentTimeOutByTransactionID(ctx, transactionID)
-			if err != nil {
-				return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update payment status timeout", err)
-			}
-		default:
-			return uuid.Nil, exceptions.Error(exceptions.CodeRepository, "status code not supported")
-		}
-	} else {
-		err := i.repository.UpdateStatusPaymentSuccessByTransactionID(ctx, transactionID)
-		if err != nil {
-			return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update payment status success", err)
-		}
-	}
-
 	orderID, err := i.repository.GetPaymentOrderIDByTransaction(ctx, transactionID)
 	if err != nil {
 		if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
@@ -130,38 +84,20 @@ func (i *Implement) CallbackPaymentTransaction(ctx context.Context, transactionI
 		return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to get order id", err)
 	}
 
-	if strings.ToUpper(statusCode) != "SUCCESS" {
-		err = i.repository.UpdateOrderStatusWaitForPayment(ctx, orderID)
-		if err != nil {
-			return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update order status", err)
-		}
-		return uuid.Nil, nil
-	}
-
-	amount, err := i.repository.GetTotalAmountToPayForServedItems(ctx, orderID)
-	if err != nil {
-		return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, FailToGetTotalAmount, err)
-	}
-
-	err = i.repository.UpdateOrderStatusCompletedAndAmount(ctx, database.UpdateOrderStatusCompletedAndAmountParams{
-		ID:     orderID,
-		Amount: amount,
+	err = i.repository.TXCallbackPayment(ctx, database.TXCallbackPaymentParams{
+		OrderID:       orderID,
+		TransactionID: transactionID,
+		StatusCode:    statusCode,
 	})
 	if err != nil {
-		return uuid.Nil, exceptions.Errorf(exceptions.CodeRepository, "failed to update order status and amount", err)
+		return uuid.Nil, paymentTransactionError(err, orderID, "failed to update payment status")
 	}
 
-	tableID, customError := i.GetTableIDByOrderID(ctx, orderID)
-	if customError != nil {
-		return uuid.Nil, customError
-	}
-
-	customError = i.UpdateTablesStatusCleaning(ctx, tableID)
-	if customError != nil {
-		return uuid.Nil, customError
+	if statusCode != "SUCCESS" {
+		return uuid.Nil, nil
 	}
 
-	sessionID, customError = i.GetSessionIDByOrderID(ctx, orderID)
+	sessionID, customError := i.GetSessionIDByOrderID(ctx, orderID)
 	if customError != nil {
 		return uuid.Nil, customError
 	}
@@ -174,6 +110,18 @@ func (i *Implement) CallbackPaymentTransaction(ctx context.Context, transactionI
 	return sessionID, nil
 }
 
+// paymentTransactionError maps the errors of the payment transactions, including a rejected order status change.
+func paymentTransactionError(err error, orderID int64, message string) error {
+	if statusErr, ok := orderstatus.Error(err); ok {
+		return statusErr
+	}
+	if errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+		return exceptions.ErrorIDNotFound(exceptions.CodeOrderNotFound, orderID)
+	}
+
+	return exceptions.Errorf(exceptions.CodeRepository, message, err)
+}
+
 func (i *Implement) GetPaymentAmountByTransaction(ctx context.Context, transactionID string) (result float64, err error) {
 	amount, err := i.repository.GetPaymentAmountByTransaction(ctx, transactionID)
 	if err != nil {
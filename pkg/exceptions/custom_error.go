@@ -13,14 +13,21 @@ const (
 	CodeSystem     Code = "12000"
 	CodeRepository Code = "13000"
 
-	CodeNotFound            Code = "14000"
-	CodeProductNotFound     Code = "14001"
-	CodeTableNotFound       Code = "14002"
-	CodeTableStatusNotFound Code = "14003"
-	CodeOrderNotFound       Code = "14004"
-	CodeOrderItemNotFound   Code = "14005"
-	CodeOrderStatusNotFound Code = "14006"
-	CodeSessionFound        Code = "14007"
+	CodeNotFound               Code = "14000"
+	CodeProductNotFound        Code = "14001"
+	CodeTableNotFound          Code = "14002"
+	CodeTableStatusNotFound    Code = "14003"
+	CodeOrderNotFound          Code = "14004"
+	CodeOrderItemNotFound      Code = "14005"
+	CodeOrderStatusNotFound    Code = "14006"
+	CodeSessionFound           Code = "14007"
+	CodeCategoryNotFound       Code = "14008"
+	CodeIngredientNotFound     Code = "14009"
+	CodeSearchAliasNotFound    Code = "14010"
+	CodeProductPriceNotFound   Code = "14011"
+	CodePromotionNotFound      Code = "14012"
+	CodeMenuVersionNotFound    Code = "14013"
+	CodeServiceRequestNotFound Code = "14014"
 
 	CodeUnauthorized Code = "15000"
 	CodeForbidden    Code = "16000"
@@ -118,6 +125,41 @@ func notFoundMapping(id int64, code Code) string {
 			}
 		case CodeSessionFound:
 			title = "session not found"
+		case CodeCategoryNotFound:
+			title = fmt.Sprintf("category id '%d' not found", id)
+			if id == 0 {
+				title = "category not found"
+			}
+		case CodeIngredientNotFound:
+			title = fmt.Sprintf("ingredient id '%d' not found", id)
+			if id == 0 {
+				title = "ingredient not found"
+			}
+		case CodeSearchAliasNotFound:
+			title = fmt.Sprintf("search alias id '%d' not found", id)
+			if id == 0 {
+				title = "search alias not found"
+			}
+		case CodeProductPriceNotFound:
+			title = fmt.Sprintf("scheduled price id '%d' not found", id)
+			if id == 0 {
+				title = "scheduled price not found"
+			}
+		case CodePromotionNotFound:
+			title = fmt.Sprintf("promotion id '%d' not found", id)
+			if id == 0 {
+				title = "promotion not found"
+			}
+		case CodeMenuVersionNotFound:
+			title = fmt.Sprintf("menu version id '%d' not found", id)
+			if id == 0 {
+				title = "menu version not found"
+			}
+		case CodeServiceRequestNotFound:
+			title = fmt.Sprintf("service request id '%d' not found", id)
+			if id == 0 {
+				title = "service request not found"
+			}
 		default:
 			title = "data not found"
 		}
@@ -7,16 +7,24 @@ import (
 )
 
 var (
-	ErrIDInvalidFormat     = errors.New("invalid id format")
-	ErrValueIsEmpty        = errors.New("value is empty")
-	ErrInternalServerError = errors.New("something went wrong please try again")
-	ErrRowDatabaseNotFound = pgx.ErrNoRows
-	ErrRedisKeyNotFound    = errors.New("key not found")
-	ErrSessionExpired      = errors.New("session expired")
-	ErrFailedToReadSession = errors.New("failed to read session")
-	ErrOrderRequired       = errors.New("order cannot be empty")
-	ErrOrderItemsRequired  = errors.New("order items cannot be empty")
-	ErrForeignKeyViolation = errors.New("foreign key violation")
+	ErrIDInvalidFormat        = errors.New("invalid id format")
+	ErrValueIsEmpty           = errors.New("value is empty")
+	ErrInternalServerError    = errors.New("something went wrong please try again")
+	ErrRowDatabaseNotFound    = pgx.ErrNoRows
+	ErrRedisKeyNotFound       = errors.New("key not found")
+	ErrSessionExpired         = errors.New("session expired")
+	ErrFailedToReadSession    = errors.New("failed to read session")
+	ErrOrderRequired          = errors.New("order cannot be empty")
+	ErrOrderItemsRequired     = errors.New("order items cannot be empty")
+	ErrForeignKeyViolation    = errors.New("foreign key violation")
+	ErrProductOutOfStock      = errors.New("product is out of stock")
+	ErrIngredientInsufficient = errors.New("insufficient ingredient stock")
+	ErrOrderItemNotAmendable  = errors.New("order item can no longer be changed because the kitchen has started preparing it")
+	ErrOrderStatusTransition  = errors.New("order status transition is not allowed")
+	ErrOrderStatusActor       = errors.New("not allowed to change the order status")
+	ErrServiceRequestStatus   = errors.New("table service request status transition is not allowed")
+	ErrMenuOptionNotFound     = errors.New("does not belong to the product")
+	ErrPaymentStatusCode      = errors.New("status code not supported")
 
 	ErrRedisKeyNotFoundException = Error(CodeNotFound, "key not found")
 )
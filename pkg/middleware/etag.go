@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ResponseOKWithETag ตอบ 304 Not Modified เมื่อ ETag ของข้อมูลตรงกับ If-None-Match ที่ client ส่งมา
+// ETag คำนวณจาก payload เท่านั้น เพราะ meta (requestId, timestamp) เปลี่ยนทุก request
+func ResponseOKWithETag(c *fiber.Ctx, payload interface{}) error {
+	if isNotModified(c, payload) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	return ResponseOK(c, payload)
+}
+
+func ResponseOKWithPaginationETag(c *fiber.Ctx, payload ResponseWithPaginationPayload) error {
+	if isNotModified(c, payload) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	return ResponseOKWithPagination(c, payload)
+}
+
+func isNotModified(c *fiber.Ctx, payload interface{}) bool {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return false
+	}
+
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	// no-cache ให้ client ถาม server ทุกครั้งแต่ใช้ของเดิมได้เมื่อได้ 304
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+
+	return matchETag(c.Get(fiber.HeaderIfNoneMatch), etag)
+}
+
+func matchETag(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+		if value == "*" || value == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"food-story/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LocalsSessionLocale is set by the session middleware of each service when the table session has a preferred language.
+const LocalsSessionLocale = "sessionLocale"
+
+// RequestLocales resolves the locale fallback chain of a request. The session locale chosen when the table
+// was opened wins over the browser's Accept-Language header.
+func RequestLocales(c *fiber.Ctx) []string {
+	var preferred []string
+	if sessionLocale, ok := c.Locals(LocalsSessionLocale).(string); ok && sessionLocale != "" {
+		preferred = append(preferred, sessionLocale)
+	}
+
+	c.Vary(fiber.HeaderAcceptLanguage)
+	preferred = append(preferred, utils.ParseAcceptLanguage(c.Get(fiber.HeaderAcceptLanguage))...)
+	return utils.LocaleFallbackChain(preferred...)
+}
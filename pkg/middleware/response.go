@@ -93,7 +93,7 @@ func mapErrorToHTTP(err error) (int, ErrorResponse) {
 		case exceptions.CodeForbidden:
 			return 403, getErrorResponse(string(appErr.Code), appErr.Message)
 
-		case exceptions.CodeNotFound, exceptions.CodeOrderNotFound, exceptions.CodeTableNotFound, exceptions.CodeOrderItemNotFound, exceptions.CodeProductNotFound, exceptions.CodeTableStatusNotFound, exceptions.CodeSessionFound:
+		case exceptions.CodeNotFound, exceptions.CodeOrderNotFound, exceptions.CodeTableNotFound, exceptions.CodeOrderItemNotFound, exceptions.CodeProductNotFound, exceptions.CodeTableStatusNotFound, exceptions.CodeSessionFound, exceptions.CodeCategoryNotFound, exceptions.CodeIngredientNotFound, exceptions.CodeSearchAliasNotFound, exceptions.CodeProductPriceNotFound, exceptions.CodePromotionNotFound, exceptions.CodeMenuVersionNotFound, exceptions.CodeServiceRequestNotFound:
 			slog.Error(appErr.Message)
 			return 404, getErrorResponse(string(appErr.Code), exceptions.NotFoundWithoutID(appErr.Code))
 
@@ -15,6 +15,7 @@ import (
 	"math"
 	"math/big"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -268,6 +269,38 @@ func Int64ToPgInt8(v int64) pgtype.Int8 {
 	}
 }
 
+func PgInt8ToInt64Ptr(value pgtype.Int8) *int64 {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Int64
+}
+
+func PgInt4ToInt32Ptr(value pgtype.Int4) *int32 {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Int32
+}
+
+func Int32PtrToPgInt4(value *int32) pgtype.Int4 {
+	if value == nil {
+		return pgtype.Int4{}
+	}
+
+	return pgtype.Int4{Int32: *value, Valid: true}
+}
+
+func Int64PtrToPgInt8(value *int64) pgtype.Int8 {
+	if value == nil {
+		return pgtype.Int8{}
+	}
+
+	return pgtype.Int8{Int64: *value, Valid: true}
+}
+
 func PgTimestampToThaiISO8601(ts pgtype.Timestamptz) (string, error) {
 	if !ts.Valid {
 		return "", fmt.Errorf("timestamp is null")
@@ -308,6 +341,27 @@ func PgTimestampToTime(ts pgtype.Timestamptz) (time.Time, error) {
 	return t.In(loc), nil
 }
 
+func StrToPgTime(value string) (pgtype.Time, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return pgtype.Time{}, err
+	}
+
+	return pgtype.Time{
+		Microseconds: int64(t.Hour())*time.Hour.Microseconds() + int64(t.Minute())*time.Minute.Microseconds(),
+		Valid:        true,
+	}, nil
+}
+
+func PgTimeToStr(value pgtype.Time) string {
+	if !value.Valid {
+		return ""
+	}
+
+	duration := time.Duration(value.Microseconds) * time.Microsecond
+	return fmt.Sprintf("%02d:%02d", int(duration.Hours()), int(duration.Minutes())%60)
+}
+
 func PareStringToUUID(str string) (uuid.UUID, error) {
 	if str == "" {
 		return uuid.UUID{}, errors.New("string is empty")
@@ -363,3 +417,85 @@ func MapPgErr(err error) error {
 	}
 	return err
 }
+
+func PgUniqueViolationField(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		constraintName := strings.TrimPrefix(pgErr.ConstraintName, pgErr.TableName+"_")
+		return IndexToFieldName(constraintName, pgErr.TableName), true
+	}
+	return "", false
+}
+
+const (
+	LocaleThai    = "th"
+	LocaleEnglish = "en"
+)
+
+// ParseAcceptLanguage returns the language tags of an Accept-Language header, highest quality first.
+func ParseAcceptLanguage(header string) []string {
+	type weightedLocale struct {
+		locale  string
+		quality float64
+	}
+
+	var weighted []weightedLocale
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		locale := NormalizeLocale(tag)
+		if locale == "" || locale == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil || parsed <= 0 {
+				continue
+			}
+			quality = parsed
+		}
+
+		weighted = append(weighted, weightedLocale{locale: locale, quality: quality})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].quality > weighted[j].quality
+	})
+
+	result := make([]string, len(weighted))
+	for index, item := range weighted {
+		result[index] = item.locale
+	}
+	return result
+}
+
+// LocaleFallbackChain returns the lookup order for translated text: each preferred locale followed by
+// its base language (zh-tw -> zh), then English and finally Thai, the language the menu is authored in.
+func LocaleFallbackChain(preferred ...string) []string {
+	chain := make([]string, 0, len(preferred)*2+2)
+	seen := make(map[string]bool)
+	add := func(locale string) {
+		if locale == "" || seen[locale] {
+			return
+		}
+		seen[locale] = true
+		chain = append(chain, locale)
+	}
+
+	for _, locale := range preferred {
+		locale = NormalizeLocale(locale)
+		add(locale)
+		if base, _, ok := strings.Cut(locale, "-"); ok {
+			add(base)
+		}
+	}
+	add(LocaleEnglish)
+	add(LocaleThai)
+
+	return chain
+}
+
+func NormalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
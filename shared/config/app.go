@@ -21,8 +21,14 @@ type Config struct {
 	RedisAddress         string `mapstructure:"REDIS_ADDRESS"`
 	RedisPassword        string `mapstructure:"REDIS_PASSWORD"`
 	KafkaBrokers         string `mapstructure:"KAFKA_BROKERS"`
+	KafkaProducerAcks    string `mapstructure:"KAFKA_PRODUCER_ACKS"`
+	KafkaIdempotent      string `mapstructure:"KAFKA_PRODUCER_IDEMPOTENT"`
+	KafkaProducerRetries string `mapstructure:"KAFKA_PRODUCER_RETRIES"`
+	KafkaCompression     string `mapstructure:"KAFKA_PRODUCER_COMPRESSION"`
 	KeyCloakCertURL      string `mapstructure:"KEYCLOAK_CERT_URL"`
 	TimeZone             string `mapstructure:"TZ"`
+	UploadDir            string `mapstructure:"UPLOAD_DIR"`
+	SnowflakeNodeID      int64  `mapstructure:"SNOWFLAKE_NODE_ID"`
 	TableSessionDuration time.Duration
 	BaseURL              string
 }
@@ -52,8 +58,14 @@ func InitConfig(envFile string) (Config, error) {
 		viper.SetDefault("REDIS_ADDRESS", os.Getenv("REDIS_ADDRESS"))
 		viper.SetDefault("REDIS_PASSWORD", os.Getenv("REDIS_PASSWORD"))
 		viper.SetDefault("KAFKA_BROKERS", os.Getenv("KAFKA_BROKERS"))
+		viper.SetDefault("KAFKA_PRODUCER_ACKS", os.Getenv("KAFKA_PRODUCER_ACKS"))
+		viper.SetDefault("KAFKA_PRODUCER_IDEMPOTENT", os.Getenv("KAFKA_PRODUCER_IDEMPOTENT"))
+		viper.SetDefault("KAFKA_PRODUCER_RETRIES", os.Getenv("KAFKA_PRODUCER_RETRIES"))
+		viper.SetDefault("KAFKA_PRODUCER_COMPRESSION", os.Getenv("KAFKA_PRODUCER_COMPRESSION"))
 		viper.SetDefault("KEYCLOAK_CERT_URL", os.Getenv("KEYCLOAK_CERT_URL"))
 		viper.SetDefault("TZ", os.Getenv("TZ"))
+		viper.SetDefault("UPLOAD_DIR", os.Getenv("UPLOAD_DIR"))
+		viper.SetDefault("SNOWFLAKE_NODE_ID", os.Getenv("SNOWFLAKE_NODE_ID"))
 	}
 
 	_ = viper.Unmarshal(&cfg)
@@ -68,6 +80,14 @@ func InitConfig(envFile string) (Config, error) {
 		return Config{}, errors.New("invalid time zone")
 	}
 
+	if cfg.SnowflakeNodeID < 0 || cfg.SnowflakeNodeID > 1023 {
+		return Config{}, errors.New("snowflake node ID must be between 0 and 1023")
+	}
+
+	if cfg.UploadDir == "" {
+		cfg.UploadDir = "./uploads"
+	}
+
 	cfg.TableSessionDuration = 1 * time.Hour
 	return cfg, nil
 }
@@ -0,0 +1,36 @@
+CREATE TABLE public.product_modifier_groups (
+                                                id BIGINT NOT NULL PRIMARY KEY
+    ,product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,name VARCHAR(100) NOT NULL
+    ,name_en VARCHAR(100) NOT NULL
+    ,min_selections INTEGER DEFAULT 0 NOT NULL
+    ,max_selections INTEGER DEFAULT 1 NOT NULL
+    ,sort_order INTEGER DEFAULT 1 NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,updated_at TIMESTAMP WITH TIME zone
+    ,CONSTRAINT product_modifier_groups_selections_check CHECK (min_selections >= 0 AND max_selections >= 1 AND min_selections <= max_selections)
+);
+
+ALTER TABLE public.product_modifier_groups OWNER TO postgres;
+
+CREATE INDEX product_modifier_groups_product_id_idx ON public.product_modifier_groups (product_id);
+
+CREATE TABLE public.product_modifiers (
+                                          id BIGINT NOT NULL PRIMARY KEY
+    ,group_id BIGINT NOT NULL REFERENCES public.product_modifier_groups ON DELETE CASCADE
+    ,name VARCHAR(100) NOT NULL
+    ,name_en VARCHAR(100) NOT NULL
+    ,price_delta NUMERIC(10, 2) DEFAULT 0 NOT NULL
+    ,is_available boolean DEFAULT true NOT NULL
+    ,sort_order INTEGER DEFAULT 1 NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,updated_at TIMESTAMP WITH TIME zone
+);
+
+ALTER TABLE public.product_modifiers OWNER TO postgres;
+
+CREATE INDEX product_modifiers_group_id_idx ON public.product_modifiers (group_id);
+
+ALTER TABLE public.order_items ADD COLUMN modifiers JSONB;
+
+comment ON COLUMN public.order_items.modifiers IS 'ตัวเลือกเสริมที่ลูกค้าเลือก ณ เวลาสั่ง';
@@ -0,0 +1,25 @@
+CREATE TABLE public.product_variants (
+                                         id BIGINT NOT NULL PRIMARY KEY
+    ,product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,name VARCHAR(50) NOT NULL
+    ,name_en VARCHAR(50) NOT NULL
+    ,price NUMERIC(10, 2) DEFAULT 0 NOT NULL
+    ,is_available boolean DEFAULT true NOT NULL
+    ,sort_order INTEGER DEFAULT 1 NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,updated_at TIMESTAMP WITH TIME zone
+    ,CONSTRAINT product_variants_product_id_name_key UNIQUE (product_id, name)
+    ,CONSTRAINT product_variants_product_id_name_en_key UNIQUE (product_id, name_en)
+);
+
+comment ON COLUMN public.product_variants.name IS 'ต่อท้ายชื่อสินค้า เช่น เล็ก, กลาง, ใหญ่';
+
+ALTER TABLE public.product_variants OWNER TO postgres;
+
+CREATE INDEX product_variants_product_id_idx ON public.product_variants (product_id);
+
+ALTER TABLE public.order_items ADD COLUMN variant_id BIGINT REFERENCES public.product_variants ON DELETE SET NULL;
+
+ALTER TABLE public.order_items ADD COLUMN variant_name VARCHAR(50);
+
+ALTER TABLE public.order_items ADD COLUMN variant_name_en VARCHAR(50);
@@ -0,0 +1,49 @@
+CREATE TABLE public.menu_schedules (
+                                       id BIGINT NOT NULL PRIMARY KEY
+    ,product_id BIGINT REFERENCES public.products ON DELETE CASCADE
+    ,category_id BIGINT REFERENCES public.md_categories ON DELETE CASCADE
+    ,day_of_week SMALLINT NOT NULL
+    ,start_time TIME NOT NULL
+    ,end_time TIME NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,CONSTRAINT menu_schedules_scope_check CHECK (num_nonnulls(product_id, category_id) = 1)
+    ,CONSTRAINT menu_schedules_day_of_week_check CHECK (day_of_week BETWEEN 0 AND 6)
+    ,CONSTRAINT menu_schedules_time_check CHECK (start_time < end_time)
+);
+
+comment ON COLUMN public.menu_schedules.day_of_week IS 'วันในสัปดาห์ 0 = อาทิตย์ ถึง 6 = เสาร์';
+
+comment ON COLUMN public.menu_schedules.start_time IS 'เวลาเริ่มขาย (ตามเขตเวลา TZ ของระบบ)';
+
+comment ON COLUMN public.menu_schedules.end_time IS 'เวลาสิ้นสุดการขาย (ไม่รวมเวลานี้)';
+
+ALTER TABLE public.menu_schedules OWNER TO postgres;
+
+CREATE INDEX menu_schedules_product_id_idx ON public.menu_schedules (product_id);
+
+CREATE INDEX menu_schedules_category_id_idx ON public.menu_schedules (category_id);
+
+-- สินค้า/หมวดหมู่ที่ไม่มีตารางเวลา ถือว่าขายได้ตลอดเวลา
+CREATE OR REPLACE FUNCTION public.is_within_menu_schedule(p_product_id BIGINT, p_category_id BIGINT, p_time_zone TEXT)
+    RETURNS BOOLEAN
+    LANGUAGE sql
+    STABLE
+AS
+$$
+SELECT (NOT EXISTS (SELECT 1 FROM public.menu_schedules s WHERE s.product_id = p_product_id)
+    OR EXISTS (SELECT 1
+               FROM public.menu_schedules s
+               WHERE s.product_id = p_product_id
+                 AND s.day_of_week = EXTRACT(DOW FROM NOW() AT TIME ZONE p_time_zone)
+                 AND (NOW() AT TIME ZONE p_time_zone)::time >= s.start_time
+                 AND (NOW() AT TIME ZONE p_time_zone)::time < s.end_time))
+   AND (NOT EXISTS (SELECT 1 FROM public.menu_schedules s WHERE s.category_id = p_category_id)
+    OR EXISTS (SELECT 1
+               FROM public.menu_schedules s
+               WHERE s.category_id = p_category_id
+                 AND s.day_of_week = EXTRACT(DOW FROM NOW() AT TIME ZONE p_time_zone)
+                 AND (NOW() AT TIME ZONE p_time_zone)::time >= s.start_time
+                 AND (NOW() AT TIME ZONE p_time_zone)::time < s.end_time));
+$$;
+
+ALTER FUNCTION public.is_within_menu_schedule(BIGINT, BIGINT, TEXT) OWNER TO postgres;
@@ -0,0 +1,5 @@
+ALTER TABLE public.products ADD COLUMN stock_quantity INTEGER;
+
+ALTER TABLE public.products ADD CONSTRAINT products_stock_quantity_check CHECK (stock_quantity >= 0);
+
+comment ON COLUMN public.products.stock_quantity IS 'จำนวนคงเหลือ NULL = ไม่จำกัดจำนวน เมื่อเหลือ 0 ระบบจะปิดการขายอัตโนมัติ';
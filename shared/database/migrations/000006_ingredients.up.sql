@@ -0,0 +1,53 @@
+CREATE TABLE public.ingredients (
+                                    id BIGINT NOT NULL PRIMARY KEY
+    ,name VARCHAR(100) NOT NULL UNIQUE
+    ,name_en VARCHAR(100) NOT NULL UNIQUE
+    ,unit VARCHAR(20) NOT NULL
+    ,stock_quantity NUMERIC(12, 2) DEFAULT 0 NOT NULL
+    ,low_stock_threshold NUMERIC(12, 2) DEFAULT 0 NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,updated_at TIMESTAMP WITH TIME zone
+    ,CONSTRAINT ingredients_stock_quantity_check CHECK (stock_quantity >= 0)
+);
+
+comment ON COLUMN public.ingredients.unit IS 'หน่วยนับ เช่น g, ml, ฟอง';
+
+comment ON COLUMN public.ingredients.low_stock_threshold IS 'แจ้งเตือนเมื่อคงเหลือน้อยกว่าหรือเท่ากับค่านี้';
+
+ALTER TABLE public.ingredients OWNER TO postgres;
+
+CREATE TABLE public.product_ingredients (
+                                            product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,ingredient_id BIGINT NOT NULL REFERENCES public.ingredients
+    ,quantity NUMERIC(12, 2) NOT NULL
+    ,PRIMARY KEY (product_id, ingredient_id)
+    ,CONSTRAINT product_ingredients_quantity_check CHECK (quantity > 0)
+);
+
+comment ON COLUMN public.product_ingredients.quantity IS 'ปริมาณวัตถุดิบที่ใช้ต่อสินค้า 1 หน่วย';
+
+ALTER TABLE public.product_ingredients OWNER TO postgres;
+
+CREATE INDEX product_ingredients_ingredient_id_idx ON public.product_ingredients (ingredient_id);
+
+CREATE TABLE public.ingredient_stock_movements (
+                                                   id BIGINT NOT NULL PRIMARY KEY
+    ,ingredient_id BIGINT NOT NULL REFERENCES public.ingredients ON DELETE CASCADE
+    ,order_item_id BIGINT REFERENCES public.order_items ON DELETE SET NULL
+    ,quantity NUMERIC(12, 2) NOT NULL
+    ,reason VARCHAR(20) NOT NULL
+    ,note TEXT
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+);
+
+comment ON COLUMN public.ingredient_stock_movements.quantity IS 'จำนวนที่เปลี่ยนแปลง ค่าบวก = รับเข้า ค่าลบ = ตัดออก';
+
+comment ON COLUMN public.ingredient_stock_movements.reason IS 'ORDER, CANCEL, RESTOCK, ADJUST';
+
+ALTER TABLE public.ingredient_stock_movements OWNER TO postgres;
+
+CREATE INDEX ingredient_stock_movements_ingredient_id_idx ON public.ingredient_stock_movements (ingredient_id);
+
+CREATE INDEX ingredient_stock_movements_order_item_id_idx ON public.ingredient_stock_movements (order_item_id);
+
+ALTER TABLE public.order_items ADD COLUMN ingredients_deducted BOOLEAN DEFAULT false NOT NULL;
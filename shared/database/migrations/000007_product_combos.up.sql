@@ -0,0 +1,32 @@
+CREATE TABLE public.combo_slots (
+                                    id BIGINT NOT NULL PRIMARY KEY
+    ,combo_product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,name VARCHAR(100) NOT NULL
+    ,name_en VARCHAR(100) NOT NULL
+    ,sort_order INTEGER DEFAULT 1 NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+);
+
+comment ON TABLE public.combo_slots IS 'ช่องเลือกของชุดเมนู เช่น อาหารจานหลัก, เครื่องดื่ม, ของหวาน';
+
+ALTER TABLE public.combo_slots OWNER TO postgres;
+
+CREATE INDEX combo_slots_combo_product_id_idx ON public.combo_slots (combo_product_id);
+
+CREATE TABLE public.combo_slot_options (
+                                           slot_id BIGINT NOT NULL REFERENCES public.combo_slots ON DELETE CASCADE
+    ,product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,price_delta NUMERIC(10, 2) DEFAULT 0 NOT NULL
+    ,sort_order INTEGER DEFAULT 1 NOT NULL
+    ,PRIMARY KEY (slot_id, product_id)
+);
+
+comment ON COLUMN public.combo_slot_options.price_delta IS 'ราคาเพิ่มจากราคาชุด เมื่อเลือกตัวเลือกนี้';
+
+ALTER TABLE public.combo_slot_options OWNER TO postgres;
+
+ALTER TABLE public.order_items ADD COLUMN parent_order_item_id BIGINT REFERENCES public.order_items ON DELETE CASCADE;
+
+comment ON COLUMN public.order_items.parent_order_item_id IS 'รายการชุดเมนูที่รายการนี้เป็นส่วนประกอบ';
+
+CREATE INDEX order_items_parent_order_item_id_idx ON public.order_items (parent_order_item_id);
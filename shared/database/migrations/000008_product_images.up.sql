@@ -0,0 +1,3 @@
+ALTER TABLE public.products ADD COLUMN thumbnail_url TEXT;
+
+comment ON COLUMN public.products.thumbnail_url IS 'รูปย่อของสินค้า สร้างอัตโนมัติเมื่ออัปโหลดรูป';
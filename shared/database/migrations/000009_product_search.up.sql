@@ -0,0 +1,76 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE public.search_aliases (
+                                       id BIGINT NOT NULL PRIMARY KEY
+    ,alias VARCHAR(100) NOT NULL UNIQUE
+    ,term VARCHAR(100) NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+);
+
+comment ON TABLE public.search_aliases IS 'คำสะกดอื่นที่ใช้ค้นหาเมนู เช่น krapow -> kaphrao, pad -> phat';
+
+comment ON COLUMN public.search_aliases.alias IS 'คำที่ลูกค้าพิมพ์';
+
+comment ON COLUMN public.search_aliases.term IS 'คำที่ใช้ค้นหาแทน';
+
+ALTER TABLE public.search_aliases OWNER TO postgres;
+
+CREATE TABLE public.product_search_keywords (
+                                                product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,keyword VARCHAR(100) NOT NULL
+    ,PRIMARY KEY (product_id, keyword)
+);
+
+comment ON TABLE public.product_search_keywords IS 'คำค้นหาเพิ่มเติมของสินค้า เช่น ชื่อเรียกอื่น หรือวัตถุดิบหลัก';
+
+ALTER TABLE public.product_search_keywords OWNER TO postgres;
+
+CREATE OR REPLACE FUNCTION public.normalize_search_text(p_text TEXT)
+    RETURNS TEXT
+    LANGUAGE sql
+    IMMUTABLE
+AS
+$$
+SELECT regexp_replace(
+               regexp_replace(
+                       regexp_replace(lower(COALESCE(p_text, '')), '[็-๎]', '', 'g'),
+                       '([bcdgkpt])h', '\1', 'g'),
+               '[[:space:][:punct:]]', '', 'g');
+$$;
+
+comment ON FUNCTION public.normalize_search_text(TEXT) IS 'ตัดวรรณยุกต์ไทย ช่องว่าง เครื่องหมาย และ h หลังพยัญชนะในคำทับศัพท์ (phat = pat)';
+
+ALTER FUNCTION public.normalize_search_text(TEXT) OWNER TO postgres;
+
+CREATE OR REPLACE FUNCTION public.product_search_score(p_product_id BIGINT, p_name TEXT, p_name_en TEXT, p_term TEXT)
+    RETURNS REAL
+    LANGUAGE sql
+    STABLE
+AS
+$$
+WITH terms AS (SELECT public.normalize_search_text(p_term) AS term
+               UNION
+               SELECT string_agg(COALESCE((SELECT public.normalize_search_text(a.term)
+                                           FROM public.search_aliases a
+                                           WHERE public.normalize_search_text(a.alias) = public.normalize_search_text(w.word)
+                                           LIMIT 1), public.normalize_search_text(w.word)), '' ORDER BY w.ord)
+               FROM regexp_split_to_table(trim(p_term), '\s+') WITH ORDINALITY AS w(word, ord)),
+     candidates AS (SELECT public.normalize_search_text(p_name) AS value
+                    UNION ALL
+                    SELECT public.normalize_search_text(p_name_en)
+                    UNION ALL
+                    SELECT public.normalize_search_text(k.keyword)
+                    FROM public.product_search_keywords k
+                    WHERE k.product_id = p_product_id)
+SELECT COALESCE(MAX(CASE
+                        WHEN position(t.term IN c.value) > 0 THEN 1
+                        ELSE GREATEST(similarity(c.value, t.term), word_similarity(t.term, c.value))
+    END), 0)::real
+FROM terms t
+         CROSS JOIN candidates c
+WHERE t.term <> '';
+$$;
+
+comment ON FUNCTION public.product_search_score(BIGINT, TEXT, TEXT, TEXT) IS 'คะแนนความใกล้เคียงของคำค้นหากับชื่อสินค้าและคำค้นหาเพิ่มเติม (0-1)';
+
+ALTER FUNCTION public.product_search_score(BIGINT, TEXT, TEXT, TEXT) OWNER TO postgres;
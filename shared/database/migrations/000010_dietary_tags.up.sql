@@ -0,0 +1,35 @@
+CREATE TABLE public.md_dietary_tags (
+                                        id BIGINT NOT NULL PRIMARY KEY
+    ,code VARCHAR(20) NOT NULL UNIQUE
+    ,name VARCHAR(100) NOT NULL UNIQUE
+    ,name_en VARCHAR(100) NOT NULL UNIQUE
+    ,is_allergen BOOLEAN DEFAULT false NOT NULL
+    ,sort_order INTEGER DEFAULT 1 NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,updated_at TIMESTAMP WITH TIME zone
+);
+
+comment ON COLUMN public.md_dietary_tags.is_allergen IS 'true = สารก่อภูมิแพ้ (แจ้งเตือนในครัว), false = ข้อมูลโภชนาการ เช่น มังสวิรัติ ฮาลาล';
+
+ALTER TABLE public.md_dietary_tags OWNER TO postgres;
+
+CREATE TABLE public.product_dietary_tags (
+                                             product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,tag_id BIGINT NOT NULL REFERENCES public.md_dietary_tags
+    ,PRIMARY KEY (product_id, tag_id)
+);
+
+ALTER TABLE public.product_dietary_tags OWNER TO postgres;
+
+CREATE INDEX product_dietary_tags_tag_id_idx ON public.product_dietary_tags (tag_id);
+
+ALTER TABLE public.order_items ADD COLUMN allergens JSONB DEFAULT '[]'::jsonb NOT NULL;
+
+comment ON COLUMN public.order_items.allergens IS 'สารก่อภูมิแพ้ของสินค้า ณ เวลาที่สั่ง';
+
+insert into public.md_dietary_tags (id, code, name, name_en, is_allergen, sort_order, created_at, updated_at)
+values  (1990000000000000001, 'PEANUT', 'ถั่วลิสง', 'Peanut', true, 1, '2026-10-18 00:00:00.000000 +00:00', null),
+        (1990000000000000002, 'SHELLFISH', 'สัตว์น้ำมีเปลือก', 'Shellfish', true, 2, '2026-10-18 00:00:00.000000 +00:00', null),
+        (1990000000000000003, 'GLUTEN', 'กลูเตน', 'Gluten', true, 3, '2026-10-18 00:00:00.000000 +00:00', null),
+        (1990000000000000004, 'VEGETARIAN', 'มังสวิรัติ', 'Vegetarian', false, 4, '2026-10-18 00:00:00.000000 +00:00', null),
+        (1990000000000000005, 'HALAL', 'ฮาลาล', 'Halal', false, 5, '2026-10-18 00:00:00.000000 +00:00', null);
@@ -0,0 +1,19 @@
+CREATE TABLE public.translations (
+                                     entity_type VARCHAR(30) NOT NULL
+    ,entity_id BIGINT NOT NULL
+    ,field VARCHAR(30) NOT NULL
+    ,locale VARCHAR(10) NOT NULL
+    ,value TEXT NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,PRIMARY KEY (entity_type, entity_id, field, locale)
+);
+
+comment ON TABLE public.translations IS 'คำแปลชื่อ/รายละเอียดของข้อมูลหลักในภาษาอื่นนอกจากไทยและอังกฤษ เช่น zh, ja, ko';
+
+comment ON COLUMN public.translations.entity_type IS 'product, category, variant, modifier_group, modifier, combo_slot, order_status';
+
+comment ON COLUMN public.translations.locale IS 'รหัสภาษาตัวพิมพ์เล็ก เช่น zh, zh-tw, ja, ko';
+
+ALTER TABLE public.translations OWNER TO postgres;
+
+CREATE INDEX translations_entity_id_locale_idx ON public.translations (entity_id, locale);
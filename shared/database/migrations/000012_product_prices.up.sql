@@ -0,0 +1,36 @@
+CREATE TABLE public.product_prices (
+                                       id BIGINT NOT NULL PRIMARY KEY
+    ,product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,price NUMERIC(10, 2) NOT NULL
+    ,effective_from TIMESTAMP WITH TIME zone NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,CONSTRAINT product_prices_effective_from_key UNIQUE (product_id, effective_from)
+    ,CONSTRAINT product_prices_price_check CHECK (price >= 0)
+);
+
+comment ON TABLE public.product_prices IS 'ประวัติราคาสินค้า รวมถึงราคาที่ตั้งล่วงหน้า';
+
+comment ON COLUMN public.product_prices.effective_from IS 'เวลาที่ราคานี้เริ่มมีผล ใช้จนกว่าจะมีราคาถัดไป';
+
+ALTER TABLE public.product_prices OWNER TO postgres;
+
+-- ราคาปัจจุบันของสินค้าเดิม ใช้ id สินค้าเป็น id ของแถวแรก (snowflake id ไม่ซ้ำกันอยู่แล้ว)
+insert into public.product_prices (id, product_id, price, effective_from)
+select id, id, price, created_at from public.products;
+
+-- ราคาที่มีผล ณ เวลาที่กำหนด คืนค่า NULL ถ้าไม่มีประวัติราคา (ให้ใช้ products.price แทน)
+CREATE OR REPLACE FUNCTION public.product_price_at(p_product_id BIGINT, p_at TIMESTAMP WITH TIME zone)
+    RETURNS NUMERIC
+    LANGUAGE sql
+    STABLE
+AS
+$$
+SELECT pp.price
+FROM public.product_prices pp
+WHERE pp.product_id = p_product_id
+  AND pp.effective_from <= p_at
+ORDER BY pp.effective_from DESC
+LIMIT 1;
+$$;
+
+ALTER FUNCTION public.product_price_at(BIGINT, TIMESTAMP WITH TIME zone) OWNER TO postgres;
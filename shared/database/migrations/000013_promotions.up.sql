@@ -0,0 +1,96 @@
+CREATE TABLE public.promotions (
+                                   id BIGINT NOT NULL PRIMARY KEY
+    ,name VARCHAR(255) NOT NULL
+    ,name_en VARCHAR(255) NOT NULL
+    ,promotion_type VARCHAR(20) NOT NULL
+    ,discount_value NUMERIC(10, 2) DEFAULT 0 NOT NULL
+    ,buy_quantity INTEGER
+    ,free_quantity INTEGER
+    ,product_id BIGINT REFERENCES public.products ON DELETE CASCADE
+    ,category_id BIGINT REFERENCES public.md_categories ON DELETE CASCADE
+    ,starts_at TIMESTAMP WITH TIME zone
+    ,ends_at TIMESTAMP WITH TIME zone
+    ,days_of_week SMALLINT[]
+    ,start_time TIME
+    ,end_time TIME
+    ,is_active BOOLEAN DEFAULT true NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,updated_at TIMESTAMP WITH TIME zone
+    ,CONSTRAINT promotions_type_check CHECK (promotion_type IN ('PERCENTAGE', 'FIXED_AMOUNT', 'BUY_X_GET_Y'))
+    ,CONSTRAINT promotions_scope_check CHECK (num_nonnulls(product_id, category_id) = 1)
+    ,CONSTRAINT promotions_buy_x_get_y_check CHECK (promotion_type <> 'BUY_X_GET_Y' OR (buy_quantity > 0 AND free_quantity > 0))
+    ,CONSTRAINT promotions_period_check CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at)
+    ,CONSTRAINT promotions_time_check CHECK (num_nulls(start_time, end_time) <> 1 AND (start_time IS NULL OR start_time < end_time))
+);
+
+comment ON TABLE public.promotions IS 'โปรโมชั่น เช่น เครื่องดื่มลด 20% ช่วง 15:00-17:00 หรือ ซื้อ 2 แถม 1';
+
+comment ON COLUMN public.promotions.promotion_type IS 'PERCENTAGE = ลดเป็นเปอร์เซ็นต์, FIXED_AMOUNT = ลดเป็นจำนวนเงินต่อชิ้น, BUY_X_GET_Y = ซื้อ X แถม Y (ชิ้นที่ถูกที่สุดฟรี)';
+
+comment ON COLUMN public.promotions.discount_value IS 'เปอร์เซ็นต์ส่วนลด หรือ จำนวนเงินที่ลดต่อชิ้น (ไม่ใช้กับ BUY_X_GET_Y)';
+
+comment ON COLUMN public.promotions.days_of_week IS 'วันที่มีโปรโมชั่น 0 = อาทิตย์ ถึง 6 = เสาร์, NULL = ทุกวัน';
+
+comment ON COLUMN public.promotions.start_time IS 'เวลาเริ่มโปรโมชั่นในแต่ละวัน (ตามเขตเวลา TZ ของระบบ), NULL = ทั้งวัน';
+
+comment ON COLUMN public.promotions.end_time IS 'เวลาสิ้นสุดโปรโมชั่นในแต่ละวัน (ไม่รวมเวลานี้)';
+
+ALTER TABLE public.promotions OWNER TO postgres;
+
+CREATE INDEX promotions_product_id_idx ON public.promotions (product_id);
+
+CREATE INDEX promotions_category_id_idx ON public.promotions (category_id);
+
+ALTER TABLE public.order_items ADD COLUMN promotion_id BIGINT REFERENCES public.promotions;
+
+ALTER TABLE public.order_items ADD COLUMN discount_amount NUMERIC(10, 2) DEFAULT 0 NOT NULL;
+
+comment ON COLUMN public.order_items.promotion_id IS 'โปรโมชั่นที่ใช้กับรายการนี้ ณ เวลาที่สั่ง';
+
+comment ON COLUMN public.order_items.discount_amount IS 'ส่วนลดรวมของรายการ (ราคา x จำนวน) จากโปรโมชั่นแบบเปอร์เซ็นต์หรือจำนวนเงิน ส่วน BUY_X_GET_Y คิดตอนรวมบิล';
+
+CREATE OR REPLACE FUNCTION public.is_promotion_active(p_promotion_id BIGINT, p_at TIMESTAMP WITH TIME zone, p_time_zone TEXT)
+    RETURNS BOOLEAN
+    LANGUAGE sql
+    STABLE
+AS
+$$
+SELECT EXISTS (SELECT 1
+               FROM public.promotions p
+               WHERE p.id = p_promotion_id
+                 AND p.is_active IS TRUE
+                 AND (p.starts_at IS NULL OR p.starts_at <= p_at)
+                 AND (p.ends_at IS NULL OR p.ends_at > p_at)
+                 AND (p.days_of_week IS NULL OR EXTRACT(DOW FROM p_at AT TIME ZONE p_time_zone)::smallint = ANY (p.days_of_week))
+                 AND (p.start_time IS NULL OR ((p_at AT TIME ZONE p_time_zone)::time >= p.start_time
+                     AND (p_at AT TIME ZONE p_time_zone)::time < p.end_time)));
+$$;
+
+ALTER FUNCTION public.is_promotion_active(BIGINT, TIMESTAMP WITH TIME zone, TEXT) OWNER TO postgres;
+
+-- ส่วนลดซื้อ X แถม Y ของทั้งออเดอร์ รวมทุกรายการที่ผูกกับโปรโมชั่นเดียวกัน ชิ้นที่ถูกที่สุดเป็นชิ้นที่แถม
+CREATE OR REPLACE FUNCTION public.order_buy_x_get_y_discount(p_order_id BIGINT, p_served_only BOOLEAN)
+    RETURNS NUMERIC
+    LANGUAGE sql
+    STABLE
+AS
+$$
+WITH units AS (SELECT oi.promotion_id,
+                      oi.price,
+                      row_number() OVER (PARTITION BY oi.promotion_id ORDER BY oi.price, oi.id) AS unit_rank,
+                      count(*) OVER (PARTITION BY oi.promotion_id)                              AS unit_count
+               FROM public.order_items oi
+                        JOIN public.md_order_statuses mos ON mos.id = oi.status_id
+                        JOIN public.promotions p ON p.id = oi.promotion_id
+                        CROSS JOIN generate_series(1, oi.quantity)
+               WHERE oi.order_id = p_order_id
+                 AND p.promotion_type = 'BUY_X_GET_Y'
+                 AND mos.code <> 'CANCELLED'
+                 AND (p_served_only IS FALSE OR mos.code = 'SERVED'))
+SELECT COALESCE(SUM(u.price), 0)
+FROM units u
+         JOIN public.promotions p ON p.id = u.promotion_id
+WHERE u.unit_rank <= (u.unit_count / (p.buy_quantity + p.free_quantity)) * p.free_quantity;
+$$;
+
+ALTER FUNCTION public.order_buy_x_get_y_discount(BIGINT, BOOLEAN) OWNER TO postgres;
@@ -0,0 +1,25 @@
+CREATE TABLE public.menu_versions (
+                                      id BIGINT NOT NULL PRIMARY KEY
+    ,version_number INTEGER NOT NULL UNIQUE
+    ,snapshot JSONB NOT NULL
+    ,publish_at TIMESTAMP WITH TIME zone NOT NULL
+    ,note TEXT
+    ,rollback_of BIGINT REFERENCES public.menu_versions
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+);
+
+comment ON TABLE public.menu_versions IS 'เมนูที่เผยแพร่ให้ลูกค้า ตารางสินค้าเป็นฉบับร่าง เวอร์ชันที่ publish_at ล่าสุดที่ถึงเวลาแล้วคือเมนูปัจจุบัน';
+
+comment ON COLUMN public.menu_versions.snapshot IS 'หมวดหมู่และสินค้า (รวมตัวเลือก ท็อปปิ้ง คอมโบ แท็กอาหาร) ณ เวลาที่เผยแพร่';
+
+comment ON COLUMN public.menu_versions.publish_at IS 'เวลาที่เริ่มใช้เวอร์ชันนี้ ถ้าเป็นเวลาในอนาคตคือการตั้งเวลาเผยแพร่';
+
+comment ON COLUMN public.menu_versions.rollback_of IS 'เวอร์ชันเดิมที่นำกลับมาใช้ใหม่';
+
+ALTER TABLE public.menu_versions OWNER TO postgres;
+
+CREATE INDEX menu_versions_publish_at_idx ON public.menu_versions (publish_at);
+
+ALTER TABLE public.product_prices ADD COLUMN menu_version_id BIGINT REFERENCES public.menu_versions ON DELETE CASCADE;
+
+comment ON COLUMN public.product_prices.menu_version_id IS 'เวอร์ชันเมนูที่ทำให้ราคานี้มีผล ถ้ายกเลิกการเผยแพร่ ราคาจะถูกลบไปด้วย';
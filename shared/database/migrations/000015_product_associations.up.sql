@@ -0,0 +1,24 @@
+CREATE TABLE public.product_associations (
+                                             product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,associated_product_id BIGINT NOT NULL REFERENCES public.products ON DELETE CASCADE
+    ,order_count INTEGER NOT NULL
+    ,support NUMERIC(9, 6) NOT NULL
+    ,confidence NUMERIC(9, 6) NOT NULL
+    ,lift NUMERIC(12, 6) NOT NULL
+    ,computed_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,PRIMARY KEY (product_id, associated_product_id)
+);
+
+comment ON TABLE public.product_associations IS 'สินค้าที่มักสั่งด้วยกัน คำนวณใหม่เป็นระยะจาก order_items โดย background job ของ menu-service';
+
+comment ON COLUMN public.product_associations.order_count IS 'จำนวนออเดอร์ที่มีทั้งสองสินค้า';
+
+comment ON COLUMN public.product_associations.support IS 'สัดส่วนออเดอร์ที่มีทั้งสองสินค้า ต่อออเดอร์ทั้งหมด';
+
+comment ON COLUMN public.product_associations.confidence IS 'สัดส่วนออเดอร์ที่มี associated_product_id ในบรรดาออเดอร์ที่มี product_id';
+
+comment ON COLUMN public.product_associations.lift IS 'confidence หารด้วยสัดส่วนออเดอร์ที่มี associated_product_id มากกว่า 1 แปลว่าสั่งด้วยกันบ่อยกว่าปกติ';
+
+ALTER TABLE public.product_associations OWNER TO postgres;
+
+CREATE INDEX product_associations_product_id_idx ON public.product_associations (product_id, confidence DESC);
@@ -0,0 +1,20 @@
+CREATE TABLE public.outbox_events (
+                                      id BIGINT NOT NULL PRIMARY KEY
+    ,topic VARCHAR(255) NOT NULL
+    ,payload JSONB NOT NULL
+    ,attempts INTEGER DEFAULT 0 NOT NULL
+    ,last_error TEXT
+    ,next_attempt_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,sent_at TIMESTAMP WITH TIME zone
+);
+
+comment ON TABLE public.outbox_events IS 'ข้อความ Kafka ที่บันทึกใน transaction เดียวกับออเดอร์ relay worker ของ order-service เป็นผู้ส่งจริง';
+
+comment ON COLUMN public.outbox_events.next_attempt_at IS 'เวลาที่จะลองส่งครั้งถัดไป ใช้เป็น lease ระหว่างส่งด้วย';
+
+comment ON COLUMN public.outbox_events.sent_at IS 'เวลาที่ส่งสำเร็จ ถ้าเป็น NULL คือยังไม่ได้ส่ง';
+
+ALTER TABLE public.outbox_events OWNER TO postgres;
+
+CREATE INDEX outbox_events_pending_idx ON public.outbox_events (next_attempt_at, id) WHERE sent_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE public.outbox_events ADD COLUMN message_key VARCHAR(255);
+
+comment ON COLUMN public.outbox_events.message_key IS 'Kafka message key ข้อความของออเดอร์เดียวกันใช้ key เดียวกันจึงอยู่ partition เดียวกันและถึงครัวตามลำดับ';
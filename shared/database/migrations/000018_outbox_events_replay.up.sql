@@ -0,0 +1,3 @@
+CREATE INDEX outbox_events_topic_message_key_idx ON public.outbox_events (topic, message_key, id);
+
+comment ON INDEX public.outbox_events_topic_message_key_idx IS 'ใช้ส่งเหตุการณ์ย้อนหลังของออเดอร์ให้ลูกค้าที่เชื่อมต่อใหม่ด้วย Last-Event-ID';
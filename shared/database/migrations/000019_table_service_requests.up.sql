@@ -0,0 +1,26 @@
+CREATE TABLE public.table_service_requests (
+                                      id BIGINT NOT NULL PRIMARY KEY
+    ,session_id uuid NOT NULL REFERENCES public.table_session(session_id)
+    ,table_id BIGINT NOT NULL REFERENCES public.tables
+    ,order_id BIGINT REFERENCES public.orders
+    ,request_type VARCHAR(20) NOT NULL CHECK (request_type IN ('CALL_WAITER', 'REQUEST_BILL'))
+    ,reason VARCHAR(20) CHECK (reason IN ('WATER', 'CUTLERY', 'HELP'))
+    ,status VARCHAR(20) DEFAULT 'PENDING' NOT NULL CHECK (status IN ('PENDING', 'ACKNOWLEDGED', 'RESOLVED'))
+    ,previous_table_status_id BIGINT NOT NULL REFERENCES public.md_table_statuses
+    ,created_at TIMESTAMP WITH TIME zone DEFAULT now() NOT NULL
+    ,acknowledged_at TIMESTAMP WITH TIME zone
+    ,resolved_at TIMESTAMP WITH TIME zone
+);
+
+comment ON TABLE public.table_service_requests IS 'คำขอจากลูกค้าที่โต๊ะ เช่น เรียกพนักงานหรือขอเช็คบิล พนักงานรับทราบแล้วจึงปิดคำขอ';
+
+comment ON COLUMN public.table_service_requests.reason IS 'เหตุผลที่เรียกพนักงาน ใช้กับ CALL_WAITER เท่านั้น';
+
+comment ON COLUMN public.table_service_requests.previous_table_status_id IS 'สถานะโต๊ะก่อนเรียกพนักงาน ใช้คืนสถานะเมื่อปิดคำขอ';
+
+ALTER TABLE public.table_service_requests OWNER TO postgres;
+
+-- คำขอประเภทเดียวกันที่ยังไม่ปิดมีได้ครั้งละหนึ่งรายการต่อ session
+CREATE UNIQUE INDEX table_service_requests_open_idx ON public.table_service_requests (session_id, request_type) WHERE status <> 'RESOLVED';
+
+CREATE INDEX table_service_requests_status_idx ON public.table_service_requests (status, created_at);
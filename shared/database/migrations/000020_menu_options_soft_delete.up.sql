@@ -0,0 +1,25 @@
+ALTER TABLE public.product_variants ADD COLUMN deleted_at TIMESTAMP WITH TIME zone;
+
+comment ON COLUMN public.product_variants.deleted_at IS 'เวลาที่นำออกจากสินค้า เก็บแถวไว้ให้ออเดอร์เดิม เมนูที่เผยแพร่แล้ว และคำแปลยังอ้างถึงรหัสเดิมได้';
+
+ALTER TABLE public.product_variants DROP CONSTRAINT product_variants_product_id_name_key;
+
+ALTER TABLE public.product_variants DROP CONSTRAINT product_variants_product_id_name_en_key;
+
+CREATE UNIQUE INDEX product_variants_product_id_name_key ON public.product_variants (product_id, name) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX product_variants_product_id_name_en_key ON public.product_variants (product_id, name_en) WHERE deleted_at IS NULL;
+
+ALTER TABLE public.product_modifier_groups ADD COLUMN deleted_at TIMESTAMP WITH TIME zone;
+
+comment ON COLUMN public.product_modifier_groups.deleted_at IS 'เวลาที่นำออกจากสินค้า เก็บแถวไว้ให้เมนูที่เผยแพร่แล้วและคำแปลยังอ้างถึงรหัสเดิมได้';
+
+ALTER TABLE public.product_modifiers ADD COLUMN deleted_at TIMESTAMP WITH TIME zone;
+
+comment ON COLUMN public.product_modifiers.deleted_at IS 'เวลาที่นำออกจากกลุ่ม เก็บแถวไว้ให้ออเดอร์เดิม เมนูที่เผยแพร่แล้ว และคำแปลยังอ้างถึงรหัสเดิมได้';
+
+ALTER TABLE public.combo_slots ADD COLUMN updated_at TIMESTAMP WITH TIME zone;
+
+ALTER TABLE public.combo_slots ADD COLUMN deleted_at TIMESTAMP WITH TIME zone;
+
+comment ON COLUMN public.combo_slots.deleted_at IS 'เวลาที่นำออกจากชุดเมนู เก็บแถวไว้ให้ออเดอร์เดิม เมนูที่เผยแพร่แล้ว และคำแปลยังอ้างถึงรหัสเดิมได้';
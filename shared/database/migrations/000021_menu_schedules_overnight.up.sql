@@ -0,0 +1,36 @@
+-- ช่วงเวลาที่ start_time มากกว่า end_time คือช่วงข้ามเที่ยงคืน เช่น 22:00-02:00 ของวันศุกร์ ขายได้ถึงตีสองของวันเสาร์
+ALTER TABLE public.menu_schedules
+    DROP CONSTRAINT menu_schedules_time_check;
+
+ALTER TABLE public.menu_schedules
+    ADD CONSTRAINT menu_schedules_time_check CHECK (start_time <> end_time);
+
+comment ON COLUMN public.menu_schedules.end_time IS 'เวลาสิ้นสุดการขาย (ไม่รวมเวลานี้) ถ้าน้อยกว่า start_time คือเวลาของวันถัดไป';
+
+-- สินค้า/หมวดหมู่ที่ไม่มีตารางเวลา ถือว่าขายได้ตลอดเวลา
+CREATE OR REPLACE FUNCTION public.is_within_menu_schedule(p_product_id BIGINT, p_category_id BIGINT, p_time_zone TEXT)
+    RETURNS BOOLEAN
+    LANGUAGE sql
+    STABLE
+AS
+$$
+WITH now_local AS (SELECT EXTRACT(DOW FROM NOW() AT TIME ZONE p_time_zone)::SMALLINT AS day_of_week
+                        , (NOW() AT TIME ZONE p_time_zone)::time                   AS time_of_day)
+   , open_schedules AS (SELECT s.product_id, s.category_id
+                        FROM public.menu_schedules s
+                                 CROSS JOIN now_local n
+                        WHERE (s.start_time < s.end_time
+                            AND s.day_of_week = n.day_of_week
+                            AND n.time_of_day >= s.start_time
+                            AND n.time_of_day < s.end_time)
+                           -- ช่วงข้ามเที่ยงคืน: ส่วนก่อนเที่ยงคืนของวันนี้ หรือส่วนหลังเที่ยงคืนของช่วงที่เริ่มเมื่อวาน
+                           OR (s.start_time > s.end_time
+                            AND ((s.day_of_week = n.day_of_week AND n.time_of_day >= s.start_time)
+                                OR (s.day_of_week = (n.day_of_week + 6) % 7 AND n.time_of_day < s.end_time))))
+SELECT (NOT EXISTS (SELECT 1 FROM public.menu_schedules s WHERE s.product_id = p_product_id)
+    OR EXISTS (SELECT 1 FROM open_schedules o WHERE o.product_id = p_product_id))
+   AND (NOT EXISTS (SELECT 1 FROM public.menu_schedules s WHERE s.category_id = p_category_id)
+    OR EXISTS (SELECT 1 FROM open_schedules o WHERE o.category_id = p_category_id));
+$$;
+
+ALTER FUNCTION public.is_within_menu_schedule(BIGINT, BIGINT, TEXT) OWNER TO postgres;
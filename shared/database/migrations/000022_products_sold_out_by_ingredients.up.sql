@@ -0,0 +1,3 @@
+ALTER TABLE public.products ADD COLUMN sold_out_by_ingredients BOOLEAN DEFAULT FALSE NOT NULL;
+
+comment ON COLUMN public.products.sold_out_by_ingredients IS 'ระบบปิดการขายเองเพราะวัตถุดิบไม่พอ เปิดขายคืนอัตโนมัติเมื่อวัตถุดิบทุกรายการพอ แยกจากการปิดขายโดยพนักงาน';
@@ -0,0 +1,42 @@
+ALTER TABLE public.order_items ADD COLUMN promotion_buy_quantity INTEGER;
+
+ALTER TABLE public.order_items ADD COLUMN promotion_free_quantity INTEGER;
+
+comment ON COLUMN public.order_items.promotion_buy_quantity IS 'จำนวนที่ต้องซื้อของโปรโมชั่น BUY_X_GET_Y ณ เวลาที่สั่ง แก้โปรโมชั่นภายหลังไม่กระทบบิลเดิม';
+
+comment ON COLUMN public.order_items.promotion_free_quantity IS 'จำนวนที่แถมของโปรโมชั่น BUY_X_GET_Y ณ เวลาที่สั่ง';
+
+UPDATE public.order_items oi
+SET promotion_buy_quantity  = p.buy_quantity,
+    promotion_free_quantity = p.free_quantity
+FROM public.promotions p
+WHERE p.id = oi.promotion_id
+  AND p.promotion_type = 'BUY_X_GET_Y';
+
+-- ส่วนลดซื้อ X แถม Y ของทั้งออเดอร์ คิดจากเงื่อนไขที่บันทึกไว้กับรายการ รายการที่สั่งภายใต้เงื่อนไขต่างกันนับแยกกัน
+CREATE OR REPLACE FUNCTION public.order_buy_x_get_y_discount(p_order_id BIGINT, p_served_only BOOLEAN)
+    RETURNS NUMERIC
+    LANGUAGE sql
+    STABLE
+AS
+$$
+WITH units AS (SELECT oi.price,
+                      oi.promotion_buy_quantity,
+                      oi.promotion_free_quantity,
+                      row_number() OVER rule_units AS unit_rank,
+                      count(*) OVER rule_group     AS unit_count
+               FROM public.order_items oi
+                        JOIN public.md_order_statuses mos ON mos.id = oi.status_id
+                        CROSS JOIN generate_series(1, oi.quantity)
+               WHERE oi.order_id = p_order_id
+                 AND oi.promotion_free_quantity IS NOT NULL
+                 AND mos.code <> 'CANCELLED'
+                 AND (p_served_only IS FALSE OR mos.code = 'SERVED')
+               WINDOW rule_group AS (PARTITION BY oi.promotion_id, oi.promotion_buy_quantity, oi.promotion_free_quantity),
+                      rule_units AS (rule_group ORDER BY oi.price, oi.id))
+SELECT COALESCE(SUM(u.price), 0)
+FROM units u
+WHERE u.unit_rank <= (u.unit_count / (u.promotion_buy_quantity + u.promotion_free_quantity)) * u.promotion_free_quantity;
+$$;
+
+ALTER FUNCTION public.order_buy_x_get_y_discount(BIGINT, BOOLEAN) OWNER TO postgres;
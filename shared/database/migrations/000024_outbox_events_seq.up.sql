@@ -0,0 +1,28 @@
+CREATE SEQUENCE public.outbox_events_seq_seq;
+
+ALTER SEQUENCE public.outbox_events_seq_seq OWNER TO postgres;
+
+ALTER TABLE public.outbox_events ADD COLUMN seq BIGINT;
+
+UPDATE public.outbox_events e
+SET seq = ordered.seq
+FROM (SELECT id, row_number() OVER (ORDER BY id) AS seq
+      FROM public.outbox_events) ordered
+WHERE ordered.id = e.id;
+
+SELECT setval('public.outbox_events_seq_seq', COALESCE(MAX(seq), 0) + 1, false)
+FROM public.outbox_events;
+
+ALTER TABLE public.outbox_events ALTER COLUMN seq SET DEFAULT nextval('public.outbox_events_seq_seq');
+
+ALTER TABLE public.outbox_events ALTER COLUMN seq SET NOT NULL;
+
+ALTER SEQUENCE public.outbox_events_seq_seq OWNED BY public.outbox_events.seq;
+
+comment ON COLUMN public.outbox_events.seq IS 'ลำดับของเหตุการณ์ภายใน key เดียวกันตามลำดับ commit ใช้เป็น event ID ของ SSE และลำดับการส่ง id ที่มาจาก snowflake ของหลาย service เรียงแทนไม่ได้';
+
+DROP INDEX public.outbox_events_topic_message_key_idx;
+
+CREATE UNIQUE INDEX outbox_events_topic_message_key_seq_idx ON public.outbox_events (topic, message_key, seq);
+
+comment ON INDEX public.outbox_events_topic_message_key_seq_idx IS 'ใช้ส่งเหตุการณ์ย้อนหลังของออเดอร์ให้ลูกค้าที่เชื่อมต่อใหม่ด้วย Last-Event-ID และหาเหตุการณ์ก่อนหน้าที่ยังไม่ได้ส่ง';
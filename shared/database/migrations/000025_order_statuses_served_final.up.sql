@@ -0,0 +1,5 @@
+-- รายการอาหารที่เสิร์ฟแล้วเปลี่ยนสถานะต่อไม่ได้ ให้ตรงกับ orderstatus.OrderItem
+UPDATE public.md_order_statuses
+SET is_final   = TRUE,
+    updated_at = NOW()
+WHERE code = 'SERVED';
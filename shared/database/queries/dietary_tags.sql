@@ -0,0 +1,24 @@
+-- name: ListDietaryTags :many
+SELECT code, "name", name_en as "nameEN", is_allergen as "isAllergen"
+FROM public.md_dietary_tags
+ORDER BY sort_order;
+
+-- name: ListDietaryTagsByProductIDs :many
+SELECT pt.product_id as "productID",
+       t.code,
+       t."name",
+       t.name_en as "nameEN",
+       t.is_allergen as "isAllergen"
+FROM public.product_dietary_tags pt
+         JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+WHERE pt.product_id = ANY(sqlc.arg(product_ids)::bigint[])
+ORDER BY pt.product_id, t.sort_order;
+
+-- name: DeleteProductDietaryTags :exec
+DELETE FROM public.product_dietary_tags WHERE product_id = sqlc.arg(product_id)::bigint;
+
+-- name: CreateProductDietaryTags :exec
+INSERT INTO public.product_dietary_tags (product_id, tag_id)
+SELECT sqlc.arg(product_id)::bigint, t.id
+FROM public.md_dietary_tags t
+WHERE t.code = ANY(sqlc.arg(codes)::varchar[]);
@@ -0,0 +1,101 @@
+-- name: ListIngredients :many
+SELECT id, "name", name_en as "nameEN", unit, stock_quantity as "stockQuantity", low_stock_threshold as "lowStockThreshold"
+FROM public.ingredients
+ORDER BY name_en;
+
+-- name: ListLowStockIngredients :many
+SELECT id, "name", name_en as "nameEN", unit, stock_quantity as "stockQuantity", low_stock_threshold as "lowStockThreshold"
+FROM public.ingredients
+WHERE stock_quantity <= low_stock_threshold
+ORDER BY stock_quantity - low_stock_threshold, name_en;
+
+-- name: CreateIngredient :one
+INSERT INTO public.ingredients (id, "name", name_en, unit, low_stock_threshold)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(name)::varchar, sqlc.arg(name_en)::varchar, sqlc.arg(unit)::varchar, sqlc.arg(low_stock_threshold)::numeric)
+RETURNING id;
+
+-- name: UpdateIngredient :execrows
+UPDATE public.ingredients
+SET "name"              = sqlc.arg(name)::varchar,
+    name_en             = sqlc.arg(name_en)::varchar,
+    unit                = sqlc.arg(unit)::varchar,
+    low_stock_threshold = sqlc.arg(low_stock_threshold)::numeric,
+    updated_at          = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: IsIngredientExists :one
+SELECT count(*) > 0 as "isExists" FROM public.ingredients WHERE id = $1;
+
+-- name: AdjustIngredientStock :execrows
+UPDATE public.ingredients
+SET stock_quantity = stock_quantity + sqlc.arg(quantity)::numeric,
+    updated_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND stock_quantity + sqlc.arg(quantity)::numeric >= 0;
+
+-- name: CreateIngredientMovement :exec
+INSERT INTO public.ingredient_stock_movements (id, ingredient_id, order_item_id, quantity, reason, note)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(ingredient_id)::bigint, sqlc.narg(order_item_id)::bigint, sqlc.arg(quantity)::numeric, sqlc.arg(reason)::varchar, sqlc.narg(note)::text);
+
+-- name: ListIngredientMovementsByOrderItemID :many
+SELECT ingredient_id as "ingredientID", SUM(quantity)::numeric as "quantity"
+FROM public.ingredient_stock_movements
+WHERE order_item_id = sqlc.arg(order_item_id)::bigint
+GROUP BY ingredient_id;
+
+-- name: ListProductIngredients :many
+SELECT pi.ingredient_id as "ingredientID", i."name", i.name_en as "nameEN", i.unit, pi.quantity
+FROM public.product_ingredients pi
+         JOIN public.ingredients i ON i.id = pi.ingredient_id
+WHERE pi.product_id = sqlc.arg(product_id)::bigint
+ORDER BY i.name_en;
+
+-- name: ListProductIngredientsForUpdate :many
+SELECT i.id, i.name_en as "nameEN", i.stock_quantity as "stockQuantity", (pi.quantity * sqlc.arg(quantity)::int)::numeric as "requiredQuantity"
+FROM public.product_ingredients pi
+         JOIN public.ingredients i ON i.id = pi.ingredient_id
+WHERE pi.product_id = sqlc.arg(product_id)::bigint
+ORDER BY i.id
+    FOR UPDATE OF i;
+
+-- name: DeleteProductIngredients :exec
+DELETE FROM public.product_ingredients WHERE product_id = sqlc.arg(product_id)::bigint;
+
+-- name: CreateProductIngredient :exec
+INSERT INTO public.product_ingredients (product_id, ingredient_id, quantity)
+VALUES (sqlc.arg(product_id)::bigint, sqlc.arg(ingredient_id)::bigint, sqlc.arg(quantity)::numeric);
+
+-- name: UpdateProductsUnavailableByIngredients :exec
+UPDATE public.products p
+SET is_available            = FALSE,
+    sold_out_by_ingredients = TRUE,
+    updated_at              = NOW()
+WHERE p.is_available IS TRUE
+  AND EXISTS (SELECT 1
+              FROM public.product_ingredients pi
+                       JOIN public.ingredients i ON i.id = pi.ingredient_id
+              WHERE pi.product_id = p.id
+                AND pi.ingredient_id = ANY (sqlc.arg(ingredient_ids)::bigint[])
+                AND i.stock_quantity < pi.quantity);
+
+-- name: UpdateProductsAvailableByIngredients :exec
+UPDATE public.products p
+SET is_available            = TRUE,
+    sold_out_by_ingredients = FALSE,
+    updated_at              = NOW()
+WHERE p.sold_out_by_ingredients IS TRUE
+  AND (p.stock_quantity IS NULL OR p.stock_quantity > 0)
+  AND EXISTS (SELECT 1
+              FROM public.product_ingredients pi
+              WHERE pi.product_id = p.id
+                AND pi.ingredient_id = ANY (sqlc.arg(ingredient_ids)::bigint[]))
+  AND NOT EXISTS (SELECT 1
+                  FROM public.product_ingredients pi
+                           JOIN public.ingredients i ON i.id = pi.ingredient_id
+                  WHERE pi.product_id = p.id
+                    AND i.stock_quantity < pi.quantity);
+
+-- name: UpdateOrderItemsIngredientsDeducted :exec
+UPDATE public.order_items
+SET ingredients_deducted = sqlc.arg(ingredients_deducted)::boolean
+WHERE id = sqlc.arg(id)::bigint;
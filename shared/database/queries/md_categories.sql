@@ -1,2 +1,39 @@
 -- name: ListCategory :many
-SELECT id, "name", name_en as "nameEN", icon_name as "icon" FROM public.md_categories WHERE is_visible IS TRUE ORDER BY sort_order;
\ No newline at end of file
+SELECT id, "name", name_en as "nameEN", icon_name as "icon" FROM public.md_categories WHERE is_visible IS TRUE ORDER BY sort_order;
+
+-- name: ListAllCategory :many
+SELECT id, "name", name_en as "nameEN", icon_name as "icon", sort_order, is_visible, code FROM public.md_categories ORDER BY sort_order;
+
+-- name: CreateCategory :one
+INSERT INTO public.md_categories (id, "name", name_en, icon_name, sort_order, is_visible, code)
+VALUES ($1, $2, $3, $4, (SELECT COALESCE(MAX(sort_order), 0) + 1 FROM public.md_categories), $5, $6)
+RETURNING id;
+
+-- name: UpdateCategory :execrows
+UPDATE public.md_categories
+SET "name"     = $2,
+    name_en    = $3,
+    icon_name  = $4,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCategoryVisibility :execrows
+UPDATE public.md_categories
+SET is_visible = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetCategorySortOrderForUpdate :one
+SELECT sort_order FROM public.md_categories WHERE id = $1 FOR UPDATE;
+
+-- name: GetCategoryIDBySortOrderForUpdate :one
+SELECT id FROM public.md_categories WHERE sort_order = $1 FOR UPDATE;
+
+-- name: UpdateCategorySortOrder :exec
+UPDATE public.md_categories
+SET sort_order = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: IsCategoryExists :one
+SELECT count(*) > 0 as "isExists" FROM public.md_categories WHERE id = $1;
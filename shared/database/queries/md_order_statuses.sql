@@ -5,6 +5,9 @@ FROM public.md_order_statuses order by sort_order ASC;
 -- name: IsOrderStatusExist :one
 SELECT (COUNT(id) > 0)  as isExist FROM public.md_order_statuses WHERE code = sqlc.arg(code)::varchar;
 
+-- name: GetOrderStatusPending :one
+SELECT id FROM public.md_order_statuses WHERE code = 'PENDING' LIMIT 1;
+
 -- name: GetOrderStatusPreparing :one
 SELECT id FROM public.md_order_statuses WHERE code = 'PREPARING' LIMIT 1;
 
@@ -0,0 +1,26 @@
+-- name: ListMenuSchedulesByProductID :many
+SELECT id, day_of_week as "dayOfWeek", start_time as "startTime", end_time as "endTime"
+FROM public.menu_schedules
+WHERE product_id = sqlc.arg(product_id)::bigint
+ORDER BY day_of_week, start_time;
+
+-- name: ListMenuSchedulesByCategoryID :many
+SELECT id, day_of_week as "dayOfWeek", start_time as "startTime", end_time as "endTime"
+FROM public.menu_schedules
+WHERE category_id = sqlc.arg(category_id)::bigint
+ORDER BY day_of_week, start_time;
+
+-- name: DeleteMenuSchedulesByProductID :exec
+DELETE FROM public.menu_schedules WHERE product_id = sqlc.arg(product_id)::bigint;
+
+-- name: DeleteMenuSchedulesByCategoryID :exec
+DELETE FROM public.menu_schedules WHERE category_id = sqlc.arg(category_id)::bigint;
+
+-- name: CreateMenuSchedule :exec
+INSERT INTO public.menu_schedules (id, product_id, category_id, day_of_week, start_time, end_time)
+VALUES (sqlc.arg(id)::bigint, sqlc.narg(product_id)::bigint, sqlc.narg(category_id)::bigint, sqlc.arg(day_of_week)::smallint, sqlc.arg(start_time)::time, sqlc.arg(end_time)::time);
+
+-- name: IsProductWithinSchedule :one
+SELECT public.is_within_menu_schedule(p.id, p.categories, sqlc.arg(time_zone)::text)::boolean as "isWithinSchedule"
+FROM public.products as p
+WHERE p.id = sqlc.arg(id)::bigint;
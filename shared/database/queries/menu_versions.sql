@@ -0,0 +1,48 @@
+-- name: CreateMenuVersion :one
+INSERT INTO public.menu_versions (id, version_number, snapshot, publish_at, note, rollback_of)
+VALUES (sqlc.arg(id)::bigint,
+        (SELECT COALESCE(MAX(version_number), 0) + 1 FROM public.menu_versions),
+        sqlc.arg(snapshot)::jsonb,
+        COALESCE(sqlc.narg(publish_at)::timestamptz, NOW()),
+        sqlc.narg(note)::text,
+        sqlc.narg(rollback_of)::bigint)
+RETURNING version_number as "versionNumber", publish_at as "publishAt";
+
+-- name: GetPublishedMenuVersionID :one
+SELECT id FROM public.menu_versions WHERE publish_at <= NOW() ORDER BY publish_at DESC, version_number DESC LIMIT 1;
+
+-- name: IsMenuVersioned :one
+SELECT EXISTS (SELECT 1 FROM public.menu_versions)::boolean as "isVersioned";
+
+-- name: ListMenuVersions :many
+SELECT v.id,
+       v.version_number as "versionNumber",
+       v.publish_at as "publishAt",
+       v.note,
+       v.rollback_of as "rollbackOf",
+       v.created_at as "createdAt",
+       jsonb_array_length(v.snapshot -> 'products')::int as "productCount",
+       (v.publish_at > NOW())::boolean as "isScheduled",
+       (v.id IS NOT DISTINCT FROM (SELECT c.id
+                                   FROM public.menu_versions c
+                                   WHERE c.publish_at <= NOW()
+                                   ORDER BY c.publish_at DESC, c.version_number DESC
+                                   LIMIT 1))::boolean as "isCurrent"
+FROM public.menu_versions v
+ORDER BY v.version_number DESC;
+
+-- name: GetMenuVersionSnapshot :one
+SELECT snapshot, (publish_at <= NOW())::boolean as "isPublished" FROM public.menu_versions WHERE id = sqlc.arg(id)::bigint;
+
+-- name: GetPublishedCategories :one
+SELECT (snapshot -> 'categories')::jsonb as "categories" FROM public.menu_versions WHERE id = sqlc.arg(id)::bigint;
+
+-- name: ListPublishedProducts :many
+SELECT p.value::jsonb as "product"
+FROM public.menu_versions v
+         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') p
+WHERE v.id = sqlc.arg(menu_version_id)::bigint
+  AND (p.value ->> 'id')::bigint = ANY (sqlc.arg(product_ids)::bigint[]);
+
+-- name: DeleteScheduledMenuVersion :execrows
+DELETE FROM public.menu_versions WHERE id = sqlc.arg(id)::bigint AND publish_at > NOW();
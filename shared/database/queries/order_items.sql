@@ -1,7 +1,7 @@
 -- name: CreateOrderItems :copyfrom
 INSERT INTO public.order_items
-(id, order_id, product_id, status_id, product_name, product_name_en, price, quantity, note, created_at, product_image_url, is_visible)
-VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+(id, order_id, product_id, status_id, product_name, product_name_en, price, quantity, note, created_at, product_image_url, is_visible, modifiers, variant_id, variant_name, variant_name_en, parent_order_item_id, allergens, promotion_id, discount_amount, promotion_buy_quantity, promotion_free_quantity)
+VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22);
 
 -- name: CreateOrderItemsPerRow :exec
 INSERT INTO public.order_items
@@ -17,6 +17,48 @@ FROM public.order_items WHERE id = $1 AND is_visible IS TRUE;
 SELECT COUNT(id) > 0 as "isExist"
 FROM public.order_items WHERE id = $1;
 
+-- name: GetOrderItemsStatusForUpdate :one
+SELECT oi.order_id, oi.product_id, oi.quantity, oi.ingredients_deducted, oi.parent_order_item_id, ms.code as "statusCode", ms.is_final as "isFinal"
+FROM public.order_items oi
+         JOIN public.md_order_statuses ms ON ms.id = oi.status_id
+WHERE oi.id = sqlc.arg(id)::bigint
+    FOR UPDATE OF oi;
+
+-- name: GetOrderItemForAmend :one
+SELECT oi.id,
+       oi.product_id,
+       oi.product_name_en,
+       oi.price,
+       oi.quantity,
+       oi.discount_amount,
+       oi.modifiers,
+       oi.parent_order_item_id,
+       oi.is_visible,
+       oi.created_at,
+       ms.code as "statusCode"
+FROM public.order_items oi
+         JOIN public.md_order_statuses ms ON ms.id = oi.status_id
+WHERE oi.id = sqlc.arg(id)::bigint
+  AND oi.order_id = sqlc.arg(order_id)::bigint;
+
+-- name: AmendOrderItem :exec
+UPDATE public.order_items
+SET quantity = sqlc.arg(quantity)::int,
+    note = sqlc.narg(note)::text,
+    modifiers = sqlc.arg(modifiers)::jsonb,
+    price = sqlc.arg(price)::numeric,
+    discount_amount = sqlc.arg(discount_amount)::numeric,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: GetComboOrderItemProgress :one
+SELECT COUNT(*)::int as "total",
+       COUNT(*) FILTER (WHERE ms.code = 'SERVED')::int as "served",
+       COUNT(*) FILTER (WHERE ms.code = 'CANCELLED')::int as "cancelled"
+FROM public.order_items oi
+         JOIN public.md_order_statuses ms ON ms.id = oi.status_id
+WHERE oi.parent_order_item_id = sqlc.arg(parent_order_item_id)::bigint;
+
 -- name: UpdateOrderItemsStatus :exec
 UPDATE public.order_items
 SET status_id = (SELECT id FROM public.md_order_statuses WHERE code = sqlc.arg(status_code)::text LIMIT 1), updated_at = NOW()
@@ -28,7 +70,8 @@ SET status_id = (SELECT id FROM public.md_order_statuses WHERE code = 'SERVED' L
 WHERE id = sqlc.arg(id)::bigint;
 
 -- name: GetTotalAmountToPayForServedItems :one
-SELECT COALESCE(SUM(oi.price * oi.quantity), 0) AS "totalAmount"
+SELECT (COALESCE(SUM(oi.price * oi.quantity - oi.discount_amount), 0)
+    - public.order_buy_x_get_y_discount(sqlc.arg(id)::bigint, TRUE))::numeric AS "totalAmount"
 FROM public.order_items oi
          JOIN public.md_order_statuses ms
               ON ms.id = oi.status_id
@@ -15,6 +15,19 @@ JOIN public.md_order_statuses as mos ON o.status_id = mos.id
 JOIN public.tables as t ON o.table_id = t.id
 WHERE o.id = sqlc.arg(id)::bigint;
 
+-- name: GetOrderStatusByID :one
+SELECT mos.code as "statusCode", mos.is_final as "isFinal"
+FROM public.orders as o
+JOIN public.md_order_statuses as mos ON o.status_id = mos.id
+WHERE o.id = sqlc.arg(id)::bigint;
+
+-- name: GetOrderStatusByIDForUpdate :one
+SELECT mos.code as "statusCode", mos.is_final as "isFinal"
+FROM public.orders as o
+JOIN public.md_order_statuses as mos ON o.status_id = mos.id
+WHERE o.id = sqlc.arg(id)::bigint
+    FOR UPDATE OF o;
+
 -- name: UpdateOrderStatus :exec
 UPDATE public.orders
 SET status_id = (SELECT id FROM public.md_order_statuses WHERE code = sqlc.arg(status_code)::text LIMIT 1)
@@ -46,6 +59,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at
 FROM public.orders o
          JOIN public.order_items oi ON oi.order_id = o.id
@@ -128,13 +147,19 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
          JOIN public.order_items oi ON oi.order_id = o.id
          JOIN public.md_order_statuses mos ON oi.status_id = mos.id
          JOIN public.tables t ON o.table_id = t.id
-WHERE o.id = sqlc.arg(order_id)::bigint
+WHERE o.id = sqlc.arg(order_id)::bigint AND oi.is_visible IS TRUE
 order by oi.id DESC;
 
 -- name: GetOrderWithItems :many
@@ -152,6 +177,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
@@ -185,6 +216,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
@@ -208,6 +245,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
@@ -239,6 +282,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at
 FROM public.orders o
          JOIN public.order_items oi ON oi.order_id = o.id
@@ -302,4 +351,32 @@ SELECT table_id FROM public.orders WHERE id = sqlc.arg(order_id)::bigint;
 select session_id as "sessionID" from public.orders where id = sqlc.arg(id)::bigint LIMIT 1;
 
 -- name: GetOrderIDBySessionID :one
-select id from public.orders where session_id = sqlc.arg(session_id)::uuid LIMIT 1;
\ No newline at end of file
+select id from public.orders where session_id = sqlc.arg(session_id)::uuid LIMIT 1;
+
+-- name: ListBillItemsByOrderID :many
+SELECT oi.id,
+       oi.product_id as "productID",
+       oi.product_name as "productName",
+       oi.product_name_en as "productNameEN",
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.price,
+       oi.quantity,
+       oi.discount_amount as "discountAmount",
+       (oi.price * oi.quantity - oi.discount_amount)::numeric as "amount",
+       mos.code as "statusCode",
+       oi.promotion_id as "promotionID",
+       pr.name as "promotionName",
+       pr.name_en as "promotionNameEN",
+       pr.promotion_type as "promotionType"
+FROM public.order_items oi
+         JOIN public.md_order_statuses mos ON oi.status_id = mos.id
+         LEFT JOIN public.promotions pr ON pr.id = oi.promotion_id
+WHERE oi.order_id = sqlc.arg(order_id)::bigint
+  AND oi.parent_order_item_id IS NULL
+  AND mos.code != 'CANCELLED'
+ORDER BY oi.created_at, oi.id;
+
+-- name: GetOrderBuyXGetYDiscount :one
+SELECT public.order_buy_x_get_y_discount(sqlc.arg(order_id)::bigint, FALSE)::numeric as "discount";
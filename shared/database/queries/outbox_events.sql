@@ -0,0 +1,66 @@
+-- name: NextOutboxEventSeq :one
+-- the key stays locked until the transaction ends, so events of one key get their seq in commit order
+SELECT nextval('public.outbox_events_seq_seq')::bigint AS seq
+FROM (SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(topic)::varchar || ':' || sqlc.arg(message_key)::varchar, 0))) key_lock;
+
+-- name: CreateOutboxEvent :exec
+INSERT INTO public.outbox_events (id, seq, topic, message_key, payload)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(seq)::bigint, sqlc.arg(topic)::varchar, sqlc.narg(message_key)::varchar, sqlc.arg(payload)::jsonb);
+
+-- name: ClaimOutboxEvents :many
+-- claims the pending events of each key in seq order. Only a key whose first pending event is due is claimed,
+-- and locking that event keeps other relays off the key, so a later event can never overtake an earlier one.
+WITH heads AS (SELECT e.id, e.topic, e.message_key, e.seq
+               FROM public.outbox_events e
+               WHERE e.sent_at IS NULL
+                 AND e.next_attempt_at <= NOW()
+                 AND NOT EXISTS (SELECT 1
+                                 FROM public.outbox_events earlier
+                                 WHERE earlier.topic = e.topic
+                                   AND earlier.message_key = e.message_key
+                                   AND earlier.seq < e.seq
+                                   AND earlier.sent_at IS NULL)
+               ORDER BY e.seq
+               LIMIT sqlc.arg(batch_size)::int FOR UPDATE SKIP LOCKED),
+     runs AS (SELECT e.id, row_number() OVER (ORDER BY e.seq) AS position
+              FROM heads h
+                       JOIN public.outbox_events e ON e.topic = h.topic
+                  AND (e.id = h.id OR e.message_key = h.message_key)
+                  AND e.seq >= h.seq
+              WHERE e.sent_at IS NULL)
+UPDATE public.outbox_events
+SET next_attempt_at = NOW() + make_interval(secs => sqlc.arg(lease_seconds)::int)
+WHERE id IN (SELECT r.id FROM runs r WHERE r.position <= sqlc.arg(batch_size)::int)
+RETURNING id, seq, topic, message_key, payload, attempts;
+
+-- name: MarkOutboxEventSent :exec
+UPDATE public.outbox_events
+SET sent_at    = NOW(),
+    last_error = NULL
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE public.outbox_events
+SET attempts        = attempts + 1,
+    last_error      = sqlc.arg(last_error)::text,
+    next_attempt_at = NOW() + make_interval(secs => LEAST(power(2, attempts + 1), sqlc.arg(max_backoff_seconds)::int)::int)
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: DeleteSentOutboxEvents :execrows
+DELETE FROM public.outbox_events
+WHERE sent_at < NOW() - make_interval(days => sqlc.arg(retention_days)::int);
+
+-- name: ListOutboxEventsByKey :many
+SELECT seq, payload
+FROM public.outbox_events
+WHERE topic = sqlc.arg(topic)::varchar
+  AND message_key = sqlc.arg(message_key)::varchar
+  AND seq > sqlc.arg(after_seq)::bigint
+ORDER BY seq
+LIMIT sqlc.arg(max_results)::int;
+
+-- name: GetLastOutboxEventIDByKey :one
+SELECT COALESCE(MAX(seq), 0)::bigint as "lastSeq"
+FROM public.outbox_events
+WHERE topic = sqlc.arg(topic)::varchar
+  AND message_key = sqlc.arg(message_key)::varchar;
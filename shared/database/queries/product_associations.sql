@@ -0,0 +1,75 @@
+-- name: TryLockProductAssociations :one
+SELECT pg_try_advisory_xact_lock(hashtext('product_associations'))::boolean as "locked";
+
+-- name: DeleteProductAssociations :exec
+DELETE FROM public.product_associations;
+
+-- name: RefreshProductAssociations :execrows
+WITH baskets AS (SELECT DISTINCT oi.order_id, oi.product_id
+                 FROM public.order_items oi
+                          JOIN public.md_order_statuses mos ON mos.id = oi.status_id
+                 WHERE oi.parent_order_item_id IS NULL
+                   AND mos.code <> 'CANCELLED'
+                   AND oi.created_at >= NOW() - make_interval(days => sqlc.arg(lookback_days)::int)),
+     total AS (SELECT COUNT(DISTINCT order_id)::numeric as orders FROM baskets),
+     product_orders AS (SELECT product_id, COUNT(*)::numeric as orders FROM baskets GROUP BY product_id),
+     pairs AS (SELECT a.product_id, b.product_id as associated_product_id, COUNT(*) as order_count
+               FROM baskets a
+                        JOIN baskets b ON b.order_id = a.order_id AND b.product_id <> a.product_id
+               GROUP BY a.product_id, b.product_id
+               HAVING COUNT(*) >= sqlc.arg(min_order_count)::int)
+INSERT INTO public.product_associations (product_id, associated_product_id, order_count, support, confidence, lift)
+SELECT p.product_id,
+       p.associated_product_id,
+       p.order_count,
+       p.order_count / t.orders,
+       p.order_count / pa.orders,
+       (p.order_count / pa.orders) / (pb.orders / t.orders)
+FROM pairs p
+         CROSS JOIN total t
+         JOIN product_orders pa ON pa.product_id = p.product_id
+         JOIN product_orders pb ON pb.product_id = p.associated_product_id;
+
+-- name: ListSessionOrderedProductIDs :many
+SELECT DISTINCT oi.product_id
+FROM public.order_items oi
+         JOIN public.orders o ON o.id = oi.order_id
+WHERE o.session_id = sqlc.arg(session_id)::uuid
+  AND oi.parent_order_item_id IS NULL;
+
+-- name: ListRecommendedProducts :many
+SELECT p.id,
+       p."name",
+       p.name_en,
+       p.categories,
+       c.name as "categoryName",
+       c.name_en as "categoryNameEN",
+       p.description,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
+       p.is_available,
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
+FROM (SELECT pa.associated_product_id, SUM(pa.confidence * LEAST(pa.lift, 10)) as score
+      FROM public.product_associations pa
+      WHERE pa.product_id = ANY (sqlc.arg(product_ids)::bigint[])
+        AND pa.lift > 1
+        AND NOT pa.associated_product_id = ANY (sqlc.arg(product_ids)::bigint[])
+      GROUP BY pa.associated_product_id) r
+         INNER JOIN public.products as p ON p.id = r.associated_product_id
+         INNER JOIN public.md_categories as c ON c.id = p.categories
+WHERE p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND (p.stock_quantity IS NULL OR p.stock_quantity > 0)
+  AND public.is_within_menu_schedule(p.id, p.categories, sqlc.arg(time_zone)::text)
+  AND (sqlc.narg(menu_version_id)::bigint IS NULL
+    OR p.id IN (SELECT (e.value ->> 'id')::bigint
+                FROM public.menu_versions v
+                         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') e
+                WHERE v.id = sqlc.narg(menu_version_id)::bigint))
+  AND (
+    sqlc.narg(category_id)::bigint[] IS NULL
+        OR array_length(sqlc.narg(category_id)::bigint[], 1) = 0
+        OR p.categories = ANY (sqlc.narg(category_id)::bigint[])
+    )
+ORDER BY r.score DESC, p.id
+LIMIT sqlc.arg(max_results)::int;
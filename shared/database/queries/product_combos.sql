@@ -0,0 +1,47 @@
+-- name: ListComboSlotsByProductIDs :many
+SELECT id, combo_product_id as "comboProductID", "name", name_en as "nameEN"
+FROM public.combo_slots
+WHERE combo_product_id = ANY(sqlc.arg(product_ids)::bigint[])
+  AND deleted_at IS NULL
+ORDER BY combo_product_id, sort_order, id;
+
+-- name: ListComboSlotOptionsBySlotIDs :many
+SELECT o.slot_id as "slotID",
+       o.product_id as "productID",
+       p."name",
+       p.name_en as "nameEN",
+       p.image_url as "imageURL",
+       o.price_delta as "priceDelta",
+       (p.is_available AND p.is_visible)::boolean as "isAvailable"
+FROM public.combo_slot_options o
+         JOIN public.products p ON p.id = o.product_id
+WHERE o.slot_id = ANY(sqlc.arg(slot_ids)::bigint[])
+ORDER BY o.slot_id, o.sort_order;
+
+-- name: DeleteComboSlotsExcept :exec
+UPDATE public.combo_slots
+SET deleted_at = NOW()
+WHERE combo_product_id = sqlc.arg(combo_product_id)::bigint
+  AND deleted_at IS NULL
+  AND NOT (id = ANY (sqlc.arg(keep_ids)::bigint[]));
+
+-- name: UpdateComboSlot :execrows
+UPDATE public.combo_slots
+SET "name"     = sqlc.arg(name)::varchar,
+    name_en    = sqlc.arg(name_en)::varchar,
+    sort_order = sqlc.arg(sort_order)::int,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND combo_product_id = sqlc.arg(combo_product_id)::bigint
+  AND deleted_at IS NULL;
+
+-- name: DeleteComboSlotOptionsBySlotID :exec
+DELETE FROM public.combo_slot_options WHERE slot_id = sqlc.arg(slot_id)::bigint;
+
+-- name: CreateComboSlot :exec
+INSERT INTO public.combo_slots (id, combo_product_id, "name", name_en, sort_order)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(combo_product_id)::bigint, sqlc.arg(name)::varchar, sqlc.arg(name_en)::varchar, sqlc.arg(sort_order)::int);
+
+-- name: CreateComboSlotOption :exec
+INSERT INTO public.combo_slot_options (slot_id, product_id, price_delta, sort_order)
+VALUES (sqlc.arg(slot_id)::bigint, sqlc.arg(product_id)::bigint, sqlc.arg(price_delta)::numeric, sqlc.arg(sort_order)::int);
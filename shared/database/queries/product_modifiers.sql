@@ -0,0 +1,59 @@
+-- name: ListModifierGroupsByProductIDs :many
+SELECT id, product_id as "productID", "name", name_en as "nameEN", min_selections as "minSelections", max_selections as "maxSelections"
+FROM public.product_modifier_groups
+WHERE product_id = ANY(sqlc.arg(product_ids)::bigint[])
+  AND deleted_at IS NULL
+ORDER BY product_id, sort_order, id;
+
+-- name: ListModifiersByGroupIDs :many
+SELECT id, group_id as "groupID", "name", name_en as "nameEN", price_delta as "priceDelta", is_available as "isAvailable"
+FROM public.product_modifiers
+WHERE group_id = ANY(sqlc.arg(group_ids)::bigint[])
+  AND deleted_at IS NULL
+ORDER BY group_id, sort_order, id;
+
+-- name: DeleteModifierGroupsExcept :exec
+UPDATE public.product_modifier_groups
+SET deleted_at = NOW()
+WHERE product_id = sqlc.arg(product_id)::bigint
+  AND deleted_at IS NULL
+  AND NOT (id = ANY (sqlc.arg(keep_ids)::bigint[]));
+
+-- name: DeleteModifiersExcept :exec
+UPDATE public.product_modifiers
+SET deleted_at = NOW()
+WHERE group_id = sqlc.arg(group_id)::bigint
+  AND deleted_at IS NULL
+  AND NOT (id = ANY (sqlc.arg(keep_ids)::bigint[]));
+
+-- name: UpdateModifierGroup :execrows
+UPDATE public.product_modifier_groups
+SET "name"         = sqlc.arg(name)::varchar,
+    name_en        = sqlc.arg(name_en)::varchar,
+    min_selections = sqlc.arg(min_selections)::int,
+    max_selections = sqlc.arg(max_selections)::int,
+    sort_order     = sqlc.arg(sort_order)::int,
+    updated_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND product_id = sqlc.arg(product_id)::bigint
+  AND deleted_at IS NULL;
+
+-- name: UpdateModifier :execrows
+UPDATE public.product_modifiers
+SET "name"       = sqlc.arg(name)::varchar,
+    name_en      = sqlc.arg(name_en)::varchar,
+    price_delta  = sqlc.arg(price_delta)::numeric,
+    is_available = sqlc.arg(is_available)::boolean,
+    sort_order   = sqlc.arg(sort_order)::int,
+    updated_at   = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND group_id = sqlc.arg(group_id)::bigint
+  AND deleted_at IS NULL;
+
+-- name: CreateModifierGroup :exec
+INSERT INTO public.product_modifier_groups (id, product_id, "name", name_en, min_selections, max_selections, sort_order)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(product_id)::bigint, sqlc.arg(name)::varchar, sqlc.arg(name_en)::varchar, sqlc.arg(min_selections)::int, sqlc.arg(max_selections)::int, sqlc.arg(sort_order)::int);
+
+-- name: CreateModifier :exec
+INSERT INTO public.product_modifiers (id, group_id, "name", name_en, price_delta, is_available, sort_order)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(group_id)::bigint, sqlc.arg(name)::varchar, sqlc.arg(name_en)::varchar, sqlc.arg(price_delta)::numeric, sqlc.arg(is_available)::boolean, sqlc.arg(sort_order)::int);
@@ -0,0 +1,34 @@
+-- name: ListProductPrices :many
+SELECT pp.id,
+       pp.price,
+       pp.effective_from as "effectiveFrom",
+       pp.created_at as "createdAt",
+       (pp.effective_from > NOW())::boolean as "isScheduled",
+       (pp.effective_from = (SELECT MAX(c.effective_from)
+                             FROM public.product_prices c
+                             WHERE c.product_id = pp.product_id
+                               AND c.effective_from <= NOW()))::boolean as "isCurrent"
+FROM public.product_prices pp
+WHERE pp.product_id = sqlc.arg(product_id)::bigint
+ORDER BY pp.effective_from DESC;
+
+-- name: GetProductPriceAt :one
+SELECT public.product_price_at(sqlc.arg(product_id)::bigint, sqlc.arg(price_at)::timestamptz)::numeric as "price";
+
+-- name: RecordProductPriceChange :exec
+INSERT INTO public.product_prices (id, product_id, price, effective_from, menu_version_id)
+SELECT sqlc.arg(id)::bigint, sqlc.arg(product_id)::bigint, sqlc.arg(price)::numeric,
+       COALESCE(sqlc.narg(effective_from)::timestamptz, NOW()), sqlc.narg(menu_version_id)::bigint
+WHERE public.product_price_at(sqlc.arg(product_id)::bigint, COALESCE(sqlc.narg(effective_from)::timestamptz, NOW())) IS DISTINCT FROM sqlc.arg(price)::numeric
+ON CONFLICT (product_id, effective_from) DO UPDATE SET price = EXCLUDED.price, menu_version_id = EXCLUDED.menu_version_id;
+
+-- name: CreateScheduledProductPrice :execrows
+INSERT INTO public.product_prices (id, product_id, price, effective_from)
+SELECT sqlc.arg(id)::bigint, sqlc.arg(product_id)::bigint, sqlc.arg(price)::numeric, sqlc.arg(effective_from)::timestamptz
+WHERE sqlc.arg(effective_from)::timestamptz > NOW();
+
+-- name: DeleteScheduledProductPrice :execrows
+DELETE FROM public.product_prices
+WHERE id = sqlc.arg(id)::bigint
+  AND product_id = sqlc.arg(product_id)::bigint
+  AND effective_from > NOW();
@@ -0,0 +1,24 @@
+-- name: ListSearchAliases :many
+SELECT id, alias, term
+FROM public.search_aliases
+ORDER BY alias;
+
+-- name: CreateSearchAlias :exec
+INSERT INTO public.search_aliases (id, alias, term)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(alias)::varchar, sqlc.arg(term)::varchar);
+
+-- name: DeleteSearchAlias :execrows
+DELETE FROM public.search_aliases WHERE id = sqlc.arg(id)::bigint;
+
+-- name: ListProductSearchKeywords :many
+SELECT keyword
+FROM public.product_search_keywords
+WHERE product_id = sqlc.arg(product_id)::bigint
+ORDER BY keyword;
+
+-- name: DeleteProductSearchKeywords :exec
+DELETE FROM public.product_search_keywords WHERE product_id = sqlc.arg(product_id)::bigint;
+
+-- name: CreateProductSearchKeyword :exec
+INSERT INTO public.product_search_keywords (product_id, keyword)
+VALUES (sqlc.arg(product_id)::bigint, sqlc.arg(keyword)::varchar);
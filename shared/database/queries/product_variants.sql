@@ -0,0 +1,29 @@
+-- name: ListVariantsByProductIDs :many
+SELECT id, product_id as "productID", "name", name_en as "nameEN", price, is_available as "isAvailable"
+FROM public.product_variants
+WHERE product_id = ANY(sqlc.arg(product_ids)::bigint[])
+  AND deleted_at IS NULL
+ORDER BY product_id, sort_order, id;
+
+-- name: DeleteVariantsExcept :exec
+UPDATE public.product_variants
+SET deleted_at = NOW()
+WHERE product_id = sqlc.arg(product_id)::bigint
+  AND deleted_at IS NULL
+  AND NOT (id = ANY (sqlc.arg(keep_ids)::bigint[]));
+
+-- name: UpdateVariant :execrows
+UPDATE public.product_variants
+SET "name"       = sqlc.arg(name)::varchar,
+    name_en      = sqlc.arg(name_en)::varchar,
+    price        = sqlc.arg(price)::numeric,
+    is_available = sqlc.arg(is_available)::boolean,
+    sort_order   = sqlc.arg(sort_order)::int,
+    updated_at   = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND product_id = sqlc.arg(product_id)::bigint
+  AND deleted_at IS NULL;
+
+-- name: CreateVariant :exec
+INSERT INTO public.product_variants (id, product_id, "name", name_en, price, is_available, sort_order)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(product_id)::bigint, sqlc.arg(name)::varchar, sqlc.arg(name_en)::varchar, sqlc.arg(price)::numeric, sqlc.arg(is_available)::boolean, sqlc.arg(sort_order)::int);
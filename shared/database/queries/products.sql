@@ -6,20 +6,35 @@ RETURNING id;
 
 -- name: UpdateProduct :exec
 UPDATE public.products
-SET "name"       = $2,
-    name_en      = $3,
-    categories   = $4,
-    description  = $5,
-    price        = $6,
-    is_available = $7,
-    image_url    = $8,
-    updated_at   = NOW()
+SET "name"                  = $2,
+    name_en                 = $3,
+    categories              = $4,
+    description             = $5,
+    price                   = $6,
+    is_available            = $7,
+    image_url               = $8,
+    sold_out_by_ingredients = FALSE,
+    updated_at              = NOW()
 WHERE id = $1;
 
 -- name: UpdateProductAvailability :exec
 UPDATE public.products
-SET is_available = $2,
-    updated_at   = NOW()
+SET is_available            = $2,
+    sold_out_by_ingredients = FALSE,
+    updated_at              = NOW()
+WHERE id = $1;
+
+-- name: UpdateProductImage :execrows
+UPDATE public.products
+SET image_url     = sqlc.arg(image_url)::text,
+    thumbnail_url = sqlc.arg(thumbnail_url)::text,
+    updated_at    = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: UpdateProductVisibility :exec
+UPDATE public.products
+SET is_visible = $2,
+    updated_at = NOW()
 WHERE id = $1;
 
 -- name: SearchProducts :many
@@ -30,25 +45,50 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
        p.is_available,
-       p.image_url
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.is_available IS TRUE AND p.is_visible IS TRUE
-  AND ((sqlc.narg(name)::varchar IS NULL OR p."name" ILIKE '%' || sqlc.narg(name)::varchar || '%') OR (sqlc.narg(name)::varchar IS NULL OR p.name_en ILIKE '%' || sqlc.narg(name)::varchar || '%'))
+WHERE p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND public.is_within_menu_schedule(p.id, p.categories, sqlc.arg(time_zone)::text)
+  AND (sqlc.narg(name)::varchar IS NULL OR public.product_search_score(p.id, p."name", p.name_en, sqlc.narg(name)::varchar) >= 0.3)
   AND (sqlc.narg(is_available)::boolean IS NULL OR p.is_available = sqlc.narg(is_available)::boolean)
+  AND (sqlc.narg(dietary_tags)::varchar[] IS NULL
+    OR array_length(sqlc.narg(dietary_tags)::varchar[], 1) IS NULL
+    OR (SELECT COUNT(DISTINCT t.code)
+        FROM public.product_dietary_tags pt
+                 JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+        WHERE pt.product_id = p.id
+          AND t.code = ANY (sqlc.narg(dietary_tags)::varchar[])) = array_length(sqlc.narg(dietary_tags)::varchar[], 1))
+  AND (sqlc.narg(exclude_allergens)::varchar[] IS NULL
+    OR NOT EXISTS (SELECT 1
+                   FROM public.product_dietary_tags pt
+                            JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+                   WHERE pt.product_id = p.id
+                     AND t.code = ANY (sqlc.narg(exclude_allergens)::varchar[])))
+  AND (sqlc.narg(menu_version_id)::bigint IS NULL
+    OR p.id IN (SELECT (e.value ->> 'id')::bigint
+                FROM public.menu_versions v
+                         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') e
+                WHERE v.id = sqlc.narg(menu_version_id)::bigint))
   AND (
     sqlc.narg(category_id)::bigint[] IS NULL
         OR array_length(sqlc.narg(category_id)::bigint[], 1) = 0
         OR p.categories = ANY (sqlc.narg(category_id)::bigint[])
     )
 ORDER BY CASE
+             WHEN sqlc.narg(name)::varchar IS NULL THEN 0
+             ELSE public.product_search_score(p.id, p."name", p.name_en, sqlc.narg(name)::varchar)
+             END DESC,
+         CASE
              WHEN sqlc.arg(order_by_type)::text = 'asc' THEN
                  CASE
                      WHEN sqlc.arg(order_by)::text = 'id' THEN p.id::text
                      WHEN sqlc.arg(order_by)::text = 'name' THEN p."name"
-                     WHEN sqlc.arg(order_by)::text = 'price' THEN p.price::text
+                     WHEN sqlc.arg(order_by)::text = 'price' THEN COALESCE(public.product_price_at(p.id, NOW()), p.price)::text
                      ELSE c.sort_order::text
                      END
              END,
@@ -57,7 +97,7 @@ ORDER BY CASE
                  CASE
                      WHEN sqlc.arg(order_by)::text = 'id' THEN p.id::text
                      WHEN sqlc.arg(order_by)::text = 'name' THEN p."name"
-                     WHEN sqlc.arg(order_by)::text = 'price' THEN p.price::text
+                     WHEN sqlc.arg(order_by)::text = 'price' THEN COALESCE(public.product_price_at(p.id, NOW()), p.price)::text
                      ELSE c.sort_order::text
                      END
              END DESC
@@ -67,9 +107,28 @@ OFFSET sqlc.arg(page_number) LIMIT sqlc.arg(page_size);
 SELECT COUNT(*)
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.is_available IS TRUE AND p.is_visible IS TRUE
-  AND ((sqlc.narg(name)::varchar IS NULL OR p."name" ILIKE '%' || sqlc.narg(name)::varchar || '%') OR (sqlc.narg(name)::varchar IS NULL OR p.name_en ILIKE '%' || sqlc.narg(name)::varchar || '%'))
+WHERE p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND public.is_within_menu_schedule(p.id, p.categories, sqlc.arg(time_zone)::text)
+  AND (sqlc.narg(name)::varchar IS NULL OR public.product_search_score(p.id, p."name", p.name_en, sqlc.narg(name)::varchar) >= 0.3)
   AND (sqlc.narg(is_available)::boolean IS NULL OR p.is_available = sqlc.narg(is_available)::boolean)
+  AND (sqlc.narg(dietary_tags)::varchar[] IS NULL
+    OR array_length(sqlc.narg(dietary_tags)::varchar[], 1) IS NULL
+    OR (SELECT COUNT(DISTINCT t.code)
+        FROM public.product_dietary_tags pt
+                 JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+        WHERE pt.product_id = p.id
+          AND t.code = ANY (sqlc.narg(dietary_tags)::varchar[])) = array_length(sqlc.narg(dietary_tags)::varchar[], 1))
+  AND (sqlc.narg(exclude_allergens)::varchar[] IS NULL
+    OR NOT EXISTS (SELECT 1
+                   FROM public.product_dietary_tags pt
+                            JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+                   WHERE pt.product_id = p.id
+                     AND t.code = ANY (sqlc.narg(exclude_allergens)::varchar[])))
+  AND (sqlc.narg(menu_version_id)::bigint IS NULL
+    OR p.id IN (SELECT (e.value ->> 'id')::bigint
+                FROM public.menu_versions v
+                         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') e
+                WHERE v.id = sqlc.narg(menu_version_id)::bigint))
   AND (
     sqlc.narg(category_id)::bigint[] IS NULL
         OR array_length(sqlc.narg(category_id)::bigint[], 1) = 0
@@ -84,12 +143,12 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, sqlc.arg(price_at)::timestamptz), p.price)::numeric as "price",
        p.is_available,
        p.image_url
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.id = sqlc.arg(id)::bigint AND p.is_available IS TRUE LIMIT 1;
+WHERE p.id = sqlc.arg(id)::bigint AND p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE LIMIT 1;
 
 -- name: GetProductAvailableByID :one
 SELECT p.id,
@@ -99,16 +158,73 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
        p.is_available,
-       p.image_url
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
+FROM public.products as p
+         INNER JOIN public.md_categories as c ON c.id = p.categories
+WHERE p.id = sqlc.arg(id)::bigint AND p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND public.is_within_menu_schedule(p.id, p.categories, sqlc.arg(time_zone)::text) LIMIT 1;
+
+-- name: GetProductDetailByID :one
+SELECT p.id,
+       p."name",
+       p.name_en,
+       p.categories,
+       c.name as "categoryName",
+       c.name_en as "categoryNameEN",
+       p.description,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
+       p.is_available,
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.id = sqlc.arg(id)::bigint AND p.is_available IS TRUE LIMIT 1;
+WHERE p.id = sqlc.arg(id)::bigint LIMIT 1;
+
+-- name: UpdateProductStock :execrows
+UPDATE public.products
+SET stock_quantity = sqlc.narg(stock_quantity)::int,
+    is_available   = CASE
+                         WHEN sqlc.narg(stock_quantity)::int = 0 THEN FALSE
+                         WHEN stock_quantity = 0 AND NOT sold_out_by_ingredients THEN TRUE
+                         ELSE is_available
+        END,
+    updated_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: DecreaseProductStock :execrows
+UPDATE public.products
+SET stock_quantity = stock_quantity - sqlc.arg(quantity)::int,
+    is_available   = CASE WHEN stock_quantity - sqlc.arg(quantity)::int = 0 THEN FALSE ELSE is_available END,
+    updated_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND stock_quantity IS NOT NULL
+  AND stock_quantity >= sqlc.arg(quantity)::int;
+
+-- name: IncreaseProductStock :exec
+UPDATE public.products
+SET stock_quantity = stock_quantity + sqlc.arg(quantity)::int,
+    is_available   = CASE WHEN stock_quantity = 0 AND NOT sold_out_by_ingredients THEN TRUE ELSE is_available END,
+    updated_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint
+  AND stock_quantity IS NOT NULL;
+
+-- name: IsProductStockTracked :one
+SELECT (stock_quantity IS NOT NULL)::boolean as "isTracked" FROM public.products WHERE id = sqlc.arg(id)::bigint;
+
+-- name: IsProductSoldOut :one
+SELECT count(*) > 0 as "isSoldOut" FROM public.products WHERE id = sqlc.arg(id)::bigint AND stock_quantity = 0;
 
 -- name: IsProductExists :one
 SELECT count(*) > 0 as "isExists" FROM public.products WHERE id = $1;
 
+-- name: ListAvailableProductIDs :many
+SELECT id FROM public.products WHERE id = ANY (sqlc.arg(ids)::bigint[]) AND is_available IS TRUE AND is_visible IS TRUE;
+
 -- name: ListProductTimeExtension :many
 SELECT p.id,
        p."name",
@@ -117,9 +233,26 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
        p.is_available,
        p.image_url
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.is_available IS TRUE AND c.code = 'TIME_EXTENSION';
\ No newline at end of file
+WHERE p.is_available IS TRUE AND c.code = 'TIME_EXTENSION';
+
+-- name: ListProductsForMenuSnapshot :many
+SELECT p.id,
+       p."name",
+       p.name_en,
+       p.categories,
+       c.name as "categoryName",
+       c.name_en as "categoryNameEN",
+       p.description,
+       p.price,
+       p.is_available,
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
+FROM public.products as p
+         INNER JOIN public.md_categories as c ON c.id = p.categories
+ORDER BY c.sort_order, p.id;
@@ -0,0 +1,56 @@
+-- name: ListPromotions :many
+SELECT id,
+       "name",
+       name_en as "nameEN",
+       promotion_type as "promotionType",
+       discount_value as "discountValue",
+       buy_quantity as "buyQuantity",
+       free_quantity as "freeQuantity",
+       product_id as "productID",
+       category_id as "categoryID",
+       starts_at as "startsAt",
+       ends_at as "endsAt",
+       days_of_week as "daysOfWeek",
+       start_time as "startTime",
+       end_time as "endTime",
+       is_active as "isActive"
+FROM public.promotions
+ORDER BY created_at DESC, id DESC;
+
+-- name: CreatePromotion :exec
+INSERT INTO public.promotions
+(id, "name", name_en, promotion_type, discount_value, buy_quantity, free_quantity, product_id, category_id, starts_at, ends_at, days_of_week, start_time, end_time, is_active)
+VALUES (sqlc.arg(id)::bigint, sqlc.arg(name)::varchar, sqlc.arg(name_en)::varchar, sqlc.arg(promotion_type)::varchar, sqlc.arg(discount_value)::numeric,
+        sqlc.narg(buy_quantity)::int, sqlc.narg(free_quantity)::int, sqlc.narg(product_id)::bigint, sqlc.narg(category_id)::bigint,
+        sqlc.narg(starts_at)::timestamptz, sqlc.narg(ends_at)::timestamptz, sqlc.narg(days_of_week)::smallint[],
+        sqlc.narg(start_time)::time, sqlc.narg(end_time)::time, sqlc.arg(is_active)::boolean);
+
+-- name: UpdatePromotion :execrows
+UPDATE public.promotions
+SET "name"         = sqlc.arg(name)::varchar,
+    name_en        = sqlc.arg(name_en)::varchar,
+    promotion_type = sqlc.arg(promotion_type)::varchar,
+    discount_value = sqlc.arg(discount_value)::numeric,
+    buy_quantity   = sqlc.narg(buy_quantity)::int,
+    free_quantity  = sqlc.narg(free_quantity)::int,
+    product_id     = sqlc.narg(product_id)::bigint,
+    category_id    = sqlc.narg(category_id)::bigint,
+    starts_at      = sqlc.narg(starts_at)::timestamptz,
+    ends_at        = sqlc.narg(ends_at)::timestamptz,
+    days_of_week   = sqlc.narg(days_of_week)::smallint[],
+    start_time     = sqlc.narg(start_time)::time,
+    end_time       = sqlc.narg(end_time)::time,
+    is_active      = sqlc.arg(is_active)::boolean,
+    updated_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: ListActivePromotionsForProduct :many
+SELECT id,
+       promotion_type as "promotionType",
+       discount_value as "discountValue",
+       buy_quantity as "buyQuantity",
+       free_quantity as "freeQuantity"
+FROM public.promotions
+WHERE (product_id = sqlc.arg(product_id)::bigint OR category_id = sqlc.arg(category_id)::bigint)
+  AND public.is_promotion_active(id, sqlc.arg(at)::timestamptz, sqlc.arg(time_zone)::text)
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,56 @@
+-- name: CreateTableServiceRequest :exec
+INSERT INTO public.table_service_requests (id, session_id, table_id, order_id, request_type, reason, previous_table_status_id)
+VALUES (sqlc.arg(id)::bigint,
+        sqlc.arg(session_id)::uuid,
+        sqlc.arg(table_id)::bigint,
+        sqlc.narg(order_id)::bigint,
+        sqlc.arg(request_type)::varchar,
+        sqlc.narg(reason)::varchar,
+        sqlc.arg(previous_table_status_id)::bigint);
+
+-- name: GetOpenTableServiceRequestID :one
+SELECT id
+FROM public.table_service_requests
+WHERE session_id = sqlc.arg(session_id)::uuid
+  AND request_type = sqlc.arg(request_type)::varchar
+  AND status <> 'RESOLVED';
+
+-- name: GetTableServiceRequestForUpdate :one
+SELECT id,
+       table_id                 as "tableID",
+       request_type             as "requestType",
+       status,
+       previous_table_status_id as "previousTableStatusID"
+FROM public.table_service_requests
+WHERE id = sqlc.arg(id)::bigint
+    FOR UPDATE;
+
+-- name: UpdateTableServiceRequestAcknowledged :exec
+UPDATE public.table_service_requests
+SET status          = 'ACKNOWLEDGED',
+    acknowledged_at = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: UpdateTableServiceRequestResolved :exec
+UPDATE public.table_service_requests
+SET status          = 'RESOLVED',
+    acknowledged_at = COALESCE(acknowledged_at, NOW()),
+    resolved_at     = NOW()
+WHERE id = sqlc.arg(id)::bigint;
+
+-- name: ListTableServiceRequests :many
+SELECT r.id,
+       r.table_id        as "tableID",
+       t.table_number    as "tableNumber",
+       r.order_id        as "orderID",
+       r.request_type    as "requestType",
+       r.reason,
+       r.status,
+       r.created_at      as "createdAt",
+       r.acknowledged_at as "acknowledgedAt",
+       r.resolved_at     as "resolvedAt"
+FROM public.table_service_requests r
+         JOIN public.tables t ON t.id = r.table_id
+WHERE (sqlc.narg(id)::bigint IS NULL OR r.id = sqlc.narg(id)::bigint)
+  AND (NOT sqlc.arg(open_only)::bool OR r.status <> 'RESOLVED')
+ORDER BY r.created_at, r.id;
@@ -195,4 +195,17 @@ OFFSET sqlc.arg(page_number) LIMIT sqlc.arg(page_size);
 SELECT COUNT(*) as "totalItems"
 FROM public.tables t
          INNER JOIN public.md_table_statuses s ON t.status_id = s.id
-WHERE t.seats >= sqlc.arg(number_of_people)::integer AND s.code = 'AVAILABLE';
\ No newline at end of file
+WHERE t.seats >= sqlc.arg(number_of_people)::integer AND s.code = 'AVAILABLE';
+
+-- name: UpdateTablesStatusCallWaiter :exec
+UPDATE public.tables
+SET status_id=(select id from public.md_table_statuses WHERE code = 'CALL_WAITER'), updated_at = NOW()
+WHERE id=sqlc.arg(id)::bigint;
+
+-- name: GetTableStatusForUpdate :one
+SELECT t.status_id as "statusID",
+       s.code      as "statusCode"
+FROM public.tables t
+         JOIN public.md_table_statuses s ON s.id = t.status_id
+WHERE t.id = sqlc.arg(id)::bigint
+    FOR UPDATE OF t;
@@ -0,0 +1,24 @@
+-- name: ListTranslations :many
+SELECT entity_type as "entityType", entity_id as "entityID", field, locale, value
+FROM public.translations
+WHERE entity_id = ANY(sqlc.arg(entity_ids)::bigint[])
+  AND locale = ANY(sqlc.arg(locales)::varchar[]);
+
+-- name: ListTranslationsByEntity :many
+SELECT field, locale, value
+FROM public.translations
+WHERE entity_type = sqlc.arg(entity_type)::varchar
+  AND entity_id = sqlc.arg(entity_id)::bigint
+ORDER BY locale, field;
+
+-- name: DeleteTranslationsByEntity :exec
+DELETE FROM public.translations
+WHERE entity_type = sqlc.arg(entity_type)::varchar
+  AND entity_id = sqlc.arg(entity_id)::bigint;
+
+-- name: CreateTranslation :exec
+INSERT INTO public.translations (entity_type, entity_id, field, locale, value)
+VALUES (sqlc.arg(entity_type)::varchar, sqlc.arg(entity_id)::bigint, sqlc.arg(field)::varchar, sqlc.arg(locale)::varchar, sqlc.arg(value)::text);
+
+-- name: ListTranslationLocales :many
+SELECT DISTINCT locale FROM public.translations ORDER BY locale;
@@ -41,6 +41,16 @@ func (r iteratorForCreateOrderItems) Values() ([]interface{}, error) {
 		r.rows[0].CreatedAt,
 		r.rows[0].ProductImageUrl,
 		r.rows[0].IsVisible,
+		r.rows[0].Modifiers,
+		r.rows[0].VariantID,
+		r.rows[0].VariantName,
+		r.rows[0].VariantNameEn,
+		r.rows[0].ParentOrderItemID,
+		r.rows[0].Allergens,
+		r.rows[0].PromotionID,
+		r.rows[0].DiscountAmount,
+		r.rows[0].PromotionBuyQuantity,
+		r.rows[0].PromotionFreeQuantity,
 	}, nil
 }
 
@@ -49,5 +59,5 @@ func (r iteratorForCreateOrderItems) Err() error {
 }
 
 func (q *Queries) CreateOrderItems(ctx context.Context, arg []CreateOrderItemsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"public", "order_items"}, []string{"id", "order_id", "product_id", "status_id", "product_name", "product_name_en", "price", "quantity", "note", "created_at", "product_image_url", "is_visible"}, &iteratorForCreateOrderItems{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"public", "order_items"}, []string{"id", "order_id", "product_id", "status_id", "product_name", "product_name_en", "price", "quantity", "note", "created_at", "product_image_url", "is_visible", "modifiers", "variant_id", "variant_name", "variant_name_en", "parent_order_item_id", "allergens", "promotion_id", "discount_amount", "promotion_buy_quantity", "promotion_free_quantity"}, &iteratorForCreateOrderItems{rows: arg})
 }
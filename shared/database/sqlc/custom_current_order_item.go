@@ -64,6 +64,30 @@ func (q *GetOrderWithItemsRow) GetNote() pgtype.Text {
 	return q.Note
 }
 
+func (q *GetOrderWithItemsRow) GetModifiers() []byte {
+	return q.Modifiers
+}
+
+func (q *GetOrderWithItemsRow) GetAllergens() []byte {
+	return q.Allergens
+}
+
+func (q *GetOrderWithItemsRow) GetVariantID() pgtype.Int8 {
+	return q.VariantID
+}
+
+func (q *GetOrderWithItemsRow) GetVariantName() pgtype.Text {
+	return q.VariantName
+}
+
+func (q *GetOrderWithItemsRow) GetVariantNameEN() pgtype.Text {
+	return q.VariantNameEN
+}
+
+func (q *GetOrderWithItemsRow) GetParentOrderItemID() pgtype.Int8 {
+	return q.ParentOrderItemID
+}
+
 func (q *GetOrderWithItemsRow) GetCreatedAt() pgtype.Timestamptz {
 	return q.CreatedAt
 }
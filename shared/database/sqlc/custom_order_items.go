@@ -122,6 +122,30 @@ func (q *GetOrderWithItemsGroupIDRow) GetNote() pgtype.Text {
 	return q.Note
 }
 
+func (q *GetOrderWithItemsGroupIDRow) GetModifiers() []byte {
+	return q.Modifiers
+}
+
+func (q *GetOrderWithItemsGroupIDRow) GetAllergens() []byte {
+	return q.Allergens
+}
+
+func (q *GetOrderWithItemsGroupIDRow) GetVariantID() pgtype.Int8 {
+	return q.VariantID
+}
+
+func (q *GetOrderWithItemsGroupIDRow) GetVariantName() pgtype.Text {
+	return q.VariantName
+}
+
+func (q *GetOrderWithItemsGroupIDRow) GetVariantNameEN() pgtype.Text {
+	return q.VariantNameEN
+}
+
+func (q *GetOrderWithItemsGroupIDRow) GetParentOrderItemID() pgtype.Int8 {
+	return q.ParentOrderItemID
+}
+
 func (q *GetOrderWithItemsGroupIDRow) GetCreatedAt() pgtype.Timestamptz {
 	return q.CreatedAt
 }
@@ -188,6 +212,30 @@ func (q *SearchOrderItemsRow) GetNote() pgtype.Text {
 	return q.Note
 }
 
+func (q *SearchOrderItemsRow) GetModifiers() []byte {
+	return q.Modifiers
+}
+
+func (q *SearchOrderItemsRow) GetAllergens() []byte {
+	return q.Allergens
+}
+
+func (q *SearchOrderItemsRow) GetVariantID() pgtype.Int8 {
+	return q.VariantID
+}
+
+func (q *SearchOrderItemsRow) GetVariantName() pgtype.Text {
+	return q.VariantName
+}
+
+func (q *SearchOrderItemsRow) GetVariantNameEN() pgtype.Text {
+	return q.VariantNameEN
+}
+
+func (q *SearchOrderItemsRow) GetParentOrderItemID() pgtype.Int8 {
+	return q.ParentOrderItemID
+}
+
 func (q *SearchOrderItemsRow) GetCreatedAt() pgtype.Timestamptz {
 	return q.CreatedAt
 }
@@ -254,6 +302,30 @@ func (q *SearchOrderItemsIsNotFinalRow) GetNote() pgtype.Text {
 	return q.Note
 }
 
+func (q *SearchOrderItemsIsNotFinalRow) GetModifiers() []byte {
+	return q.Modifiers
+}
+
+func (q *SearchOrderItemsIsNotFinalRow) GetAllergens() []byte {
+	return q.Allergens
+}
+
+func (q *SearchOrderItemsIsNotFinalRow) GetVariantID() pgtype.Int8 {
+	return q.VariantID
+}
+
+func (q *SearchOrderItemsIsNotFinalRow) GetVariantName() pgtype.Text {
+	return q.VariantName
+}
+
+func (q *SearchOrderItemsIsNotFinalRow) GetVariantNameEN() pgtype.Text {
+	return q.VariantNameEN
+}
+
+func (q *SearchOrderItemsIsNotFinalRow) GetParentOrderItemID() pgtype.Int8 {
+	return q.ParentOrderItemID
+}
+
 func (q *SearchOrderItemsIsNotFinalRow) GetCreatedAt() pgtype.Timestamptz {
 	return q.CreatedAt
 }
@@ -320,6 +392,30 @@ func (q *GetOrderWithItemsByIDRow) GetNote() pgtype.Text {
 	return q.Note
 }
 
+func (q *GetOrderWithItemsByIDRow) GetModifiers() []byte {
+	return q.Modifiers
+}
+
+func (q *GetOrderWithItemsByIDRow) GetAllergens() []byte {
+	return q.Allergens
+}
+
+func (q *GetOrderWithItemsByIDRow) GetVariantID() pgtype.Int8 {
+	return q.VariantID
+}
+
+func (q *GetOrderWithItemsByIDRow) GetVariantName() pgtype.Text {
+	return q.VariantName
+}
+
+func (q *GetOrderWithItemsByIDRow) GetVariantNameEN() pgtype.Text {
+	return q.VariantNameEN
+}
+
+func (q *GetOrderWithItemsByIDRow) GetParentOrderItemID() pgtype.Int8 {
+	return q.ParentOrderItemID
+}
+
 func (q *GetOrderWithItemsByIDRow) GetCreatedAt() pgtype.Timestamptz {
 	return q.CreatedAt
 }
@@ -386,6 +482,30 @@ func (q *GetOrderItemsByOrderIDRow) GetNote() pgtype.Text {
 	return q.Note
 }
 
+func (q *GetOrderItemsByOrderIDRow) GetModifiers() []byte {
+	return q.Modifiers
+}
+
+func (q *GetOrderItemsByOrderIDRow) GetAllergens() []byte {
+	return q.Allergens
+}
+
+func (q *GetOrderItemsByOrderIDRow) GetVariantID() pgtype.Int8 {
+	return q.VariantID
+}
+
+func (q *GetOrderItemsByOrderIDRow) GetVariantName() pgtype.Text {
+	return q.VariantName
+}
+
+func (q *GetOrderItemsByOrderIDRow) GetVariantNameEN() pgtype.Text {
+	return q.VariantNameEN
+}
+
+func (q *GetOrderItemsByOrderIDRow) GetParentOrderItemID() pgtype.Int8 {
+	return q.ParentOrderItemID
+}
+
 func (q *GetOrderItemsByOrderIDRow) GetCreatedAt() pgtype.Timestamptz {
 	return q.CreatedAt
 }
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"food-story/pkg/utils"
+	"food-story/shared/model"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (q *ListTableServiceRequestsRow) ToModel() (*model.TableServiceRequest, error) {
+	createdAt, err := utils.PgTimestampToThaiISO8601(q.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	acknowledgedAt, err := pgTimestampToThaiISO8601Ptr(q.AcknowledgedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedAt, err := pgTimestampToThaiISO8601Ptr(q.ResolvedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TableServiceRequest{
+		ID:             q.ID,
+		TableID:        q.TableID,
+		TableNumber:    q.TableNumber,
+		OrderID:        utils.PgInt8ToInt64Ptr(q.OrderID),
+		RequestType:    q.RequestType,
+		Reason:         utils.PgTextToStringPtr(q.Reason),
+		Status:         q.Status,
+		CreatedAt:      createdAt,
+		AcknowledgedAt: acknowledgedAt,
+		ResolvedAt:     resolvedAt,
+	}, nil
+}
+
+func pgTimestampToThaiISO8601Ptr(ts pgtype.Timestamptz) (*string, error) {
+	if !ts.Valid {
+		return nil, nil
+	}
+
+	value, err := utils.PgTimestampToThaiISO8601(ts)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
@@ -0,0 +1,120 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: dietary_tags.sql
+
+package database
+
+import (
+	"context"
+)
+
+const createProductDietaryTags = `-- name: CreateProductDietaryTags :exec
+INSERT INTO public.product_dietary_tags (product_id, tag_id)
+SELECT $1::bigint, t.id
+FROM public.md_dietary_tags t
+WHERE t.code = ANY($2::varchar[])
+`
+
+type CreateProductDietaryTagsParams struct {
+	ProductID int64    `json:"product_id"`
+	Codes     []string `json:"codes"`
+}
+
+func (q *Queries) CreateProductDietaryTags(ctx context.Context, arg CreateProductDietaryTagsParams) error {
+	_, err := q.db.Exec(ctx, createProductDietaryTags, arg.ProductID, arg.Codes)
+	return err
+}
+
+const deleteProductDietaryTags = `-- name: DeleteProductDietaryTags :exec
+DELETE FROM public.product_dietary_tags WHERE product_id = $1::bigint
+`
+
+func (q *Queries) DeleteProductDietaryTags(ctx context.Context, productID int64) error {
+	_, err := q.db.Exec(ctx, deleteProductDietaryTags, productID)
+	return err
+}
+
+const listDietaryTags = `-- name: ListDietaryTags :many
+SELECT code, "name", name_en as "nameEN", is_allergen as "isAllergen"
+FROM public.md_dietary_tags
+ORDER BY sort_order
+`
+
+type ListDietaryTagsRow struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NameEN     string `json:"nameEN"`
+	IsAllergen bool   `json:"isAllergen"`
+}
+
+func (q *Queries) ListDietaryTags(ctx context.Context) ([]*ListDietaryTagsRow, error) {
+	rows, err := q.db.Query(ctx, listDietaryTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListDietaryTagsRow{}
+	for rows.Next() {
+		var i ListDietaryTagsRow
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.NameEN,
+			&i.IsAllergen,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDietaryTagsByProductIDs = `-- name: ListDietaryTagsByProductIDs :many
+SELECT pt.product_id as "productID",
+       t.code,
+       t."name",
+       t.name_en as "nameEN",
+       t.is_allergen as "isAllergen"
+FROM public.product_dietary_tags pt
+         JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+WHERE pt.product_id = ANY($1::bigint[])
+ORDER BY pt.product_id, t.sort_order
+`
+
+type ListDietaryTagsByProductIDsRow struct {
+	ProductID  int64  `json:"productID"`
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NameEN     string `json:"nameEN"`
+	IsAllergen bool   `json:"isAllergen"`
+}
+
+func (q *Queries) ListDietaryTagsByProductIDs(ctx context.Context, productIds []int64) ([]*ListDietaryTagsByProductIDsRow, error) {
+	rows, err := q.db.Query(ctx, listDietaryTagsByProductIDs, productIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListDietaryTagsByProductIDsRow{}
+	for rows.Next() {
+		var i ListDietaryTagsByProductIDsRow
+		if err := rows.Scan(
+			&i.ProductID,
+			&i.Code,
+			&i.Name,
+			&i.NameEN,
+			&i.IsAllergen,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,417 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: ingredients.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const adjustIngredientStock = `-- name: AdjustIngredientStock :execrows
+UPDATE public.ingredients
+SET stock_quantity = stock_quantity + $1::numeric,
+    updated_at     = NOW()
+WHERE id = $2::bigint
+  AND stock_quantity + $1::numeric >= 0
+`
+
+type AdjustIngredientStockParams struct {
+	Quantity pgtype.Numeric `json:"quantity"`
+	ID       int64          `json:"id"`
+}
+
+func (q *Queries) AdjustIngredientStock(ctx context.Context, arg AdjustIngredientStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, adjustIngredientStock, arg.Quantity, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createIngredient = `-- name: CreateIngredient :one
+INSERT INTO public.ingredients (id, "name", name_en, unit, low_stock_threshold)
+VALUES ($1::bigint, $2::varchar, $3::varchar, $4::varchar, $5::numeric)
+RETURNING id
+`
+
+type CreateIngredientParams struct {
+	ID                int64          `json:"id"`
+	Name              string         `json:"name"`
+	NameEn            string         `json:"name_en"`
+	Unit              string         `json:"unit"`
+	LowStockThreshold pgtype.Numeric `json:"low_stock_threshold"`
+}
+
+func (q *Queries) CreateIngredient(ctx context.Context, arg CreateIngredientParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createIngredient,
+		arg.ID,
+		arg.Name,
+		arg.NameEn,
+		arg.Unit,
+		arg.LowStockThreshold,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createIngredientMovement = `-- name: CreateIngredientMovement :exec
+INSERT INTO public.ingredient_stock_movements (id, ingredient_id, order_item_id, quantity, reason, note)
+VALUES ($1::bigint, $2::bigint, $3::bigint, $4::numeric, $5::varchar, $6::text)
+`
+
+type CreateIngredientMovementParams struct {
+	ID           int64          `json:"id"`
+	IngredientID int64          `json:"ingredient_id"`
+	OrderItemID  pgtype.Int8    `json:"order_item_id"`
+	Quantity     pgtype.Numeric `json:"quantity"`
+	Reason       string         `json:"reason"`
+	Note         pgtype.Text    `json:"note"`
+}
+
+func (q *Queries) CreateIngredientMovement(ctx context.Context, arg CreateIngredientMovementParams) error {
+	_, err := q.db.Exec(ctx, createIngredientMovement,
+		arg.ID,
+		arg.IngredientID,
+		arg.OrderItemID,
+		arg.Quantity,
+		arg.Reason,
+		arg.Note,
+	)
+	return err
+}
+
+const createProductIngredient = `-- name: CreateProductIngredient :exec
+INSERT INTO public.product_ingredients (product_id, ingredient_id, quantity)
+VALUES ($1::bigint, $2::bigint, $3::numeric)
+`
+
+type CreateProductIngredientParams struct {
+	ProductID    int64          `json:"product_id"`
+	IngredientID int64          `json:"ingredient_id"`
+	Quantity     pgtype.Numeric `json:"quantity"`
+}
+
+func (q *Queries) CreateProductIngredient(ctx context.Context, arg CreateProductIngredientParams) error {
+	_, err := q.db.Exec(ctx, createProductIngredient, arg.ProductID, arg.IngredientID, arg.Quantity)
+	return err
+}
+
+const deleteProductIngredients = `-- name: DeleteProductIngredients :exec
+DELETE FROM public.product_ingredients WHERE product_id = $1::bigint
+`
+
+func (q *Queries) DeleteProductIngredients(ctx context.Context, productID int64) error {
+	_, err := q.db.Exec(ctx, deleteProductIngredients, productID)
+	return err
+}
+
+const isIngredientExists = `-- name: IsIngredientExists :one
+SELECT count(*) > 0 as "isExists" FROM public.ingredients WHERE id = $1
+`
+
+func (q *Queries) IsIngredientExists(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isIngredientExists, id)
+	var isExists bool
+	err := row.Scan(&isExists)
+	return isExists, err
+}
+
+const listIngredientMovementsByOrderItemID = `-- name: ListIngredientMovementsByOrderItemID :many
+SELECT ingredient_id as "ingredientID", SUM(quantity)::numeric as "quantity"
+FROM public.ingredient_stock_movements
+WHERE order_item_id = $1::bigint
+GROUP BY ingredient_id
+`
+
+type ListIngredientMovementsByOrderItemIDRow struct {
+	IngredientID int64          `json:"ingredientID"`
+	Quantity     pgtype.Numeric `json:"quantity"`
+}
+
+func (q *Queries) ListIngredientMovementsByOrderItemID(ctx context.Context, orderItemID int64) ([]*ListIngredientMovementsByOrderItemIDRow, error) {
+	rows, err := q.db.Query(ctx, listIngredientMovementsByOrderItemID, orderItemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListIngredientMovementsByOrderItemIDRow{}
+	for rows.Next() {
+		var i ListIngredientMovementsByOrderItemIDRow
+		if err := rows.Scan(&i.IngredientID, &i.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIngredients = `-- name: ListIngredients :many
+SELECT id, "name", name_en as "nameEN", unit, stock_quantity as "stockQuantity", low_stock_threshold as "lowStockThreshold"
+FROM public.ingredients
+ORDER BY name_en
+`
+
+type ListIngredientsRow struct {
+	ID                int64          `json:"id"`
+	Name              string         `json:"name"`
+	NameEN            string         `json:"nameEN"`
+	Unit              string         `json:"unit"`
+	StockQuantity     pgtype.Numeric `json:"stockQuantity"`
+	LowStockThreshold pgtype.Numeric `json:"lowStockThreshold"`
+}
+
+func (q *Queries) ListIngredients(ctx context.Context) ([]*ListIngredientsRow, error) {
+	rows, err := q.db.Query(ctx, listIngredients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListIngredientsRow{}
+	for rows.Next() {
+		var i ListIngredientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.NameEN,
+			&i.Unit,
+			&i.StockQuantity,
+			&i.LowStockThreshold,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLowStockIngredients = `-- name: ListLowStockIngredients :many
+SELECT id, "name", name_en as "nameEN", unit, stock_quantity as "stockQuantity", low_stock_threshold as "lowStockThreshold"
+FROM public.ingredients
+WHERE stock_quantity <= low_stock_threshold
+ORDER BY stock_quantity - low_stock_threshold, name_en
+`
+
+type ListLowStockIngredientsRow struct {
+	ID                int64          `json:"id"`
+	Name              string         `json:"name"`
+	NameEN            string         `json:"nameEN"`
+	Unit              string         `json:"unit"`
+	StockQuantity     pgtype.Numeric `json:"stockQuantity"`
+	LowStockThreshold pgtype.Numeric `json:"lowStockThreshold"`
+}
+
+func (q *Queries) ListLowStockIngredients(ctx context.Context) ([]*ListLowStockIngredientsRow, error) {
+	rows, err := q.db.Query(ctx, listLowStockIngredients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListLowStockIngredientsRow{}
+	for rows.Next() {
+		var i ListLowStockIngredientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.NameEN,
+			&i.Unit,
+			&i.StockQuantity,
+			&i.LowStockThreshold,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductIngredients = `-- name: ListProductIngredients :many
+SELECT pi.ingredient_id as "ingredientID", i."name", i.name_en as "nameEN", i.unit, pi.quantity
+FROM public.product_ingredients pi
+         JOIN public.ingredients i ON i.id = pi.ingredient_id
+WHERE pi.product_id = $1::bigint
+ORDER BY i.name_en
+`
+
+type ListProductIngredientsRow struct {
+	IngredientID int64          `json:"ingredientID"`
+	Name         string         `json:"name"`
+	NameEN       string         `json:"nameEN"`
+	Unit         string         `json:"unit"`
+	Quantity     pgtype.Numeric `json:"quantity"`
+}
+
+func (q *Queries) ListProductIngredients(ctx context.Context, productID int64) ([]*ListProductIngredientsRow, error) {
+	rows, err := q.db.Query(ctx, listProductIngredients, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProductIngredientsRow{}
+	for rows.Next() {
+		var i ListProductIngredientsRow
+		if err := rows.Scan(
+			&i.IngredientID,
+			&i.Name,
+			&i.NameEN,
+			&i.Unit,
+			&i.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductIngredientsForUpdate = `-- name: ListProductIngredientsForUpdate :many
+SELECT i.id, i.name_en as "nameEN", i.stock_quantity as "stockQuantity", (pi.quantity * $1::int)::numeric as "requiredQuantity"
+FROM public.product_ingredients pi
+         JOIN public.ingredients i ON i.id = pi.ingredient_id
+WHERE pi.product_id = $2::bigint
+ORDER BY i.id
+    FOR UPDATE OF i
+`
+
+type ListProductIngredientsForUpdateParams struct {
+	Quantity  int32 `json:"quantity"`
+	ProductID int64 `json:"product_id"`
+}
+
+type ListProductIngredientsForUpdateRow struct {
+	ID               int64          `json:"id"`
+	NameEN           string         `json:"nameEN"`
+	StockQuantity    pgtype.Numeric `json:"stockQuantity"`
+	RequiredQuantity pgtype.Numeric `json:"requiredQuantity"`
+}
+
+func (q *Queries) ListProductIngredientsForUpdate(ctx context.Context, arg ListProductIngredientsForUpdateParams) ([]*ListProductIngredientsForUpdateRow, error) {
+	rows, err := q.db.Query(ctx, listProductIngredientsForUpdate, arg.Quantity, arg.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProductIngredientsForUpdateRow{}
+	for rows.Next() {
+		var i ListProductIngredientsForUpdateRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.NameEN,
+			&i.StockQuantity,
+			&i.RequiredQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateIngredient = `-- name: UpdateIngredient :execrows
+UPDATE public.ingredients
+SET "name"              = $1::varchar,
+    name_en             = $2::varchar,
+    unit                = $3::varchar,
+    low_stock_threshold = $4::numeric,
+    updated_at          = NOW()
+WHERE id = $5::bigint
+`
+
+type UpdateIngredientParams struct {
+	Name              string         `json:"name"`
+	NameEn            string         `json:"name_en"`
+	Unit              string         `json:"unit"`
+	LowStockThreshold pgtype.Numeric `json:"low_stock_threshold"`
+	ID                int64          `json:"id"`
+}
+
+func (q *Queries) UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateIngredient,
+		arg.Name,
+		arg.NameEn,
+		arg.Unit,
+		arg.LowStockThreshold,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateOrderItemsIngredientsDeducted = `-- name: UpdateOrderItemsIngredientsDeducted :exec
+UPDATE public.order_items
+SET ingredients_deducted = $1::boolean
+WHERE id = $2::bigint
+`
+
+type UpdateOrderItemsIngredientsDeductedParams struct {
+	IngredientsDeducted bool  `json:"ingredients_deducted"`
+	ID                  int64 `json:"id"`
+}
+
+func (q *Queries) UpdateOrderItemsIngredientsDeducted(ctx context.Context, arg UpdateOrderItemsIngredientsDeductedParams) error {
+	_, err := q.db.Exec(ctx, updateOrderItemsIngredientsDeducted, arg.IngredientsDeducted, arg.ID)
+	return err
+}
+
+const updateProductsAvailableByIngredients = `-- name: UpdateProductsAvailableByIngredients :exec
+UPDATE public.products p
+SET is_available            = TRUE,
+    sold_out_by_ingredients = FALSE,
+    updated_at              = NOW()
+WHERE p.sold_out_by_ingredients IS TRUE
+  AND (p.stock_quantity IS NULL OR p.stock_quantity > 0)
+  AND EXISTS (SELECT 1
+              FROM public.product_ingredients pi
+              WHERE pi.product_id = p.id
+                AND pi.ingredient_id = ANY ($1::bigint[]))
+  AND NOT EXISTS (SELECT 1
+                  FROM public.product_ingredients pi
+                           JOIN public.ingredients i ON i.id = pi.ingredient_id
+                  WHERE pi.product_id = p.id
+                    AND i.stock_quantity < pi.quantity)
+`
+
+func (q *Queries) UpdateProductsAvailableByIngredients(ctx context.Context, ingredientIds []int64) error {
+	_, err := q.db.Exec(ctx, updateProductsAvailableByIngredients, ingredientIds)
+	return err
+}
+
+const updateProductsUnavailableByIngredients = `-- name: UpdateProductsUnavailableByIngredients :exec
+UPDATE public.products p
+SET is_available            = FALSE,
+    sold_out_by_ingredients = TRUE,
+    updated_at              = NOW()
+WHERE p.is_available IS TRUE
+  AND EXISTS (SELECT 1
+              FROM public.product_ingredients pi
+                       JOIN public.ingredients i ON i.id = pi.ingredient_id
+              WHERE pi.product_id = p.id
+                AND pi.ingredient_id = ANY ($1::bigint[])
+                AND i.stock_quantity < pi.quantity)
+`
+
+func (q *Queries) UpdateProductsUnavailableByIngredients(ctx context.Context, ingredientIds []int64) error {
+	_, err := q.db.Exec(ctx, updateProductsUnavailableByIngredients, ingredientIds)
+	return err
+}
@@ -11,6 +11,110 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const createCategory = `-- name: CreateCategory :one
+INSERT INTO public.md_categories (id, "name", name_en, icon_name, sort_order, is_visible, code)
+VALUES ($1, $2, $3, $4, (SELECT COALESCE(MAX(sort_order), 0) + 1 FROM public.md_categories), $5, $6)
+RETURNING id
+`
+
+type CreateCategoryParams struct {
+	ID        int64       `json:"id"`
+	Name      string      `json:"name"`
+	NameEn    string      `json:"name_en"`
+	IconName  pgtype.Text `json:"icon_name"`
+	IsVisible bool        `json:"is_visible"`
+	Code      pgtype.Text `json:"code"`
+}
+
+func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createCategory,
+		arg.ID,
+		arg.Name,
+		arg.NameEn,
+		arg.IconName,
+		arg.IsVisible,
+		arg.Code,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getCategoryIDBySortOrderForUpdate = `-- name: GetCategoryIDBySortOrderForUpdate :one
+SELECT id FROM public.md_categories WHERE sort_order = $1 FOR UPDATE
+`
+
+func (q *Queries) GetCategoryIDBySortOrderForUpdate(ctx context.Context, sortOrder int32) (int64, error) {
+	row := q.db.QueryRow(ctx, getCategoryIDBySortOrderForUpdate, sortOrder)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getCategorySortOrderForUpdate = `-- name: GetCategorySortOrderForUpdate :one
+SELECT sort_order FROM public.md_categories WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetCategorySortOrderForUpdate(ctx context.Context, id int64) (int32, error) {
+	row := q.db.QueryRow(ctx, getCategorySortOrderForUpdate, id)
+	var sort_order int32
+	err := row.Scan(&sort_order)
+	return sort_order, err
+}
+
+const isCategoryExists = `-- name: IsCategoryExists :one
+SELECT count(*) > 0 as "isExists" FROM public.md_categories WHERE id = $1
+`
+
+func (q *Queries) IsCategoryExists(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isCategoryExists, id)
+	var isExists bool
+	err := row.Scan(&isExists)
+	return isExists, err
+}
+
+const listAllCategory = `-- name: ListAllCategory :many
+SELECT id, "name", name_en as "nameEN", icon_name as "icon", sort_order, is_visible, code FROM public.md_categories ORDER BY sort_order
+`
+
+type ListAllCategoryRow struct {
+	ID        int64       `json:"id"`
+	Name      string      `json:"name"`
+	NameEN    string      `json:"nameEN"`
+	Icon      pgtype.Text `json:"icon"`
+	SortOrder int32       `json:"sort_order"`
+	IsVisible bool        `json:"is_visible"`
+	Code      pgtype.Text `json:"code"`
+}
+
+func (q *Queries) ListAllCategory(ctx context.Context) ([]*ListAllCategoryRow, error) {
+	rows, err := q.db.Query(ctx, listAllCategory)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListAllCategoryRow{}
+	for rows.Next() {
+		var i ListAllCategoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.NameEN,
+			&i.Icon,
+			&i.SortOrder,
+			&i.IsVisible,
+			&i.Code,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCategory = `-- name: ListCategory :many
 SELECT id, "name", name_en as "nameEN", icon_name as "icon" FROM public.md_categories WHERE is_visible IS TRUE ORDER BY sort_order
 `
@@ -46,3 +150,69 @@ func (q *Queries) ListCategory(ctx context.Context) ([]*ListCategoryRow, error)
 	}
 	return items, nil
 }
+
+const updateCategory = `-- name: UpdateCategory :execrows
+UPDATE public.md_categories
+SET "name"     = $2,
+    name_en    = $3,
+    icon_name  = $4,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCategoryParams struct {
+	ID       int64       `json:"id"`
+	Name     string      `json:"name"`
+	NameEn   string      `json:"name_en"`
+	IconName pgtype.Text `json:"icon_name"`
+}
+
+func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCategory,
+		arg.ID,
+		arg.Name,
+		arg.NameEn,
+		arg.IconName,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateCategorySortOrder = `-- name: UpdateCategorySortOrder :exec
+UPDATE public.md_categories
+SET sort_order = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCategorySortOrderParams struct {
+	ID        int64 `json:"id"`
+	SortOrder int32 `json:"sort_order"`
+}
+
+func (q *Queries) UpdateCategorySortOrder(ctx context.Context, arg UpdateCategorySortOrderParams) error {
+	_, err := q.db.Exec(ctx, updateCategorySortOrder, arg.ID, arg.SortOrder)
+	return err
+}
+
+const updateCategoryVisibility = `-- name: UpdateCategoryVisibility :execrows
+UPDATE public.md_categories
+SET is_visible = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateCategoryVisibilityParams struct {
+	ID        int64 `json:"id"`
+	IsVisible bool  `json:"is_visible"`
+}
+
+func (q *Queries) UpdateCategoryVisibility(ctx context.Context, arg UpdateCategoryVisibilityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateCategoryVisibility, arg.ID, arg.IsVisible)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -20,6 +20,17 @@ func (q *Queries) GetOrderStatusCompleted(ctx context.Context) (int64, error) {
 	return id, err
 }
 
+const getOrderStatusPending = `-- name: GetOrderStatusPending :one
+SELECT id FROM public.md_order_statuses WHERE code = 'PENDING' LIMIT 1
+`
+
+func (q *Queries) GetOrderStatusPending(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getOrderStatusPending)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getOrderStatusPreparing = `-- name: GetOrderStatusPreparing :one
 SELECT id FROM public.md_order_statuses WHERE code = 'PREPARING' LIMIT 1
 `
@@ -0,0 +1,203 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: menu_versions.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createMenuVersion = `-- name: CreateMenuVersion :one
+INSERT INTO public.menu_versions (id, version_number, snapshot, publish_at, note, rollback_of)
+VALUES ($1::bigint,
+        (SELECT COALESCE(MAX(version_number), 0) + 1 FROM public.menu_versions),
+        $2::jsonb,
+        COALESCE($3::timestamptz, NOW()),
+        $4::text,
+        $5::bigint)
+RETURNING version_number as "versionNumber", publish_at as "publishAt"
+`
+
+type CreateMenuVersionParams struct {
+	ID         int64              `json:"id"`
+	Snapshot   []byte             `json:"snapshot"`
+	PublishAt  pgtype.Timestamptz `json:"publish_at"`
+	Note       pgtype.Text        `json:"note"`
+	RollbackOf pgtype.Int8        `json:"rollback_of"`
+}
+
+type CreateMenuVersionRow struct {
+	VersionNumber int32              `json:"versionNumber"`
+	PublishAt     pgtype.Timestamptz `json:"publishAt"`
+}
+
+func (q *Queries) CreateMenuVersion(ctx context.Context, arg CreateMenuVersionParams) (*CreateMenuVersionRow, error) {
+	row := q.db.QueryRow(ctx, createMenuVersion,
+		arg.ID,
+		arg.Snapshot,
+		arg.PublishAt,
+		arg.Note,
+		arg.RollbackOf,
+	)
+	var i CreateMenuVersionRow
+	err := row.Scan(&i.VersionNumber, &i.PublishAt)
+	return &i, err
+}
+
+const deleteScheduledMenuVersion = `-- name: DeleteScheduledMenuVersion :execrows
+DELETE FROM public.menu_versions WHERE id = $1::bigint AND publish_at > NOW()
+`
+
+func (q *Queries) DeleteScheduledMenuVersion(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteScheduledMenuVersion, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getMenuVersionSnapshot = `-- name: GetMenuVersionSnapshot :one
+SELECT snapshot, (publish_at <= NOW())::boolean as "isPublished" FROM public.menu_versions WHERE id = $1::bigint
+`
+
+type GetMenuVersionSnapshotRow struct {
+	Snapshot    []byte `json:"snapshot"`
+	IsPublished bool   `json:"isPublished"`
+}
+
+func (q *Queries) GetMenuVersionSnapshot(ctx context.Context, id int64) (*GetMenuVersionSnapshotRow, error) {
+	row := q.db.QueryRow(ctx, getMenuVersionSnapshot, id)
+	var i GetMenuVersionSnapshotRow
+	err := row.Scan(&i.Snapshot, &i.IsPublished)
+	return &i, err
+}
+
+const getPublishedCategories = `-- name: GetPublishedCategories :one
+SELECT (snapshot -> 'categories')::jsonb as "categories" FROM public.menu_versions WHERE id = $1::bigint
+`
+
+func (q *Queries) GetPublishedCategories(ctx context.Context, id int64) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getPublishedCategories, id)
+	var categories []byte
+	err := row.Scan(&categories)
+	return categories, err
+}
+
+const getPublishedMenuVersionID = `-- name: GetPublishedMenuVersionID :one
+SELECT id FROM public.menu_versions WHERE publish_at <= NOW() ORDER BY publish_at DESC, version_number DESC LIMIT 1
+`
+
+func (q *Queries) GetPublishedMenuVersionID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getPublishedMenuVersionID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const isMenuVersioned = `-- name: IsMenuVersioned :one
+SELECT EXISTS (SELECT 1 FROM public.menu_versions)::boolean as "isVersioned"
+`
+
+func (q *Queries) IsMenuVersioned(ctx context.Context) (bool, error) {
+	row := q.db.QueryRow(ctx, isMenuVersioned)
+	var isVersioned bool
+	err := row.Scan(&isVersioned)
+	return isVersioned, err
+}
+
+const listMenuVersions = `-- name: ListMenuVersions :many
+SELECT v.id,
+       v.version_number as "versionNumber",
+       v.publish_at as "publishAt",
+       v.note,
+       v.rollback_of as "rollbackOf",
+       v.created_at as "createdAt",
+       jsonb_array_length(v.snapshot -> 'products')::int as "productCount",
+       (v.publish_at > NOW())::boolean as "isScheduled",
+       (v.id IS NOT DISTINCT FROM (SELECT c.id
+                                   FROM public.menu_versions c
+                                   WHERE c.publish_at <= NOW()
+                                   ORDER BY c.publish_at DESC, c.version_number DESC
+                                   LIMIT 1))::boolean as "isCurrent"
+FROM public.menu_versions v
+ORDER BY v.version_number DESC
+`
+
+type ListMenuVersionsRow struct {
+	ID            int64              `json:"id"`
+	VersionNumber int32              `json:"versionNumber"`
+	PublishAt     pgtype.Timestamptz `json:"publishAt"`
+	Note          pgtype.Text        `json:"note"`
+	RollbackOf    pgtype.Int8        `json:"rollbackOf"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+	ProductCount  int32              `json:"productCount"`
+	IsScheduled   bool               `json:"isScheduled"`
+	IsCurrent     bool               `json:"isCurrent"`
+}
+
+func (q *Queries) ListMenuVersions(ctx context.Context) ([]*ListMenuVersionsRow, error) {
+	rows, err := q.db.Query(ctx, listMenuVersions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListMenuVersionsRow{}
+	for rows.Next() {
+		var i ListMenuVersionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.VersionNumber,
+			&i.PublishAt,
+			&i.Note,
+			&i.RollbackOf,
+			&i.CreatedAt,
+			&i.ProductCount,
+			&i.IsScheduled,
+			&i.IsCurrent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPublishedProducts = `-- name: ListPublishedProducts :many
+SELECT p.value::jsonb as "product"
+FROM public.menu_versions v
+         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') p
+WHERE v.id = $1::bigint
+  AND (p.value ->> 'id')::bigint = ANY ($2::bigint[])
+`
+
+type ListPublishedProductsParams struct {
+	MenuVersionID int64   `json:"menu_version_id"`
+	ProductIds    []int64 `json:"product_ids"`
+}
+
+func (q *Queries) ListPublishedProducts(ctx context.Context, arg ListPublishedProductsParams) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, listPublishedProducts, arg.MenuVersionID, arg.ProductIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := [][]byte{}
+	for rows.Next() {
+		var product []byte
+		if err := rows.Scan(&product); err != nil {
+			return nil, err
+		}
+		items = append(items, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -54,6 +54,52 @@ func (ns NullTableSessionStatus) Value() (driver.Value, error) {
 	return string(ns.TableSessionStatus), nil
 }
 
+// ช่องเลือกของชุดเมนู เช่น อาหารจานหลัก, เครื่องดื่ม, ของหวาน
+type ComboSlot struct {
+	ID             int64              `json:"id"`
+	ComboProductID int64              `json:"combo_product_id"`
+	Name           string             `json:"name"`
+	NameEn         string             `json:"name_en"`
+	SortOrder      int32              `json:"sort_order"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	// เวลาที่นำออกจากชุดเมนู เก็บแถวไว้ให้ออเดอร์เดิม เมนูที่เผยแพร่แล้ว และคำแปลยังอ้างถึงรหัสเดิมได้
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type ComboSlotOption struct {
+	SlotID    int64 `json:"slot_id"`
+	ProductID int64 `json:"product_id"`
+	// ราคาเพิ่มจากราคาชุด เมื่อเลือกตัวเลือกนี้
+	PriceDelta pgtype.Numeric `json:"price_delta"`
+	SortOrder  int32          `json:"sort_order"`
+}
+
+type Ingredient struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	NameEn string `json:"name_en"`
+	// หน่วยนับ เช่น g, ml, ฟอง
+	Unit          string         `json:"unit"`
+	StockQuantity pgtype.Numeric `json:"stock_quantity"`
+	// แจ้งเตือนเมื่อคงเหลือน้อยกว่าหรือเท่ากับค่านี้
+	LowStockThreshold pgtype.Numeric     `json:"low_stock_threshold"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type IngredientStockMovement struct {
+	ID           int64       `json:"id"`
+	IngredientID int64       `json:"ingredient_id"`
+	OrderItemID  pgtype.Int8 `json:"order_item_id"`
+	// จำนวนที่เปลี่ยนแปลง ค่าบวก = รับเข้า ค่าลบ = ตัดออก
+	Quantity pgtype.Numeric `json:"quantity"`
+	// ORDER, CANCEL, RESTOCK, ADJUST
+	Reason    string             `json:"reason"`
+	Note      pgtype.Text        `json:"note"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type MdCategory struct {
 	ID        int64              `json:"id"`
 	Name      string             `json:"name"`
@@ -66,6 +112,18 @@ type MdCategory struct {
 	Code      pgtype.Text        `json:"code"`
 }
 
+type MdDietaryTag struct {
+	ID     int64  `json:"id"`
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	NameEn string `json:"name_en"`
+	// true = สารก่อภูมิแพ้ (แจ้งเตือนในครัว), false = ข้อมูลโภชนาการ เช่น มังสวิรัติ ฮาลาล
+	IsAllergen bool               `json:"is_allergen"`
+	SortOrder  int32              `json:"sort_order"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
 type MdOrderStatus struct {
 	ID        int64              `json:"id"`
 	Code      string             `json:"code"`
@@ -129,6 +187,33 @@ type MdTableStatus struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type MenuSchedule struct {
+	ID         int64       `json:"id"`
+	ProductID  pgtype.Int8 `json:"product_id"`
+	CategoryID pgtype.Int8 `json:"category_id"`
+	// วันในสัปดาห์ 0 = อาทิตย์ ถึง 6 = เสาร์
+	DayOfWeek int16 `json:"day_of_week"`
+	// เวลาเริ่มขาย (ตามเขตเวลา TZ ของระบบ)
+	StartTime pgtype.Time `json:"start_time"`
+	// เวลาสิ้นสุดการขาย (ไม่รวมเวลานี้) ถ้าน้อยกว่า start_time คือเวลาของวันถัดไป
+	EndTime   pgtype.Time        `json:"end_time"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+// เมนูที่เผยแพร่ให้ลูกค้า ตารางสินค้าเป็นฉบับร่าง เวอร์ชันที่ publish_at ล่าสุดที่ถึงเวลาแล้วคือเมนูปัจจุบัน
+type MenuVersion struct {
+	ID            int64 `json:"id"`
+	VersionNumber int32 `json:"version_number"`
+	// หมวดหมู่และสินค้า (รวมตัวเลือก ท็อปปิ้ง คอมโบ แท็กอาหาร) ณ เวลาที่เผยแพร่
+	Snapshot []byte `json:"snapshot"`
+	// เวลาที่เริ่มใช้เวอร์ชันนี้ ถ้าเป็นเวลาในอนาคตคือการตั้งเวลาเผยแพร่
+	PublishAt pgtype.Timestamptz `json:"publish_at"`
+	Note      pgtype.Text        `json:"note"`
+	// เวอร์ชันเดิมที่นำกลับมาใช้ใหม่
+	RollbackOf pgtype.Int8        `json:"rollback_of"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
 type Order struct {
 	ID          int64              `json:"id"`
 	OrderNumber string             `json:"order_number"`
@@ -156,6 +241,24 @@ type OrderItem struct {
 	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
 	ProductImageUrl pgtype.Text        `json:"product_image_url"`
 	IsVisible       bool               `json:"is_visible"`
+	// ตัวเลือกเสริมที่ลูกค้าเลือก ณ เวลาสั่ง
+	Modifiers           []byte      `json:"modifiers"`
+	VariantID           pgtype.Int8 `json:"variant_id"`
+	VariantName         pgtype.Text `json:"variant_name"`
+	VariantNameEn       pgtype.Text `json:"variant_name_en"`
+	IngredientsDeducted bool        `json:"ingredients_deducted"`
+	// รายการชุดเมนูที่รายการนี้เป็นส่วนประกอบ
+	ParentOrderItemID pgtype.Int8 `json:"parent_order_item_id"`
+	// สารก่อภูมิแพ้ของสินค้า ณ เวลาที่สั่ง
+	Allergens []byte `json:"allergens"`
+	// โปรโมชั่นที่ใช้กับรายการนี้ ณ เวลาที่สั่ง
+	PromotionID pgtype.Int8 `json:"promotion_id"`
+	// ส่วนลดรวมของรายการ (ราคา x จำนวน) จากโปรโมชั่นแบบเปอร์เซ็นต์หรือจำนวนเงิน ส่วน BUY_X_GET_Y คิดตอนรวมบิล
+	DiscountAmount pgtype.Numeric `json:"discount_amount"`
+	// จำนวนที่ต้องซื้อของโปรโมชั่น BUY_X_GET_Y ณ เวลาที่สั่ง แก้โปรโมชั่นภายหลังไม่กระทบบิลเดิม
+	PromotionBuyQuantity pgtype.Int4 `json:"promotion_buy_quantity"`
+	// จำนวนที่แถมของโปรโมชั่น BUY_X_GET_Y ณ เวลาที่สั่ง
+	PromotionFreeQuantity pgtype.Int4 `json:"promotion_free_quantity"`
 }
 
 type OrderSequence struct {
@@ -163,6 +266,24 @@ type OrderSequence struct {
 	CurrentNumber int32       `json:"current_number"`
 }
 
+// ข้อความ Kafka ที่บันทึกใน transaction เดียวกับออเดอร์ relay worker ของ order-service เป็นผู้ส่งจริง
+type OutboxEvent struct {
+	ID        int64       `json:"id"`
+	Topic     string      `json:"topic"`
+	Payload   []byte      `json:"payload"`
+	Attempts  int32       `json:"attempts"`
+	LastError pgtype.Text `json:"last_error"`
+	// เวลาที่จะลองส่งครั้งถัดไป ใช้เป็น lease ระหว่างส่งด้วย
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	// เวลาที่ส่งสำเร็จ ถ้าเป็น NULL คือยังไม่ได้ส่ง
+	SentAt pgtype.Timestamptz `json:"sent_at"`
+	// Kafka message key ข้อความของออเดอร์เดียวกันใช้ key เดียวกันจึงอยู่ partition เดียวกันและถึงครัวตามลำดับ
+	MessageKey pgtype.Text `json:"message_key"`
+	// ลำดับของเหตุการณ์ภายใน key เดียวกันตามลำดับ commit ใช้เป็น event ID ของ SSE และลำดับการส่ง id ที่มาจาก snowflake ของหลาย service เรียงแทนไม่ได้
+	Seq int64 `json:"seq"`
+}
+
 type Payment struct {
 	ID            int64              `json:"id"`
 	OrderID       int64              `json:"order_id"`
@@ -189,6 +310,85 @@ type Product struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
 	IsVisible   bool               `json:"is_visible"`
+	// จำนวนคงเหลือ NULL = ไม่จำกัดจำนวน เมื่อเหลือ 0 ระบบจะปิดการขายอัตโนมัติ
+	StockQuantity pgtype.Int4 `json:"stock_quantity"`
+	// รูปย่อของสินค้า สร้างอัตโนมัติเมื่ออัปโหลดรูป
+	ThumbnailUrl pgtype.Text `json:"thumbnail_url"`
+	// ระบบปิดการขายเองเพราะวัตถุดิบไม่พอ เปิดขายคืนอัตโนมัติเมื่อวัตถุดิบทุกรายการพอ แยกจากการปิดขายโดยพนักงาน
+	SoldOutByIngredients bool `json:"sold_out_by_ingredients"`
+}
+
+// สินค้าที่มักสั่งด้วยกัน คำนวณใหม่เป็นระยะจาก order_items โดย background job ของ menu-service
+type ProductAssociation struct {
+	ProductID           int64 `json:"product_id"`
+	AssociatedProductID int64 `json:"associated_product_id"`
+	// จำนวนออเดอร์ที่มีทั้งสองสินค้า
+	OrderCount int32 `json:"order_count"`
+	// สัดส่วนออเดอร์ที่มีทั้งสองสินค้า ต่อออเดอร์ทั้งหมด
+	Support pgtype.Numeric `json:"support"`
+	// สัดส่วนออเดอร์ที่มี associated_product_id ในบรรดาออเดอร์ที่มี product_id
+	Confidence pgtype.Numeric `json:"confidence"`
+	// confidence หารด้วยสัดส่วนออเดอร์ที่มี associated_product_id มากกว่า 1 แปลว่าสั่งด้วยกันบ่อยกว่าปกติ
+	Lift       pgtype.Numeric     `json:"lift"`
+	ComputedAt pgtype.Timestamptz `json:"computed_at"`
+}
+
+type ProductDietaryTag struct {
+	ProductID int64 `json:"product_id"`
+	TagID     int64 `json:"tag_id"`
+}
+
+type ProductIngredient struct {
+	ProductID    int64 `json:"product_id"`
+	IngredientID int64 `json:"ingredient_id"`
+	// ปริมาณวัตถุดิบที่ใช้ต่อสินค้า 1 หน่วย
+	Quantity pgtype.Numeric `json:"quantity"`
+}
+
+type ProductModifier struct {
+	ID          int64              `json:"id"`
+	GroupID     int64              `json:"group_id"`
+	Name        string             `json:"name"`
+	NameEn      string             `json:"name_en"`
+	PriceDelta  pgtype.Numeric     `json:"price_delta"`
+	IsAvailable bool               `json:"is_available"`
+	SortOrder   int32              `json:"sort_order"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	// เวลาที่นำออกจากกลุ่ม เก็บแถวไว้ให้ออเดอร์เดิม เมนูที่เผยแพร่แล้ว และคำแปลยังอ้างถึงรหัสเดิมได้
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type ProductModifierGroup struct {
+	ID            int64              `json:"id"`
+	ProductID     int64              `json:"product_id"`
+	Name          string             `json:"name"`
+	NameEn        string             `json:"name_en"`
+	MinSelections int32              `json:"min_selections"`
+	MaxSelections int32              `json:"max_selections"`
+	SortOrder     int32              `json:"sort_order"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	// เวลาที่นำออกจากสินค้า เก็บแถวไว้ให้เมนูที่เผยแพร่แล้วและคำแปลยังอ้างถึงรหัสเดิมได้
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+// ประวัติราคาสินค้า รวมถึงราคาที่ตั้งล่วงหน้า
+type ProductPrice struct {
+	ID        int64          `json:"id"`
+	ProductID int64          `json:"product_id"`
+	Price     pgtype.Numeric `json:"price"`
+	// เวลาที่ราคานี้เริ่มมีผล ใช้จนกว่าจะมีราคาถัดไป
+	EffectiveFrom pgtype.Timestamptz `json:"effective_from"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	// เวอร์ชันเมนูที่ทำให้ราคานี้มีผล ถ้ายกเลิกการเผยแพร่ ราคาจะถูกลบไปด้วย
+	MenuVersionID pgtype.Int8 `json:"menu_version_id"`
+}
+
+// คำค้นหาเพิ่มเติมของสินค้า เช่น ชื่อเรียกอื่น หรือวัตถุดิบหลัก
+type ProductSearchKeyword struct {
+	ProductID int64  `json:"product_id"`
+	Keyword   string `json:"keyword"`
 }
 
 type ProductTimeExtension struct {
@@ -199,6 +399,57 @@ type ProductTimeExtension struct {
 	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
 }
 
+type ProductVariant struct {
+	ID        int64 `json:"id"`
+	ProductID int64 `json:"product_id"`
+	// ต่อท้ายชื่อสินค้า เช่น เล็ก, กลาง, ใหญ่
+	Name        string             `json:"name"`
+	NameEn      string             `json:"name_en"`
+	Price       pgtype.Numeric     `json:"price"`
+	IsAvailable bool               `json:"is_available"`
+	SortOrder   int32              `json:"sort_order"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	// เวลาที่นำออกจากสินค้า เก็บแถวไว้ให้ออเดอร์เดิม เมนูที่เผยแพร่แล้ว และคำแปลยังอ้างถึงรหัสเดิมได้
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+}
+
+// โปรโมชั่น เช่น เครื่องดื่มลด 20% ช่วง 15:00-17:00 หรือ ซื้อ 2 แถม 1
+type Promotion struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	NameEn string `json:"name_en"`
+	// PERCENTAGE = ลดเป็นเปอร์เซ็นต์, FIXED_AMOUNT = ลดเป็นจำนวนเงินต่อชิ้น, BUY_X_GET_Y = ซื้อ X แถม Y (ชิ้นที่ถูกที่สุดฟรี)
+	PromotionType string `json:"promotion_type"`
+	// เปอร์เซ็นต์ส่วนลด หรือ จำนวนเงินที่ลดต่อชิ้น (ไม่ใช้กับ BUY_X_GET_Y)
+	DiscountValue pgtype.Numeric     `json:"discount_value"`
+	BuyQuantity   pgtype.Int4        `json:"buy_quantity"`
+	FreeQuantity  pgtype.Int4        `json:"free_quantity"`
+	ProductID     pgtype.Int8        `json:"product_id"`
+	CategoryID    pgtype.Int8        `json:"category_id"`
+	StartsAt      pgtype.Timestamptz `json:"starts_at"`
+	EndsAt        pgtype.Timestamptz `json:"ends_at"`
+	// วันที่มีโปรโมชั่น 0 = อาทิตย์ ถึง 6 = เสาร์, NULL = ทุกวัน
+	DaysOfWeek []int16 `json:"days_of_week"`
+	// เวลาเริ่มโปรโมชั่นในแต่ละวัน (ตามเขตเวลา TZ ของระบบ), NULL = ทั้งวัน
+	StartTime pgtype.Time `json:"start_time"`
+	// เวลาสิ้นสุดโปรโมชั่นในแต่ละวัน (ไม่รวมเวลานี้)
+	EndTime   pgtype.Time        `json:"end_time"`
+	IsActive  bool               `json:"is_active"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+// คำสะกดอื่นที่ใช้ค้นหาเมนู เช่น krapow -> kaphrao, pad -> phat
+type SearchAlias struct {
+	ID int64 `json:"id"`
+	// คำที่ลูกค้าพิมพ์
+	Alias string `json:"alias"`
+	// คำที่ใช้ค้นหาแทน
+	Term      string             `json:"term"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type SessionExtension struct {
 	ID               int64              `json:"id"`
 	SessionID        pgtype.UUID        `json:"session_id"`
@@ -217,6 +468,23 @@ type Table struct {
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
 }
 
+// คำขอจากลูกค้าที่โต๊ะ เช่น เรียกพนักงานหรือขอเช็คบิล พนักงานรับทราบแล้วจึงปิดคำขอ
+type TableServiceRequest struct {
+	ID          int64       `json:"id"`
+	SessionID   pgtype.UUID `json:"session_id"`
+	TableID     int64       `json:"table_id"`
+	OrderID     pgtype.Int8 `json:"order_id"`
+	RequestType string      `json:"request_type"`
+	// เหตุผลที่เรียกพนักงาน ใช้กับ CALL_WAITER เท่านั้น
+	Reason pgtype.Text `json:"reason"`
+	Status string      `json:"status"`
+	// สถานะโต๊ะก่อนเรียกพนักงาน ใช้คืนสถานะเมื่อปิดคำขอ
+	PreviousTableStatusID int64              `json:"previous_table_status_id"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	AcknowledgedAt        pgtype.Timestamptz `json:"acknowledged_at"`
+	ResolvedAt            pgtype.Timestamptz `json:"resolved_at"`
+}
+
 type TableSession struct {
 	ID                 int64                  `json:"id"`
 	TableID            int64                  `json:"table_id"`
@@ -232,3 +500,15 @@ type TableSession struct {
 	LockVersion        int32                  `json:"lock_version"`
 	EndedAt            pgtype.Timestamptz     `json:"ended_at"`
 }
+
+// คำแปลชื่อ/รายละเอียดของข้อมูลหลักในภาษาอื่นนอกจากไทยและอังกฤษ เช่น zh, ja, ko
+type Translation struct {
+	// product, category, variant, modifier_group, modifier, combo_slot, order_status
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+	Field      string `json:"field"`
+	// รหัสภาษาตัวพิมพ์เล็ก เช่น zh, zh-tw, ja, ko
+	Locale    string             `json:"locale"`
+	Value     string             `json:"value"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
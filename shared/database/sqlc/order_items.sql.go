@@ -11,19 +11,61 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const amendOrderItem = `-- name: AmendOrderItem :exec
+UPDATE public.order_items
+SET quantity = $1::int,
+    note = $2::text,
+    modifiers = $3::jsonb,
+    price = $4::numeric,
+    discount_amount = $5::numeric,
+    updated_at = NOW()
+WHERE id = $6::bigint
+`
+
+type AmendOrderItemParams struct {
+	Quantity       int32          `json:"quantity"`
+	Note           pgtype.Text    `json:"note"`
+	Modifiers      []byte         `json:"modifiers"`
+	Price          pgtype.Numeric `json:"price"`
+	DiscountAmount pgtype.Numeric `json:"discount_amount"`
+	ID             int64          `json:"id"`
+}
+
+func (q *Queries) AmendOrderItem(ctx context.Context, arg AmendOrderItemParams) error {
+	_, err := q.db.Exec(ctx, amendOrderItem,
+		arg.Quantity,
+		arg.Note,
+		arg.Modifiers,
+		arg.Price,
+		arg.DiscountAmount,
+		arg.ID,
+	)
+	return err
+}
+
 type CreateOrderItemsParams struct {
-	ID              int64              `json:"id"`
-	OrderID         int64              `json:"order_id"`
-	ProductID       int64              `json:"product_id"`
-	StatusID        int64              `json:"status_id"`
-	ProductName     string             `json:"product_name"`
-	ProductNameEn   string             `json:"product_name_en"`
-	Price           pgtype.Numeric     `json:"price"`
-	Quantity        int32              `json:"quantity"`
-	Note            pgtype.Text        `json:"note"`
-	CreatedAt       pgtype.Timestamptz `json:"created_at"`
-	ProductImageUrl pgtype.Text        `json:"product_image_url"`
-	IsVisible       bool               `json:"is_visible"`
+	ID                    int64              `json:"id"`
+	OrderID               int64              `json:"order_id"`
+	ProductID             int64              `json:"product_id"`
+	StatusID              int64              `json:"status_id"`
+	ProductName           string             `json:"product_name"`
+	ProductNameEn         string             `json:"product_name_en"`
+	Price                 pgtype.Numeric     `json:"price"`
+	Quantity              int32              `json:"quantity"`
+	Note                  pgtype.Text        `json:"note"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	ProductImageUrl       pgtype.Text        `json:"product_image_url"`
+	IsVisible             bool               `json:"is_visible"`
+	Modifiers             []byte             `json:"modifiers"`
+	VariantID             pgtype.Int8        `json:"variant_id"`
+	VariantName           pgtype.Text        `json:"variant_name"`
+	VariantNameEn         pgtype.Text        `json:"variant_name_en"`
+	ParentOrderItemID     pgtype.Int8        `json:"parent_order_item_id"`
+	Allergens             []byte             `json:"allergens"`
+	PromotionID           pgtype.Int8        `json:"promotion_id"`
+	DiscountAmount        pgtype.Numeric     `json:"discount_amount"`
+	PromotionBuyQuantity  pgtype.Int4        `json:"promotion_buy_quantity"`
+	PromotionFreeQuantity pgtype.Int4        `json:"promotion_free_quantity"`
 }
 
 const createOrderItemsPerRow = `-- name: CreateOrderItemsPerRow :exec
@@ -65,6 +107,84 @@ func (q *Queries) CreateOrderItemsPerRow(ctx context.Context, arg CreateOrderIte
 	return err
 }
 
+const getComboOrderItemProgress = `-- name: GetComboOrderItemProgress :one
+SELECT COUNT(*)::int as "total",
+       COUNT(*) FILTER (WHERE ms.code = 'SERVED')::int as "served",
+       COUNT(*) FILTER (WHERE ms.code = 'CANCELLED')::int as "cancelled"
+FROM public.order_items oi
+         JOIN public.md_order_statuses ms ON ms.id = oi.status_id
+WHERE oi.parent_order_item_id = $1::bigint
+`
+
+type GetComboOrderItemProgressRow struct {
+	Total     int32 `json:"total"`
+	Served    int32 `json:"served"`
+	Cancelled int32 `json:"cancelled"`
+}
+
+func (q *Queries) GetComboOrderItemProgress(ctx context.Context, parentOrderItemID int64) (*GetComboOrderItemProgressRow, error) {
+	row := q.db.QueryRow(ctx, getComboOrderItemProgress, parentOrderItemID)
+	var i GetComboOrderItemProgressRow
+	err := row.Scan(&i.Total, &i.Served, &i.Cancelled)
+	return &i, err
+}
+
+const getOrderItemForAmend = `-- name: GetOrderItemForAmend :one
+SELECT oi.id,
+       oi.product_id,
+       oi.product_name_en,
+       oi.price,
+       oi.quantity,
+       oi.discount_amount,
+       oi.modifiers,
+       oi.parent_order_item_id,
+       oi.is_visible,
+       oi.created_at,
+       ms.code as "statusCode"
+FROM public.order_items oi
+         JOIN public.md_order_statuses ms ON ms.id = oi.status_id
+WHERE oi.id = $1::bigint
+  AND oi.order_id = $2::bigint
+`
+
+type GetOrderItemForAmendParams struct {
+	ID      int64 `json:"id"`
+	OrderID int64 `json:"order_id"`
+}
+
+type GetOrderItemForAmendRow struct {
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"product_id"`
+	ProductNameEn     string             `json:"product_name_en"`
+	Price             pgtype.Numeric     `json:"price"`
+	Quantity          int32              `json:"quantity"`
+	DiscountAmount    pgtype.Numeric     `json:"discount_amount"`
+	Modifiers         []byte             `json:"modifiers"`
+	ParentOrderItemID pgtype.Int8        `json:"parent_order_item_id"`
+	IsVisible         bool               `json:"is_visible"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	StatusCode        string             `json:"statusCode"`
+}
+
+func (q *Queries) GetOrderItemForAmend(ctx context.Context, arg GetOrderItemForAmendParams) (*GetOrderItemForAmendRow, error) {
+	row := q.db.QueryRow(ctx, getOrderItemForAmend, arg.ID, arg.OrderID)
+	var i GetOrderItemForAmendRow
+	err := row.Scan(
+		&i.ID,
+		&i.ProductID,
+		&i.ProductNameEn,
+		&i.Price,
+		&i.Quantity,
+		&i.DiscountAmount,
+		&i.Modifiers,
+		&i.ParentOrderItemID,
+		&i.IsVisible,
+		&i.CreatedAt,
+		&i.StatusCode,
+	)
+	return &i, err
+}
+
 const getOrderItemsByID = `-- name: GetOrderItemsByID :one
 SELECT id, order_id, product_id, status_id, product_name, product_name_en, price, quantity, note
 FROM public.order_items WHERE id = $1 AND is_visible IS TRUE
@@ -99,8 +219,42 @@ func (q *Queries) GetOrderItemsByID(ctx context.Context, id int64) (*GetOrderIte
 	return &i, err
 }
 
+const getOrderItemsStatusForUpdate = `-- name: GetOrderItemsStatusForUpdate :one
+SELECT oi.order_id, oi.product_id, oi.quantity, oi.ingredients_deducted, oi.parent_order_item_id, ms.code as "statusCode", ms.is_final as "isFinal"
+FROM public.order_items oi
+         JOIN public.md_order_statuses ms ON ms.id = oi.status_id
+WHERE oi.id = $1::bigint
+    FOR UPDATE OF oi
+`
+
+type GetOrderItemsStatusForUpdateRow struct {
+	OrderID             int64       `json:"order_id"`
+	ProductID           int64       `json:"product_id"`
+	Quantity            int32       `json:"quantity"`
+	IngredientsDeducted bool        `json:"ingredients_deducted"`
+	ParentOrderItemID   pgtype.Int8 `json:"parent_order_item_id"`
+	StatusCode          string      `json:"statusCode"`
+	IsFinal             bool        `json:"isFinal"`
+}
+
+func (q *Queries) GetOrderItemsStatusForUpdate(ctx context.Context, id int64) (*GetOrderItemsStatusForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getOrderItemsStatusForUpdate, id)
+	var i GetOrderItemsStatusForUpdateRow
+	err := row.Scan(
+		&i.OrderID,
+		&i.ProductID,
+		&i.Quantity,
+		&i.IngredientsDeducted,
+		&i.ParentOrderItemID,
+		&i.StatusCode,
+		&i.IsFinal,
+	)
+	return &i, err
+}
+
 const getTotalAmountToPayForServedItems = `-- name: GetTotalAmountToPayForServedItems :one
-SELECT COALESCE(SUM(oi.price * oi.quantity), 0) AS "totalAmount"
+SELECT (COALESCE(SUM(oi.price * oi.quantity - oi.discount_amount), 0)
+    - public.order_buy_x_get_y_discount($1::bigint, TRUE))::numeric AS "totalAmount"
 FROM public.order_items oi
          JOIN public.md_order_statuses ms
               ON ms.id = oi.status_id
@@ -37,6 +37,17 @@ func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (int64
 	return id, err
 }
 
+const getOrderBuyXGetYDiscount = `-- name: GetOrderBuyXGetYDiscount :one
+SELECT public.order_buy_x_get_y_discount($1::bigint, FALSE)::numeric as "discount"
+`
+
+func (q *Queries) GetOrderBuyXGetYDiscount(ctx context.Context, orderID int64) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getOrderBuyXGetYDiscount, orderID)
+	var discount pgtype.Numeric
+	err := row.Scan(&discount)
+	return discount, err
+}
+
 const getOrderByID = `-- name: GetOrderByID :one
 SELECT o.id, o.session_id as "sessionID", o.table_id as "tableID", t.table_number as "tableNumber", o.status_id as "statusID", mos.name as "statusName", mos.name_en as "statusNameEN", mos.code as "statusCode"
 FROM public.orders as o
@@ -98,33 +109,45 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
          JOIN public.order_items oi ON oi.order_id = o.id
          JOIN public.md_order_statuses mos ON oi.status_id = mos.id
          JOIN public.tables t ON o.table_id = t.id
-WHERE o.id = $1::bigint
+WHERE o.id = $1::bigint AND oi.is_visible IS TRUE
 order by oi.id DESC
 `
 
 type GetOrderItemsByOrderIDRow struct {
-	OrderID       int64              `json:"orderID"`
-	OrderNumber   string             `json:"orderNumber"`
-	ID            int64              `json:"id"`
-	ProductID     int64              `json:"productID"`
-	ProductName   string             `json:"productName"`
-	ProductNameEN string             `json:"productNameEN"`
-	ImageURL      pgtype.Text        `json:"imageURL"`
-	Quantity      int32              `json:"quantity"`
-	Price         pgtype.Numeric     `json:"price"`
-	StatusID      int64              `json:"statusID"`
-	StatusName    string             `json:"statusName"`
-	StatusNameEN  string             `json:"statusNameEN"`
-	StatusCode    string             `json:"statusCode"`
-	Note          pgtype.Text        `json:"note"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	TableNumber   int32              `json:"tableNumber"`
+	OrderID           int64              `json:"orderID"`
+	OrderNumber       string             `json:"orderNumber"`
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"productID"`
+	ProductName       string             `json:"productName"`
+	ProductNameEN     string             `json:"productNameEN"`
+	ImageURL          pgtype.Text        `json:"imageURL"`
+	Quantity          int32              `json:"quantity"`
+	Price             pgtype.Numeric     `json:"price"`
+	StatusID          int64              `json:"statusID"`
+	StatusName        string             `json:"statusName"`
+	StatusNameEN      string             `json:"statusNameEN"`
+	StatusCode        string             `json:"statusCode"`
+	Note              pgtype.Text        `json:"note"`
+	Modifiers         []byte             `json:"modifiers"`
+	Allergens         []byte             `json:"allergens"`
+	VariantID         pgtype.Int8        `json:"variantID"`
+	VariantName       pgtype.Text        `json:"variantName"`
+	VariantNameEN     pgtype.Text        `json:"variantNameEN"`
+	ParentOrderItemID pgtype.Int8        `json:"parentOrderItemID"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	TableNumber       int32              `json:"tableNumber"`
 }
 
 func (q *Queries) GetOrderItemsByOrderID(ctx context.Context, orderID int64) ([]*GetOrderItemsByOrderIDRow, error) {
@@ -151,6 +174,12 @@ func (q *Queries) GetOrderItemsByOrderID(ctx context.Context, orderID int64) ([]
 			&i.StatusNameEN,
 			&i.StatusCode,
 			&i.Note,
+			&i.Modifiers,
+			&i.Allergens,
+			&i.VariantID,
+			&i.VariantName,
+			&i.VariantNameEN,
+			&i.ParentOrderItemID,
 			&i.CreatedAt,
 			&i.TableNumber,
 		); err != nil {
@@ -164,6 +193,45 @@ func (q *Queries) GetOrderItemsByOrderID(ctx context.Context, orderID int64) ([]
 	return items, nil
 }
 
+const getOrderStatusByID = `-- name: GetOrderStatusByID :one
+SELECT mos.code as "statusCode", mos.is_final as "isFinal"
+FROM public.orders as o
+JOIN public.md_order_statuses as mos ON o.status_id = mos.id
+WHERE o.id = $1::bigint
+`
+
+type GetOrderStatusByIDRow struct {
+	StatusCode string `json:"statusCode"`
+	IsFinal    bool   `json:"isFinal"`
+}
+
+func (q *Queries) GetOrderStatusByID(ctx context.Context, id int64) (*GetOrderStatusByIDRow, error) {
+	row := q.db.QueryRow(ctx, getOrderStatusByID, id)
+	var i GetOrderStatusByIDRow
+	err := row.Scan(&i.StatusCode, &i.IsFinal)
+	return &i, err
+}
+
+const getOrderStatusByIDForUpdate = `-- name: GetOrderStatusByIDForUpdate :one
+SELECT mos.code as "statusCode", mos.is_final as "isFinal"
+FROM public.orders as o
+JOIN public.md_order_statuses as mos ON o.status_id = mos.id
+WHERE o.id = $1::bigint
+    FOR UPDATE OF o
+`
+
+type GetOrderStatusByIDForUpdateRow struct {
+	StatusCode string `json:"statusCode"`
+	IsFinal    bool   `json:"isFinal"`
+}
+
+func (q *Queries) GetOrderStatusByIDForUpdate(ctx context.Context, id int64) (*GetOrderStatusByIDForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getOrderStatusByIDForUpdate, id)
+	var i GetOrderStatusByIDForUpdateRow
+	err := row.Scan(&i.StatusCode, &i.IsFinal)
+	return &i, err
+}
+
 const getOrderWithItems = `-- name: GetOrderWithItems :many
 SELECT o.id  AS "orderID",
        o.order_number as "orderNumber",
@@ -179,6 +247,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
@@ -197,22 +271,28 @@ type GetOrderWithItemsParams struct {
 }
 
 type GetOrderWithItemsRow struct {
-	OrderID       int64              `json:"orderID"`
-	OrderNumber   string             `json:"orderNumber"`
-	ID            int64              `json:"id"`
-	ProductID     int64              `json:"productID"`
-	ProductName   string             `json:"productName"`
-	ProductNameEN string             `json:"productNameEN"`
-	ImageURL      pgtype.Text        `json:"imageURL"`
-	Quantity      int32              `json:"quantity"`
-	Price         pgtype.Numeric     `json:"price"`
-	StatusID      int64              `json:"statusID"`
-	StatusName    string             `json:"statusName"`
-	StatusNameEN  string             `json:"statusNameEN"`
-	StatusCode    string             `json:"statusCode"`
-	Note          pgtype.Text        `json:"note"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	TableNumber   int32              `json:"tableNumber"`
+	OrderID           int64              `json:"orderID"`
+	OrderNumber       string             `json:"orderNumber"`
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"productID"`
+	ProductName       string             `json:"productName"`
+	ProductNameEN     string             `json:"productNameEN"`
+	ImageURL          pgtype.Text        `json:"imageURL"`
+	Quantity          int32              `json:"quantity"`
+	Price             pgtype.Numeric     `json:"price"`
+	StatusID          int64              `json:"statusID"`
+	StatusName        string             `json:"statusName"`
+	StatusNameEN      string             `json:"statusNameEN"`
+	StatusCode        string             `json:"statusCode"`
+	Note              pgtype.Text        `json:"note"`
+	Modifiers         []byte             `json:"modifiers"`
+	Allergens         []byte             `json:"allergens"`
+	VariantID         pgtype.Int8        `json:"variantID"`
+	VariantName       pgtype.Text        `json:"variantName"`
+	VariantNameEN     pgtype.Text        `json:"variantNameEN"`
+	ParentOrderItemID pgtype.Int8        `json:"parentOrderItemID"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	TableNumber       int32              `json:"tableNumber"`
 }
 
 func (q *Queries) GetOrderWithItems(ctx context.Context, arg GetOrderWithItemsParams) ([]*GetOrderWithItemsRow, error) {
@@ -239,6 +319,12 @@ func (q *Queries) GetOrderWithItems(ctx context.Context, arg GetOrderWithItemsPa
 			&i.StatusNameEN,
 			&i.StatusCode,
 			&i.Note,
+			&i.Modifiers,
+			&i.Allergens,
+			&i.VariantID,
+			&i.VariantName,
+			&i.VariantNameEN,
+			&i.ParentOrderItemID,
 			&i.CreatedAt,
 			&i.TableNumber,
 		); err != nil {
@@ -267,6 +353,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
@@ -282,22 +374,28 @@ type GetOrderWithItemsByIDParams struct {
 }
 
 type GetOrderWithItemsByIDRow struct {
-	OrderID       int64              `json:"orderID"`
-	OrderNumber   string             `json:"orderNumber"`
-	ID            int64              `json:"id"`
-	ProductID     int64              `json:"productID"`
-	ProductName   string             `json:"productName"`
-	ProductNameEN string             `json:"productNameEN"`
-	ImageURL      pgtype.Text        `json:"imageURL"`
-	Quantity      int32              `json:"quantity"`
-	Price         pgtype.Numeric     `json:"price"`
-	StatusID      int64              `json:"statusID"`
-	StatusName    string             `json:"statusName"`
-	StatusNameEN  string             `json:"statusNameEN"`
-	StatusCode    string             `json:"statusCode"`
-	Note          pgtype.Text        `json:"note"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	TableNumber   int32              `json:"tableNumber"`
+	OrderID           int64              `json:"orderID"`
+	OrderNumber       string             `json:"orderNumber"`
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"productID"`
+	ProductName       string             `json:"productName"`
+	ProductNameEN     string             `json:"productNameEN"`
+	ImageURL          pgtype.Text        `json:"imageURL"`
+	Quantity          int32              `json:"quantity"`
+	Price             pgtype.Numeric     `json:"price"`
+	StatusID          int64              `json:"statusID"`
+	StatusName        string             `json:"statusName"`
+	StatusNameEN      string             `json:"statusNameEN"`
+	StatusCode        string             `json:"statusCode"`
+	Note              pgtype.Text        `json:"note"`
+	Modifiers         []byte             `json:"modifiers"`
+	Allergens         []byte             `json:"allergens"`
+	VariantID         pgtype.Int8        `json:"variantID"`
+	VariantName       pgtype.Text        `json:"variantName"`
+	VariantNameEN     pgtype.Text        `json:"variantNameEN"`
+	ParentOrderItemID pgtype.Int8        `json:"parentOrderItemID"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	TableNumber       int32              `json:"tableNumber"`
 }
 
 func (q *Queries) GetOrderWithItemsByID(ctx context.Context, arg GetOrderWithItemsByIDParams) (*GetOrderWithItemsByIDRow, error) {
@@ -318,6 +416,12 @@ func (q *Queries) GetOrderWithItemsByID(ctx context.Context, arg GetOrderWithIte
 		&i.StatusNameEN,
 		&i.StatusCode,
 		&i.Note,
+		&i.Modifiers,
+		&i.Allergens,
+		&i.VariantID,
+		&i.VariantName,
+		&i.VariantNameEN,
+		&i.ParentOrderItemID,
 		&i.CreatedAt,
 		&i.TableNumber,
 	)
@@ -339,6 +443,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at,
        t.table_number as "tableNumber"
 FROM public.orders o
@@ -350,22 +460,28 @@ order by oi.id DESC
 `
 
 type GetOrderWithItemsGroupIDRow struct {
-	OrderID       int64              `json:"orderID"`
-	OrderNumber   string             `json:"orderNumber"`
-	ID            int64              `json:"id"`
-	ProductID     int64              `json:"productID"`
-	ProductName   string             `json:"productName"`
-	ProductNameEN string             `json:"productNameEN"`
-	ImageURL      pgtype.Text        `json:"imageURL"`
-	Quantity      int32              `json:"quantity"`
-	Price         pgtype.Numeric     `json:"price"`
-	StatusID      int64              `json:"statusID"`
-	StatusName    string             `json:"statusName"`
-	StatusNameEN  string             `json:"statusNameEN"`
-	StatusCode    string             `json:"statusCode"`
-	Note          pgtype.Text        `json:"note"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
-	TableNumber   int32              `json:"tableNumber"`
+	OrderID           int64              `json:"orderID"`
+	OrderNumber       string             `json:"orderNumber"`
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"productID"`
+	ProductName       string             `json:"productName"`
+	ProductNameEN     string             `json:"productNameEN"`
+	ImageURL          pgtype.Text        `json:"imageURL"`
+	Quantity          int32              `json:"quantity"`
+	Price             pgtype.Numeric     `json:"price"`
+	StatusID          int64              `json:"statusID"`
+	StatusName        string             `json:"statusName"`
+	StatusNameEN      string             `json:"statusNameEN"`
+	StatusCode        string             `json:"statusCode"`
+	Note              pgtype.Text        `json:"note"`
+	Modifiers         []byte             `json:"modifiers"`
+	Allergens         []byte             `json:"allergens"`
+	VariantID         pgtype.Int8        `json:"variantID"`
+	VariantName       pgtype.Text        `json:"variantName"`
+	VariantNameEN     pgtype.Text        `json:"variantNameEN"`
+	ParentOrderItemID pgtype.Int8        `json:"parentOrderItemID"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	TableNumber       int32              `json:"tableNumber"`
 }
 
 func (q *Queries) GetOrderWithItemsGroupID(ctx context.Context, orderItemsID []int64) ([]*GetOrderWithItemsGroupIDRow, error) {
@@ -392,6 +508,12 @@ func (q *Queries) GetOrderWithItemsGroupID(ctx context.Context, orderItemsID []i
 			&i.StatusNameEN,
 			&i.StatusCode,
 			&i.Note,
+			&i.Modifiers,
+			&i.Allergens,
+			&i.VariantID,
+			&i.VariantName,
+			&i.VariantNameEN,
+			&i.ParentOrderItemID,
 			&i.CreatedAt,
 			&i.TableNumber,
 		); err != nil {
@@ -564,6 +686,88 @@ func (q *Queries) IsOrderWithItemsExists(ctx context.Context, arg IsOrderWithIte
 	return isExists, err
 }
 
+const listBillItemsByOrderID = `-- name: ListBillItemsByOrderID :many
+SELECT oi.id,
+       oi.product_id as "productID",
+       oi.product_name as "productName",
+       oi.product_name_en as "productNameEN",
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.price,
+       oi.quantity,
+       oi.discount_amount as "discountAmount",
+       (oi.price * oi.quantity - oi.discount_amount)::numeric as "amount",
+       mos.code as "statusCode",
+       oi.promotion_id as "promotionID",
+       pr.name as "promotionName",
+       pr.name_en as "promotionNameEN",
+       pr.promotion_type as "promotionType"
+FROM public.order_items oi
+         JOIN public.md_order_statuses mos ON oi.status_id = mos.id
+         LEFT JOIN public.promotions pr ON pr.id = oi.promotion_id
+WHERE oi.order_id = $1::bigint
+  AND oi.parent_order_item_id IS NULL
+  AND mos.code != 'CANCELLED'
+ORDER BY oi.created_at, oi.id
+`
+
+type ListBillItemsByOrderIDRow struct {
+	ID              int64          `json:"id"`
+	ProductID       int64          `json:"productID"`
+	ProductName     string         `json:"productName"`
+	ProductNameEN   string         `json:"productNameEN"`
+	VariantID       pgtype.Int8    `json:"variantID"`
+	VariantName     pgtype.Text    `json:"variantName"`
+	VariantNameEN   pgtype.Text    `json:"variantNameEN"`
+	Price           pgtype.Numeric `json:"price"`
+	Quantity        int32          `json:"quantity"`
+	DiscountAmount  pgtype.Numeric `json:"discountAmount"`
+	Amount          pgtype.Numeric `json:"amount"`
+	StatusCode      string         `json:"statusCode"`
+	PromotionID     pgtype.Int8    `json:"promotionID"`
+	PromotionName   pgtype.Text    `json:"promotionName"`
+	PromotionNameEN pgtype.Text    `json:"promotionNameEN"`
+	PromotionType   pgtype.Text    `json:"promotionType"`
+}
+
+func (q *Queries) ListBillItemsByOrderID(ctx context.Context, orderID int64) ([]*ListBillItemsByOrderIDRow, error) {
+	rows, err := q.db.Query(ctx, listBillItemsByOrderID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListBillItemsByOrderIDRow{}
+	for rows.Next() {
+		var i ListBillItemsByOrderIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.ProductName,
+			&i.ProductNameEN,
+			&i.VariantID,
+			&i.VariantName,
+			&i.VariantNameEN,
+			&i.Price,
+			&i.Quantity,
+			&i.DiscountAmount,
+			&i.Amount,
+			&i.StatusCode,
+			&i.PromotionID,
+			&i.PromotionName,
+			&i.PromotionNameEN,
+			&i.PromotionType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchOrderItems = `-- name: SearchOrderItems :many
 SELECT o.id  AS "orderID",
        o.order_number as "orderNumber",
@@ -580,6 +784,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at
 FROM public.orders o
          JOIN public.order_items oi ON oi.order_id = o.id
@@ -633,22 +843,28 @@ type SearchOrderItemsParams struct {
 }
 
 type SearchOrderItemsRow struct {
-	OrderID       int64              `json:"orderID"`
-	OrderNumber   string             `json:"orderNumber"`
-	ID            int64              `json:"id"`
-	ProductID     int64              `json:"productID"`
-	ProductName   string             `json:"productName"`
-	ProductNameEN string             `json:"productNameEN"`
-	ImageURL      pgtype.Text        `json:"imageURL"`
-	TableNumber   int32              `json:"tableNumber"`
-	Quantity      int32              `json:"quantity"`
-	Price         pgtype.Numeric     `json:"price"`
-	StatusID      int64              `json:"statusID"`
-	StatusName    string             `json:"statusName"`
-	StatusNameEN  string             `json:"statusNameEN"`
-	StatusCode    string             `json:"statusCode"`
-	Note          pgtype.Text        `json:"note"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	OrderID           int64              `json:"orderID"`
+	OrderNumber       string             `json:"orderNumber"`
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"productID"`
+	ProductName       string             `json:"productName"`
+	ProductNameEN     string             `json:"productNameEN"`
+	ImageURL          pgtype.Text        `json:"imageURL"`
+	TableNumber       int32              `json:"tableNumber"`
+	Quantity          int32              `json:"quantity"`
+	Price             pgtype.Numeric     `json:"price"`
+	StatusID          int64              `json:"statusID"`
+	StatusName        string             `json:"statusName"`
+	StatusNameEN      string             `json:"statusNameEN"`
+	StatusCode        string             `json:"statusCode"`
+	Note              pgtype.Text        `json:"note"`
+	Modifiers         []byte             `json:"modifiers"`
+	Allergens         []byte             `json:"allergens"`
+	VariantID         pgtype.Int8        `json:"variantID"`
+	VariantName       pgtype.Text        `json:"variantName"`
+	VariantNameEN     pgtype.Text        `json:"variantNameEN"`
+	ParentOrderItemID pgtype.Int8        `json:"parentOrderItemID"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
 }
 
 func (q *Queries) SearchOrderItems(ctx context.Context, arg SearchOrderItemsParams) ([]*SearchOrderItemsRow, error) {
@@ -684,6 +900,12 @@ func (q *Queries) SearchOrderItems(ctx context.Context, arg SearchOrderItemsPara
 			&i.StatusNameEN,
 			&i.StatusCode,
 			&i.Note,
+			&i.Modifiers,
+			&i.Allergens,
+			&i.VariantID,
+			&i.VariantName,
+			&i.VariantNameEN,
+			&i.ParentOrderItemID,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -712,6 +934,12 @@ SELECT o.id  AS "orderID",
        mos.name_en as "statusNameEN",
        mos.code as "statusCode",
        oi.note as "note",
+       oi.modifiers,
+       oi.allergens,
+       oi.variant_id as "variantID",
+       oi.variant_name as "variantName",
+       oi.variant_name_en as "variantNameEN",
+       oi.parent_order_item_id as "parentOrderItemID",
        oi.created_at
 FROM public.orders o
          JOIN public.order_items oi ON oi.order_id = o.id
@@ -758,22 +986,28 @@ type SearchOrderItemsIsNotFinalParams struct {
 }
 
 type SearchOrderItemsIsNotFinalRow struct {
-	OrderID       int64              `json:"orderID"`
-	OrderNumber   string             `json:"orderNumber"`
-	ID            int64              `json:"id"`
-	ProductID     int64              `json:"productID"`
-	ProductName   string             `json:"productName"`
-	ProductNameEN string             `json:"productNameEN"`
-	ImageURL      pgtype.Text        `json:"imageURL"`
-	TableNumber   int32              `json:"tableNumber"`
-	Quantity      int32              `json:"quantity"`
-	Price         pgtype.Numeric     `json:"price"`
-	StatusID      int64              `json:"statusID"`
-	StatusName    string             `json:"statusName"`
-	StatusNameEN  string             `json:"statusNameEN"`
-	StatusCode    string             `json:"statusCode"`
-	Note          pgtype.Text        `json:"note"`
-	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	OrderID           int64              `json:"orderID"`
+	OrderNumber       string             `json:"orderNumber"`
+	ID                int64              `json:"id"`
+	ProductID         int64              `json:"productID"`
+	ProductName       string             `json:"productName"`
+	ProductNameEN     string             `json:"productNameEN"`
+	ImageURL          pgtype.Text        `json:"imageURL"`
+	TableNumber       int32              `json:"tableNumber"`
+	Quantity          int32              `json:"quantity"`
+	Price             pgtype.Numeric     `json:"price"`
+	StatusID          int64              `json:"statusID"`
+	StatusName        string             `json:"statusName"`
+	StatusNameEN      string             `json:"statusNameEN"`
+	StatusCode        string             `json:"statusCode"`
+	Note              pgtype.Text        `json:"note"`
+	Modifiers         []byte             `json:"modifiers"`
+	Allergens         []byte             `json:"allergens"`
+	VariantID         pgtype.Int8        `json:"variantID"`
+	VariantName       pgtype.Text        `json:"variantName"`
+	VariantNameEN     pgtype.Text        `json:"variantNameEN"`
+	ParentOrderItemID pgtype.Int8        `json:"parentOrderItemID"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
 }
 
 func (q *Queries) SearchOrderItemsIsNotFinal(ctx context.Context, arg SearchOrderItemsIsNotFinalParams) ([]*SearchOrderItemsIsNotFinalRow, error) {
@@ -809,6 +1043,12 @@ func (q *Queries) SearchOrderItemsIsNotFinal(ctx context.Context, arg SearchOrde
 			&i.StatusNameEN,
 			&i.StatusCode,
 			&i.Note,
+			&i.Modifiers,
+			&i.Allergens,
+			&i.VariantID,
+			&i.VariantName,
+			&i.VariantNameEN,
+			&i.ParentOrderItemID,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -0,0 +1,232 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox_events.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+WITH heads AS (SELECT e.id, e.topic, e.message_key, e.seq
+               FROM public.outbox_events e
+               WHERE e.sent_at IS NULL
+                 AND e.next_attempt_at <= NOW()
+                 AND NOT EXISTS (SELECT 1
+                                 FROM public.outbox_events earlier
+                                 WHERE earlier.topic = e.topic
+                                   AND earlier.message_key = e.message_key
+                                   AND earlier.seq < e.seq
+                                   AND earlier.sent_at IS NULL)
+               ORDER BY e.seq
+               LIMIT $2::int FOR UPDATE SKIP LOCKED),
+     runs AS (SELECT e.id, row_number() OVER (ORDER BY e.seq) AS position
+              FROM heads h
+                       JOIN public.outbox_events e ON e.topic = h.topic
+                  AND (e.id = h.id OR e.message_key = h.message_key)
+                  AND e.seq >= h.seq
+              WHERE e.sent_at IS NULL)
+UPDATE public.outbox_events
+SET next_attempt_at = NOW() + make_interval(secs => $1::int)
+WHERE id IN (SELECT r.id FROM runs r WHERE r.position <= $2::int)
+RETURNING id, seq, topic, message_key, payload, attempts
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseSeconds int32 `json:"lease_seconds"`
+	BatchSize    int32 `json:"batch_size"`
+}
+
+type ClaimOutboxEventsRow struct {
+	ID         int64       `json:"id"`
+	Seq        int64       `json:"seq"`
+	Topic      string      `json:"topic"`
+	MessageKey pgtype.Text `json:"message_key"`
+	Payload    []byte      `json:"payload"`
+	Attempts   int32       `json:"attempts"`
+}
+
+// claims the pending events of each key in seq order. Only a key whose first pending event is due is claimed,
+// and locking that event keeps other relays off the key, so a later event can never overtake an earlier one.
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]*ClaimOutboxEventsRow, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents, arg.LeaseSeconds, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ClaimOutboxEventsRow{}
+	for rows.Next() {
+		var i ClaimOutboxEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seq,
+			&i.Topic,
+			&i.MessageKey,
+			&i.Payload,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO public.outbox_events (id, seq, topic, message_key, payload)
+VALUES ($1::bigint, $2::bigint, $3::varchar, $4::varchar, $5::jsonb)
+`
+
+type CreateOutboxEventParams struct {
+	ID         int64       `json:"id"`
+	Seq        int64       `json:"seq"`
+	Topic      string      `json:"topic"`
+	MessageKey pgtype.Text `json:"message_key"`
+	Payload    []byte      `json:"payload"`
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, createOutboxEvent,
+		arg.ID,
+		arg.Seq,
+		arg.Topic,
+		arg.MessageKey,
+		arg.Payload,
+	)
+	return err
+}
+
+const deleteSentOutboxEvents = `-- name: DeleteSentOutboxEvents :execrows
+DELETE FROM public.outbox_events
+WHERE sent_at < NOW() - make_interval(days => $1::int)
+`
+
+func (q *Queries) DeleteSentOutboxEvents(ctx context.Context, retentionDays int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSentOutboxEvents, retentionDays)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLastOutboxEventIDByKey = `-- name: GetLastOutboxEventIDByKey :one
+SELECT COALESCE(MAX(seq), 0)::bigint as "lastSeq"
+FROM public.outbox_events
+WHERE topic = $1::varchar
+  AND message_key = $2::varchar
+`
+
+type GetLastOutboxEventIDByKeyParams struct {
+	Topic      string `json:"topic"`
+	MessageKey string `json:"message_key"`
+}
+
+func (q *Queries) GetLastOutboxEventIDByKey(ctx context.Context, arg GetLastOutboxEventIDByKeyParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastOutboxEventIDByKey, arg.Topic, arg.MessageKey)
+	var lastSeq int64
+	err := row.Scan(&lastSeq)
+	return lastSeq, err
+}
+
+const listOutboxEventsByKey = `-- name: ListOutboxEventsByKey :many
+SELECT seq, payload
+FROM public.outbox_events
+WHERE topic = $1::varchar
+  AND message_key = $2::varchar
+  AND seq > $3::bigint
+ORDER BY seq
+LIMIT $4::int
+`
+
+type ListOutboxEventsByKeyParams struct {
+	Topic      string `json:"topic"`
+	MessageKey string `json:"message_key"`
+	AfterSeq   int64  `json:"after_seq"`
+	MaxResults int32  `json:"max_results"`
+}
+
+type ListOutboxEventsByKeyRow struct {
+	Seq     int64  `json:"seq"`
+	Payload []byte `json:"payload"`
+}
+
+func (q *Queries) ListOutboxEventsByKey(ctx context.Context, arg ListOutboxEventsByKeyParams) ([]*ListOutboxEventsByKeyRow, error) {
+	rows, err := q.db.Query(ctx, listOutboxEventsByKey,
+		arg.Topic,
+		arg.MessageKey,
+		arg.AfterSeq,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListOutboxEventsByKeyRow{}
+	for rows.Next() {
+		var i ListOutboxEventsByKeyRow
+		if err := rows.Scan(&i.Seq, &i.Payload); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE public.outbox_events
+SET attempts        = attempts + 1,
+    last_error      = $1::text,
+    next_attempt_at = NOW() + make_interval(secs => LEAST(power(2, attempts + 1), $2::int)::int)
+WHERE id = $3::bigint
+`
+
+type MarkOutboxEventFailedParams struct {
+	LastError         string `json:"last_error"`
+	MaxBackoffSeconds int32  `json:"max_backoff_seconds"`
+	ID                int64  `json:"id"`
+}
+
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxEventFailed, arg.LastError, arg.MaxBackoffSeconds, arg.ID)
+	return err
+}
+
+const markOutboxEventSent = `-- name: MarkOutboxEventSent :exec
+UPDATE public.outbox_events
+SET sent_at    = NOW(),
+    last_error = NULL
+WHERE id = $1::bigint
+`
+
+func (q *Queries) MarkOutboxEventSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markOutboxEventSent, id)
+	return err
+}
+
+const nextOutboxEventSeq = `-- name: NextOutboxEventSeq :one
+SELECT nextval('public.outbox_events_seq_seq')::bigint AS seq
+FROM (SELECT pg_advisory_xact_lock(hashtextextended($1::varchar || ':' || $2::varchar, 0))) key_lock
+`
+
+type NextOutboxEventSeqParams struct {
+	Topic      string `json:"topic"`
+	MessageKey string `json:"message_key"`
+}
+
+// the key stays locked until the transaction ends, so events of one key get their seq in commit order
+func (q *Queries) NextOutboxEventSeq(ctx context.Context, arg NextOutboxEventSeqParams) (int64, error) {
+	row := q.db.QueryRow(ctx, nextOutboxEventSeq, arg.Topic, arg.MessageKey)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
@@ -0,0 +1,200 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_associations.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteProductAssociations = `-- name: DeleteProductAssociations :exec
+DELETE FROM public.product_associations
+`
+
+func (q *Queries) DeleteProductAssociations(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteProductAssociations)
+	return err
+}
+
+const listRecommendedProducts = `-- name: ListRecommendedProducts :many
+SELECT p.id,
+       p."name",
+       p.name_en,
+       p.categories,
+       c.name as "categoryName",
+       c.name_en as "categoryNameEN",
+       p.description,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
+       p.is_available,
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
+FROM (SELECT pa.associated_product_id, SUM(pa.confidence * LEAST(pa.lift, 10)) as score
+      FROM public.product_associations pa
+      WHERE pa.product_id = ANY ($1::bigint[])
+        AND pa.lift > 1
+        AND NOT pa.associated_product_id = ANY ($1::bigint[])
+      GROUP BY pa.associated_product_id) r
+         INNER JOIN public.products as p ON p.id = r.associated_product_id
+         INNER JOIN public.md_categories as c ON c.id = p.categories
+WHERE p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND (p.stock_quantity IS NULL OR p.stock_quantity > 0)
+  AND public.is_within_menu_schedule(p.id, p.categories, $2::text)
+  AND ($3::bigint IS NULL
+    OR p.id IN (SELECT (e.value ->> 'id')::bigint
+                FROM public.menu_versions v
+                         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') e
+                WHERE v.id = $3::bigint))
+  AND (
+    $4::bigint[] IS NULL
+        OR array_length($4::bigint[], 1) = 0
+        OR p.categories = ANY ($4::bigint[])
+    )
+ORDER BY r.score DESC, p.id
+LIMIT $5::int
+`
+
+type ListRecommendedProductsParams struct {
+	ProductIds    []int64     `json:"product_ids"`
+	TimeZone      string      `json:"time_zone"`
+	MenuVersionID pgtype.Int8 `json:"menu_version_id"`
+	CategoryID    []int64     `json:"category_id"`
+	MaxResults    int32       `json:"max_results"`
+}
+
+type ListRecommendedProductsRow struct {
+	ID             int64          `json:"id"`
+	Name           string         `json:"name"`
+	NameEn         string         `json:"name_en"`
+	Categories     int64          `json:"categories"`
+	CategoryName   string         `json:"categoryName"`
+	CategoryNameEN string         `json:"categoryNameEN"`
+	Description    pgtype.Text    `json:"description"`
+	Price          pgtype.Numeric `json:"price"`
+	IsAvailable    bool           `json:"is_available"`
+	ImageUrl       pgtype.Text    `json:"image_url"`
+	ThumbnailUrl   pgtype.Text    `json:"thumbnail_url"`
+	StockQuantity  pgtype.Int4    `json:"stock_quantity"`
+}
+
+func (q *Queries) ListRecommendedProducts(ctx context.Context, arg ListRecommendedProductsParams) ([]*ListRecommendedProductsRow, error) {
+	rows, err := q.db.Query(ctx, listRecommendedProducts,
+		arg.ProductIds,
+		arg.TimeZone,
+		arg.MenuVersionID,
+		arg.CategoryID,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListRecommendedProductsRow{}
+	for rows.Next() {
+		var i ListRecommendedProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.NameEn,
+			&i.Categories,
+			&i.CategoryName,
+			&i.CategoryNameEN,
+			&i.Description,
+			&i.Price,
+			&i.IsAvailable,
+			&i.ImageUrl,
+			&i.ThumbnailUrl,
+			&i.StockQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionOrderedProductIDs = `-- name: ListSessionOrderedProductIDs :many
+SELECT DISTINCT oi.product_id
+FROM public.order_items oi
+         JOIN public.orders o ON o.id = oi.order_id
+WHERE o.session_id = $1::uuid
+  AND oi.parent_order_item_id IS NULL
+`
+
+func (q *Queries) ListSessionOrderedProductIDs(ctx context.Context, sessionID pgtype.UUID) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listSessionOrderedProductIDs, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var product_id int64
+		if err := rows.Scan(&product_id); err != nil {
+			return nil, err
+		}
+		items = append(items, product_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshProductAssociations = `-- name: RefreshProductAssociations :execrows
+WITH baskets AS (SELECT DISTINCT oi.order_id, oi.product_id
+                 FROM public.order_items oi
+                          JOIN public.md_order_statuses mos ON mos.id = oi.status_id
+                 WHERE oi.parent_order_item_id IS NULL
+                   AND mos.code <> 'CANCELLED'
+                   AND oi.created_at >= NOW() - make_interval(days => $1::int)),
+     total AS (SELECT COUNT(DISTINCT order_id)::numeric as orders FROM baskets),
+     product_orders AS (SELECT product_id, COUNT(*)::numeric as orders FROM baskets GROUP BY product_id),
+     pairs AS (SELECT a.product_id, b.product_id as associated_product_id, COUNT(*) as order_count
+               FROM baskets a
+                        JOIN baskets b ON b.order_id = a.order_id AND b.product_id <> a.product_id
+               GROUP BY a.product_id, b.product_id
+               HAVING COUNT(*) >= $2::int)
+INSERT INTO public.product_associations (product_id, associated_product_id, order_count, support, confidence, lift)
+SELECT p.product_id,
+       p.associated_product_id,
+       p.order_count,
+       p.order_count / t.orders,
+       p.order_count / pa.orders,
+       (p.order_count / pa.orders) / (pb.orders / t.orders)
+FROM pairs p
+         CROSS JOIN total t
+         JOIN product_orders pa ON pa.product_id = p.product_id
+         JOIN product_orders pb ON pb.product_id = p.associated_product_id
+`
+
+type RefreshProductAssociationsParams struct {
+	LookbackDays  int32 `json:"lookback_days"`
+	MinOrderCount int32 `json:"min_order_count"`
+}
+
+func (q *Queries) RefreshProductAssociations(ctx context.Context, arg RefreshProductAssociationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, refreshProductAssociations, arg.LookbackDays, arg.MinOrderCount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const tryLockProductAssociations = `-- name: TryLockProductAssociations :one
+SELECT pg_try_advisory_xact_lock(hashtext('product_associations'))::boolean as "locked"
+`
+
+func (q *Queries) TryLockProductAssociations(ctx context.Context) (bool, error) {
+	row := q.db.QueryRow(ctx, tryLockProductAssociations)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_combos.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createComboSlot = `-- name: CreateComboSlot :exec
+INSERT INTO public.combo_slots (id, combo_product_id, "name", name_en, sort_order)
+VALUES ($1::bigint, $2::bigint, $3::varchar, $4::varchar, $5::int)
+`
+
+type CreateComboSlotParams struct {
+	ID             int64  `json:"id"`
+	ComboProductID int64  `json:"combo_product_id"`
+	Name           string `json:"name"`
+	NameEn         string `json:"name_en"`
+	SortOrder      int32  `json:"sort_order"`
+}
+
+func (q *Queries) CreateComboSlot(ctx context.Context, arg CreateComboSlotParams) error {
+	_, err := q.db.Exec(ctx, createComboSlot,
+		arg.ID,
+		arg.ComboProductID,
+		arg.Name,
+		arg.NameEn,
+		arg.SortOrder,
+	)
+	return err
+}
+
+const createComboSlotOption = `-- name: CreateComboSlotOption :exec
+INSERT INTO public.combo_slot_options (slot_id, product_id, price_delta, sort_order)
+VALUES ($1::bigint, $2::bigint, $3::numeric, $4::int)
+`
+
+type CreateComboSlotOptionParams struct {
+	SlotID     int64          `json:"slot_id"`
+	ProductID  int64          `json:"product_id"`
+	PriceDelta pgtype.Numeric `json:"price_delta"`
+	SortOrder  int32          `json:"sort_order"`
+}
+
+func (q *Queries) CreateComboSlotOption(ctx context.Context, arg CreateComboSlotOptionParams) error {
+	_, err := q.db.Exec(ctx, createComboSlotOption,
+		arg.SlotID,
+		arg.ProductID,
+		arg.PriceDelta,
+		arg.SortOrder,
+	)
+	return err
+}
+
+const deleteComboSlotOptionsBySlotID = `-- name: DeleteComboSlotOptionsBySlotID :exec
+DELETE FROM public.combo_slot_options WHERE slot_id = $1::bigint
+`
+
+func (q *Queries) DeleteComboSlotOptionsBySlotID(ctx context.Context, slotID int64) error {
+	_, err := q.db.Exec(ctx, deleteComboSlotOptionsBySlotID, slotID)
+	return err
+}
+
+const deleteComboSlotsExcept = `-- name: DeleteComboSlotsExcept :exec
+UPDATE public.combo_slots
+SET deleted_at = NOW()
+WHERE combo_product_id = $1::bigint
+  AND deleted_at IS NULL
+  AND NOT (id = ANY ($2::bigint[]))
+`
+
+type DeleteComboSlotsExceptParams struct {
+	ComboProductID int64   `json:"combo_product_id"`
+	KeepIds        []int64 `json:"keep_ids"`
+}
+
+func (q *Queries) DeleteComboSlotsExcept(ctx context.Context, arg DeleteComboSlotsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteComboSlotsExcept, arg.ComboProductID, arg.KeepIds)
+	return err
+}
+
+const listComboSlotOptionsBySlotIDs = `-- name: ListComboSlotOptionsBySlotIDs :many
+SELECT o.slot_id as "slotID",
+       o.product_id as "productID",
+       p."name",
+       p.name_en as "nameEN",
+       p.image_url as "imageURL",
+       o.price_delta as "priceDelta",
+       (p.is_available AND p.is_visible)::boolean as "isAvailable"
+FROM public.combo_slot_options o
+         JOIN public.products p ON p.id = o.product_id
+WHERE o.slot_id = ANY($1::bigint[])
+ORDER BY o.slot_id, o.sort_order
+`
+
+type ListComboSlotOptionsBySlotIDsRow struct {
+	SlotID      int64          `json:"slotID"`
+	ProductID   int64          `json:"productID"`
+	Name        string         `json:"name"`
+	NameEN      string         `json:"nameEN"`
+	ImageURL    pgtype.Text    `json:"imageURL"`
+	PriceDelta  pgtype.Numeric `json:"priceDelta"`
+	IsAvailable bool           `json:"isAvailable"`
+}
+
+func (q *Queries) ListComboSlotOptionsBySlotIDs(ctx context.Context, slotIds []int64) ([]*ListComboSlotOptionsBySlotIDsRow, error) {
+	rows, err := q.db.Query(ctx, listComboSlotOptionsBySlotIDs, slotIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListComboSlotOptionsBySlotIDsRow{}
+	for rows.Next() {
+		var i ListComboSlotOptionsBySlotIDsRow
+		if err := rows.Scan(
+			&i.SlotID,
+			&i.ProductID,
+			&i.Name,
+			&i.NameEN,
+			&i.ImageURL,
+			&i.PriceDelta,
+			&i.IsAvailable,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listComboSlotsByProductIDs = `-- name: ListComboSlotsByProductIDs :many
+SELECT id, combo_product_id as "comboProductID", "name", name_en as "nameEN"
+FROM public.combo_slots
+WHERE combo_product_id = ANY($1::bigint[])
+  AND deleted_at IS NULL
+ORDER BY combo_product_id, sort_order, id
+`
+
+type ListComboSlotsByProductIDsRow struct {
+	ID             int64  `json:"id"`
+	ComboProductID int64  `json:"comboProductID"`
+	Name           string `json:"name"`
+	NameEN         string `json:"nameEN"`
+}
+
+func (q *Queries) ListComboSlotsByProductIDs(ctx context.Context, productIds []int64) ([]*ListComboSlotsByProductIDsRow, error) {
+	rows, err := q.db.Query(ctx, listComboSlotsByProductIDs, productIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListComboSlotsByProductIDsRow{}
+	for rows.Next() {
+		var i ListComboSlotsByProductIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ComboProductID,
+			&i.Name,
+			&i.NameEN,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateComboSlot = `-- name: UpdateComboSlot :execrows
+UPDATE public.combo_slots
+SET "name"     = $1::varchar,
+    name_en    = $2::varchar,
+    sort_order = $3::int,
+    updated_at = NOW()
+WHERE id = $4::bigint
+  AND combo_product_id = $5::bigint
+  AND deleted_at IS NULL
+`
+
+type UpdateComboSlotParams struct {
+	Name           string `json:"name"`
+	NameEn         string `json:"name_en"`
+	SortOrder      int32  `json:"sort_order"`
+	ID             int64  `json:"id"`
+	ComboProductID int64  `json:"combo_product_id"`
+}
+
+func (q *Queries) UpdateComboSlot(ctx context.Context, arg UpdateComboSlotParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateComboSlot,
+		arg.Name,
+		arg.NameEn,
+		arg.SortOrder,
+		arg.ID,
+		arg.ComboProductID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_prices.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createScheduledProductPrice = `-- name: CreateScheduledProductPrice :execrows
+INSERT INTO public.product_prices (id, product_id, price, effective_from)
+SELECT $1::bigint, $2::bigint, $3::numeric, $4::timestamptz
+WHERE $4::timestamptz > NOW()
+`
+
+type CreateScheduledProductPriceParams struct {
+	ID            int64              `json:"id"`
+	ProductID     int64              `json:"product_id"`
+	Price         pgtype.Numeric     `json:"price"`
+	EffectiveFrom pgtype.Timestamptz `json:"effective_from"`
+}
+
+func (q *Queries) CreateScheduledProductPrice(ctx context.Context, arg CreateScheduledProductPriceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createScheduledProductPrice,
+		arg.ID,
+		arg.ProductID,
+		arg.Price,
+		arg.EffectiveFrom,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteScheduledProductPrice = `-- name: DeleteScheduledProductPrice :execrows
+DELETE FROM public.product_prices
+WHERE id = $1::bigint
+  AND product_id = $2::bigint
+  AND effective_from > NOW()
+`
+
+type DeleteScheduledProductPriceParams struct {
+	ID        int64 `json:"id"`
+	ProductID int64 `json:"product_id"`
+}
+
+func (q *Queries) DeleteScheduledProductPrice(ctx context.Context, arg DeleteScheduledProductPriceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteScheduledProductPrice, arg.ID, arg.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getProductPriceAt = `-- name: GetProductPriceAt :one
+SELECT public.product_price_at($1::bigint, $2::timestamptz)::numeric as "price"
+`
+
+type GetProductPriceAtParams struct {
+	ProductID int64              `json:"product_id"`
+	PriceAt   pgtype.Timestamptz `json:"price_at"`
+}
+
+func (q *Queries) GetProductPriceAt(ctx context.Context, arg GetProductPriceAtParams) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, getProductPriceAt, arg.ProductID, arg.PriceAt)
+	var price pgtype.Numeric
+	err := row.Scan(&price)
+	return price, err
+}
+
+const listProductPrices = `-- name: ListProductPrices :many
+SELECT pp.id,
+       pp.price,
+       pp.effective_from as "effectiveFrom",
+       pp.created_at as "createdAt",
+       (pp.effective_from > NOW())::boolean as "isScheduled",
+       (pp.effective_from = (SELECT MAX(c.effective_from)
+                             FROM public.product_prices c
+                             WHERE c.product_id = pp.product_id
+                               AND c.effective_from <= NOW()))::boolean as "isCurrent"
+FROM public.product_prices pp
+WHERE pp.product_id = $1::bigint
+ORDER BY pp.effective_from DESC
+`
+
+type ListProductPricesRow struct {
+	ID            int64              `json:"id"`
+	Price         pgtype.Numeric     `json:"price"`
+	EffectiveFrom pgtype.Timestamptz `json:"effectiveFrom"`
+	CreatedAt     pgtype.Timestamptz `json:"createdAt"`
+	IsScheduled   bool               `json:"isScheduled"`
+	IsCurrent     bool               `json:"isCurrent"`
+}
+
+func (q *Queries) ListProductPrices(ctx context.Context, productID int64) ([]*ListProductPricesRow, error) {
+	rows, err := q.db.Query(ctx, listProductPrices, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProductPricesRow{}
+	for rows.Next() {
+		var i ListProductPricesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Price,
+			&i.EffectiveFrom,
+			&i.CreatedAt,
+			&i.IsScheduled,
+			&i.IsCurrent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordProductPriceChange = `-- name: RecordProductPriceChange :exec
+INSERT INTO public.product_prices (id, product_id, price, effective_from, menu_version_id)
+SELECT $1::bigint, $2::bigint, $3::numeric,
+       COALESCE($4::timestamptz, NOW()), $5::bigint
+WHERE public.product_price_at($2::bigint, COALESCE($4::timestamptz, NOW())) IS DISTINCT FROM $3::numeric
+ON CONFLICT (product_id, effective_from) DO UPDATE SET price = EXCLUDED.price, menu_version_id = EXCLUDED.menu_version_id
+`
+
+type RecordProductPriceChangeParams struct {
+	ID            int64              `json:"id"`
+	ProductID     int64              `json:"product_id"`
+	Price         pgtype.Numeric     `json:"price"`
+	EffectiveFrom pgtype.Timestamptz `json:"effective_from"`
+	MenuVersionID pgtype.Int8        `json:"menu_version_id"`
+}
+
+func (q *Queries) RecordProductPriceChange(ctx context.Context, arg RecordProductPriceChangeParams) error {
+	_, err := q.db.Exec(ctx, recordProductPriceChange,
+		arg.ID,
+		arg.ProductID,
+		arg.Price,
+		arg.EffectiveFrom,
+		arg.MenuVersionID,
+	)
+	return err
+}
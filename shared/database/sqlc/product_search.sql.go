@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_search.sql
+
+package database
+
+import (
+	"context"
+)
+
+const createProductSearchKeyword = `-- name: CreateProductSearchKeyword :exec
+INSERT INTO public.product_search_keywords (product_id, keyword)
+VALUES ($1::bigint, $2::varchar)
+`
+
+type CreateProductSearchKeywordParams struct {
+	ProductID int64  `json:"product_id"`
+	Keyword   string `json:"keyword"`
+}
+
+func (q *Queries) CreateProductSearchKeyword(ctx context.Context, arg CreateProductSearchKeywordParams) error {
+	_, err := q.db.Exec(ctx, createProductSearchKeyword, arg.ProductID, arg.Keyword)
+	return err
+}
+
+const createSearchAlias = `-- name: CreateSearchAlias :exec
+INSERT INTO public.search_aliases (id, alias, term)
+VALUES ($1::bigint, $2::varchar, $3::varchar)
+`
+
+type CreateSearchAliasParams struct {
+	ID    int64  `json:"id"`
+	Alias string `json:"alias"`
+	Term  string `json:"term"`
+}
+
+func (q *Queries) CreateSearchAlias(ctx context.Context, arg CreateSearchAliasParams) error {
+	_, err := q.db.Exec(ctx, createSearchAlias, arg.ID, arg.Alias, arg.Term)
+	return err
+}
+
+const deleteProductSearchKeywords = `-- name: DeleteProductSearchKeywords :exec
+DELETE FROM public.product_search_keywords WHERE product_id = $1::bigint
+`
+
+func (q *Queries) DeleteProductSearchKeywords(ctx context.Context, productID int64) error {
+	_, err := q.db.Exec(ctx, deleteProductSearchKeywords, productID)
+	return err
+}
+
+const deleteSearchAlias = `-- name: DeleteSearchAlias :execrows
+DELETE FROM public.search_aliases WHERE id = $1::bigint
+`
+
+func (q *Queries) DeleteSearchAlias(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSearchAlias, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listProductSearchKeywords = `-- name: ListProductSearchKeywords :many
+SELECT keyword
+FROM public.product_search_keywords
+WHERE product_id = $1::bigint
+ORDER BY keyword
+`
+
+func (q *Queries) ListProductSearchKeywords(ctx context.Context, productID int64) ([]string, error) {
+	rows, err := q.db.Query(ctx, listProductSearchKeywords, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var keyword string
+		if err := rows.Scan(&keyword); err != nil {
+			return nil, err
+		}
+		items = append(items, keyword)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSearchAliases = `-- name: ListSearchAliases :many
+SELECT id, alias, term
+FROM public.search_aliases
+ORDER BY alias
+`
+
+type ListSearchAliasesRow struct {
+	ID    int64  `json:"id"`
+	Alias string `json:"alias"`
+	Term  string `json:"term"`
+}
+
+func (q *Queries) ListSearchAliases(ctx context.Context) ([]*ListSearchAliasesRow, error) {
+	rows, err := q.db.Query(ctx, listSearchAliases)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListSearchAliasesRow{}
+	for rows.Next() {
+		var i ListSearchAliasesRow
+		if err := rows.Scan(&i.ID, &i.Alias, &i.Term); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,141 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: product_variants.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createVariant = `-- name: CreateVariant :exec
+INSERT INTO public.product_variants (id, product_id, "name", name_en, price, is_available, sort_order)
+VALUES ($1::bigint, $2::bigint, $3::varchar, $4::varchar, $5::numeric, $6::boolean, $7::int)
+`
+
+type CreateVariantParams struct {
+	ID          int64          `json:"id"`
+	ProductID   int64          `json:"product_id"`
+	Name        string         `json:"name"`
+	NameEn      string         `json:"name_en"`
+	Price       pgtype.Numeric `json:"price"`
+	IsAvailable bool           `json:"is_available"`
+	SortOrder   int32          `json:"sort_order"`
+}
+
+func (q *Queries) CreateVariant(ctx context.Context, arg CreateVariantParams) error {
+	_, err := q.db.Exec(ctx, createVariant,
+		arg.ID,
+		arg.ProductID,
+		arg.Name,
+		arg.NameEn,
+		arg.Price,
+		arg.IsAvailable,
+		arg.SortOrder,
+	)
+	return err
+}
+
+const deleteVariantsExcept = `-- name: DeleteVariantsExcept :exec
+UPDATE public.product_variants
+SET deleted_at = NOW()
+WHERE product_id = $1::bigint
+  AND deleted_at IS NULL
+  AND NOT (id = ANY ($2::bigint[]))
+`
+
+type DeleteVariantsExceptParams struct {
+	ProductID int64   `json:"product_id"`
+	KeepIds   []int64 `json:"keep_ids"`
+}
+
+func (q *Queries) DeleteVariantsExcept(ctx context.Context, arg DeleteVariantsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteVariantsExcept, arg.ProductID, arg.KeepIds)
+	return err
+}
+
+const listVariantsByProductIDs = `-- name: ListVariantsByProductIDs :many
+SELECT id, product_id as "productID", "name", name_en as "nameEN", price, is_available as "isAvailable"
+FROM public.product_variants
+WHERE product_id = ANY($1::bigint[])
+  AND deleted_at IS NULL
+ORDER BY product_id, sort_order, id
+`
+
+type ListVariantsByProductIDsRow struct {
+	ID          int64          `json:"id"`
+	ProductID   int64          `json:"productID"`
+	Name        string         `json:"name"`
+	NameEN      string         `json:"nameEN"`
+	Price       pgtype.Numeric `json:"price"`
+	IsAvailable bool           `json:"isAvailable"`
+}
+
+func (q *Queries) ListVariantsByProductIDs(ctx context.Context, productIds []int64) ([]*ListVariantsByProductIDsRow, error) {
+	rows, err := q.db.Query(ctx, listVariantsByProductIDs, productIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListVariantsByProductIDsRow{}
+	for rows.Next() {
+		var i ListVariantsByProductIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProductID,
+			&i.Name,
+			&i.NameEN,
+			&i.Price,
+			&i.IsAvailable,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateVariant = `-- name: UpdateVariant :execrows
+UPDATE public.product_variants
+SET "name"       = $1::varchar,
+    name_en      = $2::varchar,
+    price        = $3::numeric,
+    is_available = $4::boolean,
+    sort_order   = $5::int,
+    updated_at   = NOW()
+WHERE id = $6::bigint
+  AND product_id = $7::bigint
+  AND deleted_at IS NULL
+`
+
+type UpdateVariantParams struct {
+	Name        string         `json:"name"`
+	NameEn      string         `json:"name_en"`
+	Price       pgtype.Numeric `json:"price"`
+	IsAvailable bool           `json:"is_available"`
+	SortOrder   int32          `json:"sort_order"`
+	ID          int64          `json:"id"`
+	ProductID   int64          `json:"product_id"`
+}
+
+func (q *Queries) UpdateVariant(ctx context.Context, arg UpdateVariantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateVariant,
+		arg.Name,
+		arg.NameEn,
+		arg.Price,
+		arg.IsAvailable,
+		arg.SortOrder,
+		arg.ID,
+		arg.ProductID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -45,6 +45,29 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (i
 	return id, err
 }
 
+const decreaseProductStock = `-- name: DecreaseProductStock :execrows
+UPDATE public.products
+SET stock_quantity = stock_quantity - $1::int,
+    is_available   = CASE WHEN stock_quantity - $1::int = 0 THEN FALSE ELSE is_available END,
+    updated_at     = NOW()
+WHERE id = $2::bigint
+  AND stock_quantity IS NOT NULL
+  AND stock_quantity >= $1::int
+`
+
+type DecreaseProductStockParams struct {
+	Quantity int32 `json:"quantity"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) DecreaseProductStock(ctx context.Context, arg DecreaseProductStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, decreaseProductStock, arg.Quantity, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getProductAvailableByID = `-- name: GetProductAvailableByID :one
 SELECT p.id,
        p."name",
@@ -53,14 +76,22 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
        p.is_available,
-       p.image_url
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.id = $1::bigint AND p.is_available IS TRUE LIMIT 1
+WHERE p.id = $1::bigint AND p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND public.is_within_menu_schedule(p.id, p.categories, $2::text) LIMIT 1
 `
 
+type GetProductAvailableByIDParams struct {
+	ID       int64  `json:"id"`
+	TimeZone string `json:"time_zone"`
+}
+
 type GetProductAvailableByIDRow struct {
 	ID             int64          `json:"id"`
 	Name           string         `json:"name"`
@@ -72,10 +103,12 @@ type GetProductAvailableByIDRow struct {
 	Price          pgtype.Numeric `json:"price"`
 	IsAvailable    bool           `json:"is_available"`
 	ImageUrl       pgtype.Text    `json:"image_url"`
+	ThumbnailUrl   pgtype.Text    `json:"thumbnail_url"`
+	StockQuantity  pgtype.Int4    `json:"stock_quantity"`
 }
 
-func (q *Queries) GetProductAvailableByID(ctx context.Context, id int64) (*GetProductAvailableByIDRow, error) {
-	row := q.db.QueryRow(ctx, getProductAvailableByID, id)
+func (q *Queries) GetProductAvailableByID(ctx context.Context, arg GetProductAvailableByIDParams) (*GetProductAvailableByIDRow, error) {
+	row := q.db.QueryRow(ctx, getProductAvailableByID, arg.ID, arg.TimeZone)
 	var i GetProductAvailableByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -88,6 +121,8 @@ func (q *Queries) GetProductAvailableByID(ctx context.Context, id int64) (*GetPr
 		&i.Price,
 		&i.IsAvailable,
 		&i.ImageUrl,
+		&i.ThumbnailUrl,
+		&i.StockQuantity,
 	)
 	return &i, err
 }
@@ -100,14 +135,19 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, $1::timestamptz), p.price)::numeric as "price",
        p.is_available,
        p.image_url
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.id = $1::bigint AND p.is_available IS TRUE LIMIT 1
+WHERE p.id = $2::bigint AND p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE LIMIT 1
 `
 
+type GetProductByIDParams struct {
+	PriceAt pgtype.Timestamptz `json:"price_at"`
+	ID      int64              `json:"id"`
+}
+
 type GetProductByIDRow struct {
 	ID             int64          `json:"id"`
 	Name           string         `json:"name"`
@@ -121,8 +161,8 @@ type GetProductByIDRow struct {
 	ImageUrl       pgtype.Text    `json:"image_url"`
 }
 
-func (q *Queries) GetProductByID(ctx context.Context, id int64) (*GetProductByIDRow, error) {
-	row := q.db.QueryRow(ctx, getProductByID, id)
+func (q *Queries) GetProductByID(ctx context.Context, arg GetProductByIDParams) (*GetProductByIDRow, error) {
+	row := q.db.QueryRow(ctx, getProductByID, arg.PriceAt, arg.ID)
 	var i GetProductByIDRow
 	err := row.Scan(
 		&i.ID,
@@ -139,33 +179,136 @@ func (q *Queries) GetProductByID(ctx context.Context, id int64) (*GetProductByID
 	return &i, err
 }
 
+const getProductDetailByID = `-- name: GetProductDetailByID :one
+SELECT p.id,
+       p."name",
+       p.name_en,
+       p.categories,
+       c.name as "categoryName",
+       c.name_en as "categoryNameEN",
+       p.description,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
+       p.is_available,
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
+FROM public.products as p
+         INNER JOIN public.md_categories as c ON c.id = p.categories
+WHERE p.id = $1::bigint LIMIT 1
+`
+
+type GetProductDetailByIDRow struct {
+	ID             int64          `json:"id"`
+	Name           string         `json:"name"`
+	NameEn         string         `json:"name_en"`
+	Categories     int64          `json:"categories"`
+	CategoryName   string         `json:"categoryName"`
+	CategoryNameEN string         `json:"categoryNameEN"`
+	Description    pgtype.Text    `json:"description"`
+	Price          pgtype.Numeric `json:"price"`
+	IsAvailable    bool           `json:"is_available"`
+	ImageUrl       pgtype.Text    `json:"image_url"`
+	ThumbnailUrl   pgtype.Text    `json:"thumbnail_url"`
+	StockQuantity  pgtype.Int4    `json:"stock_quantity"`
+}
+
+func (q *Queries) GetProductDetailByID(ctx context.Context, id int64) (*GetProductDetailByIDRow, error) {
+	row := q.db.QueryRow(ctx, getProductDetailByID, id)
+	var i GetProductDetailByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.NameEn,
+		&i.Categories,
+		&i.CategoryName,
+		&i.CategoryNameEN,
+		&i.Description,
+		&i.Price,
+		&i.IsAvailable,
+		&i.ImageUrl,
+		&i.ThumbnailUrl,
+		&i.StockQuantity,
+	)
+	return &i, err
+}
+
 const getTotalPageSearchProducts = `-- name: GetTotalPageSearchProducts :one
 SELECT COUNT(*)
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.is_available IS TRUE AND p.is_visible IS TRUE
-  AND (($1::varchar IS NULL OR p."name" ILIKE '%' || $1::varchar || '%') OR ($1::varchar IS NULL OR p.name_en ILIKE '%' || $1::varchar || '%'))
-  AND ($2::boolean IS NULL OR p.is_available = $2::boolean)
+WHERE p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND public.is_within_menu_schedule(p.id, p.categories, $1::text)
+  AND ($2::varchar IS NULL OR public.product_search_score(p.id, p."name", p.name_en, $2::varchar) >= 0.3)
+  AND ($3::boolean IS NULL OR p.is_available = $3::boolean)
+  AND ($4::varchar[] IS NULL
+    OR array_length($4::varchar[], 1) IS NULL
+    OR (SELECT COUNT(DISTINCT t.code)
+        FROM public.product_dietary_tags pt
+                 JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+        WHERE pt.product_id = p.id
+          AND t.code = ANY ($4::varchar[])) = array_length($4::varchar[], 1))
+  AND ($5::varchar[] IS NULL
+    OR NOT EXISTS (SELECT 1
+                   FROM public.product_dietary_tags pt
+                            JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+                   WHERE pt.product_id = p.id
+                     AND t.code = ANY ($5::varchar[])))
+  AND ($6::bigint IS NULL
+    OR p.id IN (SELECT (e.value ->> 'id')::bigint
+                FROM public.menu_versions v
+                         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') e
+                WHERE v.id = $6::bigint))
   AND (
-    $3::bigint[] IS NULL
-        OR array_length($3::bigint[], 1) = 0
-        OR p.categories = ANY ($3::bigint[])
+    $7::bigint[] IS NULL
+        OR array_length($7::bigint[], 1) = 0
+        OR p.categories = ANY ($7::bigint[])
     )
 `
 
 type GetTotalPageSearchProductsParams struct {
-	Name        pgtype.Text `json:"name"`
-	IsAvailable pgtype.Bool `json:"is_available"`
-	CategoryID  []int64     `json:"category_id"`
+	TimeZone         string      `json:"time_zone"`
+	Name             pgtype.Text `json:"name"`
+	IsAvailable      pgtype.Bool `json:"is_available"`
+	DietaryTags      []string    `json:"dietary_tags"`
+	ExcludeAllergens []string    `json:"exclude_allergens"`
+	MenuVersionID    pgtype.Int8 `json:"menu_version_id"`
+	CategoryID       []int64     `json:"category_id"`
 }
 
 func (q *Queries) GetTotalPageSearchProducts(ctx context.Context, arg GetTotalPageSearchProductsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, getTotalPageSearchProducts, arg.Name, arg.IsAvailable, arg.CategoryID)
+	row := q.db.QueryRow(ctx, getTotalPageSearchProducts,
+		arg.TimeZone,
+		arg.Name,
+		arg.IsAvailable,
+		arg.DietaryTags,
+		arg.ExcludeAllergens,
+		arg.MenuVersionID,
+		arg.CategoryID,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const increaseProductStock = `-- name: IncreaseProductStock :exec
+UPDATE public.products
+SET stock_quantity = stock_quantity + $1::int,
+    is_available   = CASE WHEN stock_quantity = 0 AND NOT sold_out_by_ingredients THEN TRUE ELSE is_available END,
+    updated_at     = NOW()
+WHERE id = $2::bigint
+  AND stock_quantity IS NOT NULL
+`
+
+type IncreaseProductStockParams struct {
+	Quantity int32 `json:"quantity"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) IncreaseProductStock(ctx context.Context, arg IncreaseProductStockParams) error {
+	_, err := q.db.Exec(ctx, increaseProductStock, arg.Quantity, arg.ID)
+	return err
+}
+
 const isProductExists = `-- name: IsProductExists :one
 SELECT count(*) > 0 as "isExists" FROM public.products WHERE id = $1
 `
@@ -177,6 +320,52 @@ func (q *Queries) IsProductExists(ctx context.Context, id int64) (bool, error) {
 	return isExists, err
 }
 
+const isProductSoldOut = `-- name: IsProductSoldOut :one
+SELECT count(*) > 0 as "isSoldOut" FROM public.products WHERE id = $1::bigint AND stock_quantity = 0
+`
+
+func (q *Queries) IsProductSoldOut(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isProductSoldOut, id)
+	var isSoldOut bool
+	err := row.Scan(&isSoldOut)
+	return isSoldOut, err
+}
+
+const isProductStockTracked = `-- name: IsProductStockTracked :one
+SELECT (stock_quantity IS NOT NULL)::boolean as "isTracked" FROM public.products WHERE id = $1::bigint
+`
+
+func (q *Queries) IsProductStockTracked(ctx context.Context, id int64) (bool, error) {
+	row := q.db.QueryRow(ctx, isProductStockTracked, id)
+	var isTracked bool
+	err := row.Scan(&isTracked)
+	return isTracked, err
+}
+
+const listAvailableProductIDs = `-- name: ListAvailableProductIDs :many
+SELECT id FROM public.products WHERE id = ANY ($1::bigint[]) AND is_available IS TRUE AND is_visible IS TRUE
+`
+
+func (q *Queries) ListAvailableProductIDs(ctx context.Context, ids []int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listAvailableProductIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProductTimeExtension = `-- name: ListProductTimeExtension :many
 SELECT p.id,
        p."name",
@@ -185,7 +374,7 @@ SELECT p.id,
        c.name as "categoryName",
        c.name_en as "categoryNameEN",
        p.description,
-       p.price,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
        p.is_available,
        p.image_url
 FROM public.products as p
@@ -237,7 +426,7 @@ func (q *Queries) ListProductTimeExtension(ctx context.Context) ([]*ListProductT
 	return items, nil
 }
 
-const searchProducts = `-- name: SearchProducts :many
+const listProductsForMenuSnapshot = `-- name: ListProductsForMenuSnapshot :many
 SELECT p.id,
        p."name",
        p.name_en,
@@ -247,46 +436,141 @@ SELECT p.id,
        p.description,
        p.price,
        p.is_available,
-       p.image_url
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
 FROM public.products as p
          INNER JOIN public.md_categories as c ON c.id = p.categories
-WHERE p.is_available IS TRUE AND p.is_visible IS TRUE
-  AND (($1::varchar IS NULL OR p."name" ILIKE '%' || $1::varchar || '%') OR ($1::varchar IS NULL OR p.name_en ILIKE '%' || $1::varchar || '%'))
-  AND ($2::boolean IS NULL OR p.is_available = $2::boolean)
+ORDER BY c.sort_order, p.id
+`
+
+type ListProductsForMenuSnapshotRow struct {
+	ID             int64          `json:"id"`
+	Name           string         `json:"name"`
+	NameEn         string         `json:"name_en"`
+	Categories     int64          `json:"categories"`
+	CategoryName   string         `json:"categoryName"`
+	CategoryNameEN string         `json:"categoryNameEN"`
+	Description    pgtype.Text    `json:"description"`
+	Price          pgtype.Numeric `json:"price"`
+	IsAvailable    bool           `json:"is_available"`
+	ImageUrl       pgtype.Text    `json:"image_url"`
+	ThumbnailUrl   pgtype.Text    `json:"thumbnail_url"`
+	StockQuantity  pgtype.Int4    `json:"stock_quantity"`
+}
+
+func (q *Queries) ListProductsForMenuSnapshot(ctx context.Context) ([]*ListProductsForMenuSnapshotRow, error) {
+	rows, err := q.db.Query(ctx, listProductsForMenuSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProductsForMenuSnapshotRow{}
+	for rows.Next() {
+		var i ListProductsForMenuSnapshotRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.NameEn,
+			&i.Categories,
+			&i.CategoryName,
+			&i.CategoryNameEN,
+			&i.Description,
+			&i.Price,
+			&i.IsAvailable,
+			&i.ImageUrl,
+			&i.ThumbnailUrl,
+			&i.StockQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchProducts = `-- name: SearchProducts :many
+SELECT p.id,
+       p."name",
+       p.name_en,
+       p.categories,
+       c.name as "categoryName",
+       c.name_en as "categoryNameEN",
+       p.description,
+       COALESCE(public.product_price_at(p.id, NOW()), p.price)::numeric as "price",
+       p.is_available,
+       p.image_url,
+       p.thumbnail_url,
+       p.stock_quantity
+FROM public.products as p
+         INNER JOIN public.md_categories as c ON c.id = p.categories
+WHERE p.is_available IS TRUE AND p.is_visible IS TRUE AND c.is_visible IS TRUE
+  AND public.is_within_menu_schedule(p.id, p.categories, $1::text)
+  AND ($2::varchar IS NULL OR public.product_search_score(p.id, p."name", p.name_en, $2::varchar) >= 0.3)
+  AND ($3::boolean IS NULL OR p.is_available = $3::boolean)
+  AND ($4::varchar[] IS NULL
+    OR array_length($4::varchar[], 1) IS NULL
+    OR (SELECT COUNT(DISTINCT t.code)
+        FROM public.product_dietary_tags pt
+                 JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+        WHERE pt.product_id = p.id
+          AND t.code = ANY ($4::varchar[])) = array_length($4::varchar[], 1))
+  AND ($5::varchar[] IS NULL
+    OR NOT EXISTS (SELECT 1
+                   FROM public.product_dietary_tags pt
+                            JOIN public.md_dietary_tags t ON t.id = pt.tag_id
+                   WHERE pt.product_id = p.id
+                     AND t.code = ANY ($5::varchar[])))
+  AND ($6::bigint IS NULL
+    OR p.id IN (SELECT (e.value ->> 'id')::bigint
+                FROM public.menu_versions v
+                         CROSS JOIN jsonb_array_elements(v.snapshot -> 'products') e
+                WHERE v.id = $6::bigint))
   AND (
-    $3::bigint[] IS NULL
-        OR array_length($3::bigint[], 1) = 0
-        OR p.categories = ANY ($3::bigint[])
+    $7::bigint[] IS NULL
+        OR array_length($7::bigint[], 1) = 0
+        OR p.categories = ANY ($7::bigint[])
     )
 ORDER BY CASE
-             WHEN $4::text = 'asc' THEN
+             WHEN $2::varchar IS NULL THEN 0
+             ELSE public.product_search_score(p.id, p."name", p.name_en, $2::varchar)
+             END DESC,
+         CASE
+             WHEN $8::text = 'asc' THEN
                  CASE
-                     WHEN $5::text = 'id' THEN p.id::text
-                     WHEN $5::text = 'name' THEN p."name"
-                     WHEN $5::text = 'price' THEN p.price::text
+                     WHEN $9::text = 'id' THEN p.id::text
+                     WHEN $9::text = 'name' THEN p."name"
+                     WHEN $9::text = 'price' THEN COALESCE(public.product_price_at(p.id, NOW()), p.price)::text
                      ELSE c.sort_order::text
                      END
              END,
          CASE
-             WHEN $4::text = 'desc' THEN
+             WHEN $8::text = 'desc' THEN
                  CASE
-                     WHEN $5::text = 'id' THEN p.id::text
-                     WHEN $5::text = 'name' THEN p."name"
-                     WHEN $5::text = 'price' THEN p.price::text
+                     WHEN $9::text = 'id' THEN p.id::text
+                     WHEN $9::text = 'name' THEN p."name"
+                     WHEN $9::text = 'price' THEN COALESCE(public.product_price_at(p.id, NOW()), p.price)::text
                      ELSE c.sort_order::text
                      END
              END DESC
-OFFSET $6 LIMIT $7
+OFFSET $10 LIMIT $11
 `
 
 type SearchProductsParams struct {
-	Name        pgtype.Text `json:"name"`
-	IsAvailable pgtype.Bool `json:"is_available"`
-	CategoryID  []int64     `json:"category_id"`
-	OrderByType string      `json:"order_by_type"`
-	OrderBy     string      `json:"order_by"`
-	PageNumber  int64       `json:"page_number"`
-	PageSize    int64       `json:"page_size"`
+	TimeZone         string      `json:"time_zone"`
+	Name             pgtype.Text `json:"name"`
+	IsAvailable      pgtype.Bool `json:"is_available"`
+	DietaryTags      []string    `json:"dietary_tags"`
+	ExcludeAllergens []string    `json:"exclude_allergens"`
+	MenuVersionID    pgtype.Int8 `json:"menu_version_id"`
+	CategoryID       []int64     `json:"category_id"`
+	OrderByType      string      `json:"order_by_type"`
+	OrderBy          string      `json:"order_by"`
+	PageNumber       int64       `json:"page_number"`
+	PageSize         int64       `json:"page_size"`
 }
 
 type SearchProductsRow struct {
@@ -300,12 +584,18 @@ type SearchProductsRow struct {
 	Price          pgtype.Numeric `json:"price"`
 	IsAvailable    bool           `json:"is_available"`
 	ImageUrl       pgtype.Text    `json:"image_url"`
+	ThumbnailUrl   pgtype.Text    `json:"thumbnail_url"`
+	StockQuantity  pgtype.Int4    `json:"stock_quantity"`
 }
 
 func (q *Queries) SearchProducts(ctx context.Context, arg SearchProductsParams) ([]*SearchProductsRow, error) {
 	rows, err := q.db.Query(ctx, searchProducts,
+		arg.TimeZone,
 		arg.Name,
 		arg.IsAvailable,
+		arg.DietaryTags,
+		arg.ExcludeAllergens,
+		arg.MenuVersionID,
 		arg.CategoryID,
 		arg.OrderByType,
 		arg.OrderBy,
@@ -330,6 +620,8 @@ func (q *Queries) SearchProducts(ctx context.Context, arg SearchProductsParams)
 			&i.Price,
 			&i.IsAvailable,
 			&i.ImageUrl,
+			&i.ThumbnailUrl,
+			&i.StockQuantity,
 		); err != nil {
 			return nil, err
 		}
@@ -343,14 +635,15 @@ func (q *Queries) SearchProducts(ctx context.Context, arg SearchProductsParams)
 
 const updateProduct = `-- name: UpdateProduct :exec
 UPDATE public.products
-SET "name"       = $2,
-    name_en      = $3,
-    categories   = $4,
-    description  = $5,
-    price        = $6,
-    is_available = $7,
-    image_url    = $8,
-    updated_at   = NOW()
+SET "name"                  = $2,
+    name_en                 = $3,
+    categories              = $4,
+    description             = $5,
+    price                   = $6,
+    is_available            = $7,
+    image_url               = $8,
+    sold_out_by_ingredients = FALSE,
+    updated_at              = NOW()
 WHERE id = $1
 `
 
@@ -381,8 +674,9 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) er
 
 const updateProductAvailability = `-- name: UpdateProductAvailability :exec
 UPDATE public.products
-SET is_available = $2,
-    updated_at   = NOW()
+SET is_available            = $2,
+    sold_out_by_ingredients = FALSE,
+    updated_at              = NOW()
 WHERE id = $1
 `
 
@@ -395,3 +689,67 @@ func (q *Queries) UpdateProductAvailability(ctx context.Context, arg UpdateProdu
 	_, err := q.db.Exec(ctx, updateProductAvailability, arg.ID, arg.IsAvailable)
 	return err
 }
+
+const updateProductImage = `-- name: UpdateProductImage :execrows
+UPDATE public.products
+SET image_url     = $1::text,
+    thumbnail_url = $2::text,
+    updated_at    = NOW()
+WHERE id = $3::bigint
+`
+
+type UpdateProductImageParams struct {
+	ImageUrl     string `json:"image_url"`
+	ThumbnailUrl string `json:"thumbnail_url"`
+	ID           int64  `json:"id"`
+}
+
+func (q *Queries) UpdateProductImage(ctx context.Context, arg UpdateProductImageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateProductImage, arg.ImageUrl, arg.ThumbnailUrl, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateProductStock = `-- name: UpdateProductStock :execrows
+UPDATE public.products
+SET stock_quantity = $1::int,
+    is_available   = CASE
+                         WHEN $1::int = 0 THEN FALSE
+                         WHEN stock_quantity = 0 AND NOT sold_out_by_ingredients THEN TRUE
+                         ELSE is_available
+        END,
+    updated_at     = NOW()
+WHERE id = $2::bigint
+`
+
+type UpdateProductStockParams struct {
+	StockQuantity pgtype.Int4 `json:"stock_quantity"`
+	ID            int64       `json:"id"`
+}
+
+func (q *Queries) UpdateProductStock(ctx context.Context, arg UpdateProductStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateProductStock, arg.StockQuantity, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateProductVisibility = `-- name: UpdateProductVisibility :exec
+UPDATE public.products
+SET is_visible = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateProductVisibilityParams struct {
+	ID        int64 `json:"id"`
+	IsVisible bool  `json:"is_visible"`
+}
+
+func (q *Queries) UpdateProductVisibility(ctx context.Context, arg UpdateProductVisibilityParams) error {
+	_, err := q.db.Exec(ctx, updateProductVisibility, arg.ID, arg.IsVisible)
+	return err
+}
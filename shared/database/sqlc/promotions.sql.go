@@ -0,0 +1,254 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: promotions.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createPromotion = `-- name: CreatePromotion :exec
+INSERT INTO public.promotions
+(id, "name", name_en, promotion_type, discount_value, buy_quantity, free_quantity, product_id, category_id, starts_at, ends_at, days_of_week, start_time, end_time, is_active)
+VALUES ($1::bigint, $2::varchar, $3::varchar, $4::varchar, $5::numeric,
+        $6::int, $7::int, $8::bigint, $9::bigint,
+        $10::timestamptz, $11::timestamptz, $12::smallint[],
+        $13::time, $14::time, $15::boolean)
+`
+
+type CreatePromotionParams struct {
+	ID            int64              `json:"id"`
+	Name          string             `json:"name"`
+	NameEn        string             `json:"name_en"`
+	PromotionType string             `json:"promotion_type"`
+	DiscountValue pgtype.Numeric     `json:"discount_value"`
+	BuyQuantity   pgtype.Int4        `json:"buy_quantity"`
+	FreeQuantity  pgtype.Int4        `json:"free_quantity"`
+	ProductID     pgtype.Int8        `json:"product_id"`
+	CategoryID    pgtype.Int8        `json:"category_id"`
+	StartsAt      pgtype.Timestamptz `json:"starts_at"`
+	EndsAt        pgtype.Timestamptz `json:"ends_at"`
+	DaysOfWeek    []int16            `json:"days_of_week"`
+	StartTime     pgtype.Time        `json:"start_time"`
+	EndTime       pgtype.Time        `json:"end_time"`
+	IsActive      bool               `json:"is_active"`
+}
+
+func (q *Queries) CreatePromotion(ctx context.Context, arg CreatePromotionParams) error {
+	_, err := q.db.Exec(ctx, createPromotion,
+		arg.ID,
+		arg.Name,
+		arg.NameEn,
+		arg.PromotionType,
+		arg.DiscountValue,
+		arg.BuyQuantity,
+		arg.FreeQuantity,
+		arg.ProductID,
+		arg.CategoryID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.DaysOfWeek,
+		arg.StartTime,
+		arg.EndTime,
+		arg.IsActive,
+	)
+	return err
+}
+
+const listActivePromotionsForProduct = `-- name: ListActivePromotionsForProduct :many
+SELECT id,
+       promotion_type as "promotionType",
+       discount_value as "discountValue",
+       buy_quantity as "buyQuantity",
+       free_quantity as "freeQuantity"
+FROM public.promotions
+WHERE (product_id = $1::bigint OR category_id = $2::bigint)
+  AND public.is_promotion_active(id, $3::timestamptz, $4::text)
+ORDER BY created_at DESC, id DESC
+`
+
+type ListActivePromotionsForProductParams struct {
+	ProductID  int64              `json:"product_id"`
+	CategoryID int64              `json:"category_id"`
+	At         pgtype.Timestamptz `json:"at"`
+	TimeZone   string             `json:"time_zone"`
+}
+
+type ListActivePromotionsForProductRow struct {
+	ID            int64          `json:"id"`
+	PromotionType string         `json:"promotionType"`
+	DiscountValue pgtype.Numeric `json:"discountValue"`
+	BuyQuantity   pgtype.Int4    `json:"buyQuantity"`
+	FreeQuantity  pgtype.Int4    `json:"freeQuantity"`
+}
+
+func (q *Queries) ListActivePromotionsForProduct(ctx context.Context, arg ListActivePromotionsForProductParams) ([]*ListActivePromotionsForProductRow, error) {
+	rows, err := q.db.Query(ctx, listActivePromotionsForProduct,
+		arg.ProductID,
+		arg.CategoryID,
+		arg.At,
+		arg.TimeZone,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListActivePromotionsForProductRow{}
+	for rows.Next() {
+		var i ListActivePromotionsForProductRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PromotionType,
+			&i.DiscountValue,
+			&i.BuyQuantity,
+			&i.FreeQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromotions = `-- name: ListPromotions :many
+SELECT id,
+       "name",
+       name_en as "nameEN",
+       promotion_type as "promotionType",
+       discount_value as "discountValue",
+       buy_quantity as "buyQuantity",
+       free_quantity as "freeQuantity",
+       product_id as "productID",
+       category_id as "categoryID",
+       starts_at as "startsAt",
+       ends_at as "endsAt",
+       days_of_week as "daysOfWeek",
+       start_time as "startTime",
+       end_time as "endTime",
+       is_active as "isActive"
+FROM public.promotions
+ORDER BY created_at DESC, id DESC
+`
+
+type ListPromotionsRow struct {
+	ID            int64              `json:"id"`
+	Name          string             `json:"name"`
+	NameEN        string             `json:"nameEN"`
+	PromotionType string             `json:"promotionType"`
+	DiscountValue pgtype.Numeric     `json:"discountValue"`
+	BuyQuantity   pgtype.Int4        `json:"buyQuantity"`
+	FreeQuantity  pgtype.Int4        `json:"freeQuantity"`
+	ProductID     pgtype.Int8        `json:"productID"`
+	CategoryID    pgtype.Int8        `json:"categoryID"`
+	StartsAt      pgtype.Timestamptz `json:"startsAt"`
+	EndsAt        pgtype.Timestamptz `json:"endsAt"`
+	DaysOfWeek    []int16            `json:"daysOfWeek"`
+	StartTime     pgtype.Time        `json:"startTime"`
+	EndTime       pgtype.Time        `json:"endTime"`
+	IsActive      bool               `json:"isActive"`
+}
+
+func (q *Queries) ListPromotions(ctx context.Context) ([]*ListPromotionsRow, error) {
+	rows, err := q.db.Query(ctx, listPromotions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListPromotionsRow{}
+	for rows.Next() {
+		var i ListPromotionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.NameEN,
+			&i.PromotionType,
+			&i.DiscountValue,
+			&i.BuyQuantity,
+			&i.FreeQuantity,
+			&i.ProductID,
+			&i.CategoryID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.DaysOfWeek,
+			&i.StartTime,
+			&i.EndTime,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePromotion = `-- name: UpdatePromotion :execrows
+UPDATE public.promotions
+SET "name"         = $1::varchar,
+    name_en        = $2::varchar,
+    promotion_type = $3::varchar,
+    discount_value = $4::numeric,
+    buy_quantity   = $5::int,
+    free_quantity  = $6::int,
+    product_id     = $7::bigint,
+    category_id    = $8::bigint,
+    starts_at      = $9::timestamptz,
+    ends_at        = $10::timestamptz,
+    days_of_week   = $11::smallint[],
+    start_time     = $12::time,
+    end_time       = $13::time,
+    is_active      = $14::boolean,
+    updated_at     = NOW()
+WHERE id = $15::bigint
+`
+
+type UpdatePromotionParams struct {
+	Name          string             `json:"name"`
+	NameEn        string             `json:"name_en"`
+	PromotionType string             `json:"promotion_type"`
+	DiscountValue pgtype.Numeric     `json:"discount_value"`
+	BuyQuantity   pgtype.Int4        `json:"buy_quantity"`
+	FreeQuantity  pgtype.Int4        `json:"free_quantity"`
+	ProductID     pgtype.Int8        `json:"product_id"`
+	CategoryID    pgtype.Int8        `json:"category_id"`
+	StartsAt      pgtype.Timestamptz `json:"starts_at"`
+	EndsAt        pgtype.Timestamptz `json:"ends_at"`
+	DaysOfWeek    []int16            `json:"days_of_week"`
+	StartTime     pgtype.Time        `json:"start_time"`
+	EndTime       pgtype.Time        `json:"end_time"`
+	IsActive      bool               `json:"is_active"`
+	ID            int64              `json:"id"`
+}
+
+func (q *Queries) UpdatePromotion(ctx context.Context, arg UpdatePromotionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePromotion,
+		arg.Name,
+		arg.NameEn,
+		arg.PromotionType,
+		arg.DiscountValue,
+		arg.BuyQuantity,
+		arg.FreeQuantity,
+		arg.ProductID,
+		arg.CategoryID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.DaysOfWeek,
+		arg.StartTime,
+		arg.EndTime,
+		arg.IsActive,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
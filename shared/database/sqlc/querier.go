@@ -11,23 +11,76 @@ import (
 )
 
 type Querier interface {
+	AdjustIngredientStock(ctx context.Context, arg AdjustIngredientStockParams) (int64, error)
+	AmendOrderItem(ctx context.Context, arg AmendOrderItemParams) error
+	// claims the pending events of each key in seq order. Only a key whose first pending event is due is claimed,
+	// and locking that event keeps other relays off the key, so a later event can never overtake an earlier one.
+	ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]*ClaimOutboxEventsRow, error)
+	CreateCategory(ctx context.Context, arg CreateCategoryParams) (int64, error)
+	CreateComboSlot(ctx context.Context, arg CreateComboSlotParams) error
+	CreateComboSlotOption(ctx context.Context, arg CreateComboSlotOptionParams) error
+	CreateIngredient(ctx context.Context, arg CreateIngredientParams) (int64, error)
+	CreateIngredientMovement(ctx context.Context, arg CreateIngredientMovementParams) error
+	CreateMenuSchedule(ctx context.Context, arg CreateMenuScheduleParams) error
+	CreateMenuVersion(ctx context.Context, arg CreateMenuVersionParams) (*CreateMenuVersionRow, error)
+	CreateModifier(ctx context.Context, arg CreateModifierParams) error
+	CreateModifierGroup(ctx context.Context, arg CreateModifierGroupParams) error
 	CreateOrder(ctx context.Context, arg CreateOrderParams) (int64, error)
 	CreateOrderItems(ctx context.Context, arg []CreateOrderItemsParams) (int64, error)
 	CreateOrderItemsPerRow(ctx context.Context, arg CreateOrderItemsPerRowParams) error
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
 	CreatePayment(ctx context.Context, arg CreatePaymentParams) (int64, error)
 	CreateProduct(ctx context.Context, arg CreateProductParams) (int64, error)
+	CreateProductDietaryTags(ctx context.Context, arg CreateProductDietaryTagsParams) error
+	CreateProductIngredient(ctx context.Context, arg CreateProductIngredientParams) error
+	CreateProductSearchKeyword(ctx context.Context, arg CreateProductSearchKeywordParams) error
+	CreatePromotion(ctx context.Context, arg CreatePromotionParams) error
+	CreateScheduledProductPrice(ctx context.Context, arg CreateScheduledProductPriceParams) (int64, error)
+	CreateSearchAlias(ctx context.Context, arg CreateSearchAliasParams) error
 	CreateSessionExtension(ctx context.Context, arg CreateSessionExtensionParams) (int64, error)
 	CreateTable(ctx context.Context, arg CreateTableParams) (int64, error)
+	CreateTableServiceRequest(ctx context.Context, arg CreateTableServiceRequestParams) error
 	CreateTableSession(ctx context.Context, arg CreateTableSessionParams) error
 	CreateTableStatus(ctx context.Context, arg CreateTableStatusParams) (int64, error)
+	CreateTranslation(ctx context.Context, arg CreateTranslationParams) error
+	CreateVariant(ctx context.Context, arg CreateVariantParams) error
+	DecreaseProductStock(ctx context.Context, arg DecreaseProductStockParams) (int64, error)
+	DeleteComboSlotOptionsBySlotID(ctx context.Context, slotID int64) error
+	DeleteComboSlotsExcept(ctx context.Context, arg DeleteComboSlotsExceptParams) error
+	DeleteMenuSchedulesByCategoryID(ctx context.Context, categoryID int64) error
+	DeleteMenuSchedulesByProductID(ctx context.Context, productID int64) error
+	DeleteModifierGroupsExcept(ctx context.Context, arg DeleteModifierGroupsExceptParams) error
+	DeleteModifiersExcept(ctx context.Context, arg DeleteModifiersExceptParams) error
+	DeleteProductAssociations(ctx context.Context) error
+	DeleteProductDietaryTags(ctx context.Context, productID int64) error
+	DeleteProductIngredients(ctx context.Context, productID int64) error
+	DeleteProductSearchKeywords(ctx context.Context, productID int64) error
+	DeleteScheduledMenuVersion(ctx context.Context, id int64) (int64, error)
+	DeleteScheduledProductPrice(ctx context.Context, arg DeleteScheduledProductPriceParams) (int64, error)
+	DeleteSearchAlias(ctx context.Context, id int64) (int64, error)
+	DeleteSentOutboxEvents(ctx context.Context, retentionDays int32) (int64, error)
+	DeleteTranslationsByEntity(ctx context.Context, arg DeleteTranslationsByEntityParams) error
+	DeleteVariantsExcept(ctx context.Context, arg DeleteVariantsExceptParams) error
+	GetCategoryIDBySortOrderForUpdate(ctx context.Context, sortOrder int32) (int64, error)
+	GetCategorySortOrderForUpdate(ctx context.Context, id int64) (int32, error)
+	GetComboOrderItemProgress(ctx context.Context, parentOrderItemID int64) (*GetComboOrderItemProgressRow, error)
 	GetDurationMinutesByProductID(ctx context.Context, productsID int64) (int32, error)
 	GetExpiresAtByTableID(ctx context.Context, tableID int64) (*GetExpiresAtByTableIDRow, error)
+	GetLastOutboxEventIDByKey(ctx context.Context, arg GetLastOutboxEventIDByKeyParams) (int64, error)
+	GetMenuVersionSnapshot(ctx context.Context, id int64) (*GetMenuVersionSnapshotRow, error)
+	GetOpenTableServiceRequestID(ctx context.Context, arg GetOpenTableServiceRequestIDParams) (int64, error)
+	GetOrderBuyXGetYDiscount(ctx context.Context, orderID int64) (pgtype.Numeric, error)
 	GetOrderByID(ctx context.Context, id int64) (*GetOrderByIDRow, error)
 	GetOrderIDBySessionID(ctx context.Context, sessionID pgtype.UUID) (int64, error)
+	GetOrderItemForAmend(ctx context.Context, arg GetOrderItemForAmendParams) (*GetOrderItemForAmendRow, error)
 	GetOrderItemsByID(ctx context.Context, id int64) (*GetOrderItemsByIDRow, error)
 	GetOrderItemsByOrderID(ctx context.Context, orderID int64) ([]*GetOrderItemsByOrderIDRow, error)
+	GetOrderItemsStatusForUpdate(ctx context.Context, id int64) (*GetOrderItemsStatusForUpdateRow, error)
 	GetOrderSequence(ctx context.Context, orderDate pgtype.Date) (int32, error)
+	GetOrderStatusByID(ctx context.Context, id int64) (*GetOrderStatusByIDRow, error)
+	GetOrderStatusByIDForUpdate(ctx context.Context, id int64) (*GetOrderStatusByIDForUpdateRow, error)
 	GetOrderStatusCompleted(ctx context.Context) (int64, error)
+	GetOrderStatusPending(ctx context.Context) (int64, error)
 	GetOrderStatusPreparing(ctx context.Context) (int64, error)
 	GetOrderStatusServed(ctx context.Context) (int64, error)
 	GetOrderWithItems(ctx context.Context, arg GetOrderWithItemsParams) ([]*GetOrderWithItemsRow, error)
@@ -37,15 +90,21 @@ type Querier interface {
 	GetPaymentLastStatusCodeByTransaction(ctx context.Context, transactionID string) (pgtype.Text, error)
 	GetPaymentOrderIDByTransaction(ctx context.Context, transactionID string) (int64, error)
 	GetPaymentStatusPending(ctx context.Context) (int64, error)
-	GetProductAvailableByID(ctx context.Context, id int64) (*GetProductAvailableByIDRow, error)
-	GetProductByID(ctx context.Context, id int64) (*GetProductByIDRow, error)
+	GetProductAvailableByID(ctx context.Context, arg GetProductAvailableByIDParams) (*GetProductAvailableByIDRow, error)
+	GetProductByID(ctx context.Context, arg GetProductByIDParams) (*GetProductByIDRow, error)
+	GetProductDetailByID(ctx context.Context, id int64) (*GetProductDetailByIDRow, error)
+	GetProductPriceAt(ctx context.Context, arg GetProductPriceAtParams) (pgtype.Numeric, error)
+	GetPublishedCategories(ctx context.Context, id int64) ([]byte, error)
+	GetPublishedMenuVersionID(ctx context.Context) (int64, error)
 	GetSessionExtensionModeByReasonCode(ctx context.Context, code string) (*GetSessionExtensionModeByReasonCodeRow, error)
 	GetSessionIDByOrderID(ctx context.Context, id int64) (pgtype.UUID, error)
 	GetSessionIDByTableID(ctx context.Context, tableID int64) (pgtype.UUID, error)
 	GetTableIDByOrderID(ctx context.Context, orderID int64) (int64, error)
 	GetTableNumber(ctx context.Context, id int64) (int32, error)
 	GetTableNumberOrderByID(ctx context.Context, orderID int64) (int32, error)
+	GetTableServiceRequestForUpdate(ctx context.Context, id int64) (*GetTableServiceRequestForUpdateRow, error)
 	GetTableSession(ctx context.Context, sessionid pgtype.UUID) (*GetTableSessionRow, error)
+	GetTableStatusForUpdate(ctx context.Context, id int64) (*GetTableStatusForUpdateRow, error)
 	GetTimeNow(ctx context.Context) (pgtype.Timestamptz, error)
 	GetTotalAmountToPayForServedItems(ctx context.Context, id int64) (pgtype.Numeric, error)
 	GetTotalItemOrderWithItems(ctx context.Context, orderID int64) (int64, error)
@@ -55,6 +114,10 @@ type Querier interface {
 	GetTotalSearchOrderItems(ctx context.Context, arg GetTotalSearchOrderItemsParams) (int64, error)
 	GetTotalSearchOrderItemsIsNotFinal(ctx context.Context, arg GetTotalSearchOrderItemsIsNotFinalParams) (int64, error)
 	Health(ctx context.Context) (int32, error)
+	IncreaseProductStock(ctx context.Context, arg IncreaseProductStockParams) error
+	IsCategoryExists(ctx context.Context, id int64) (bool, error)
+	IsIngredientExists(ctx context.Context, id int64) (bool, error)
+	IsMenuVersioned(ctx context.Context) (bool, error)
 	IsOrderExist(ctx context.Context, id int64) (bool, error)
 	IsOrderItemsExist(ctx context.Context, id int64) (bool, error)
 	IsOrderItemsNotFinal(ctx context.Context, orderID int64) (bool, error)
@@ -62,23 +125,73 @@ type Querier interface {
 	IsOrderStatusFinal(ctx context.Context, code string) (bool, error)
 	IsOrderWithItemsExists(ctx context.Context, arg IsOrderWithItemsExistsParams) (bool, error)
 	IsProductExists(ctx context.Context, id int64) (bool, error)
+	IsProductSoldOut(ctx context.Context, id int64) (bool, error)
+	IsProductStockTracked(ctx context.Context, id int64) (bool, error)
+	IsProductWithinSchedule(ctx context.Context, arg IsProductWithinScheduleParams) (bool, error)
 	IsSessionExtensionModeFree(ctx context.Context, id int64) (bool, error)
 	IsSessionExtensionReasonExist(ctx context.Context, id int64) (int64, error)
 	IsTableAvailableOrReserved(ctx context.Context, id int64) (bool, error)
 	IsTableExists(ctx context.Context, id int64) (bool, error)
 	IsTableSessionActive(ctx context.Context, sessionid pgtype.UUID) (bool, error)
 	IsTableSessionExists(ctx context.Context, sessionid pgtype.UUID) (bool, error)
+	ListActivePromotionsForProduct(ctx context.Context, arg ListActivePromotionsForProductParams) ([]*ListActivePromotionsForProductRow, error)
+	ListAllCategory(ctx context.Context) ([]*ListAllCategoryRow, error)
+	ListAvailableProductIDs(ctx context.Context, ids []int64) ([]int64, error)
+	ListBillItemsByOrderID(ctx context.Context, orderID int64) ([]*ListBillItemsByOrderIDRow, error)
 	ListCategory(ctx context.Context) ([]*ListCategoryRow, error)
+	ListComboSlotOptionsBySlotIDs(ctx context.Context, slotIds []int64) ([]*ListComboSlotOptionsBySlotIDsRow, error)
+	ListComboSlotsByProductIDs(ctx context.Context, productIds []int64) ([]*ListComboSlotsByProductIDsRow, error)
+	ListDietaryTags(ctx context.Context) ([]*ListDietaryTagsRow, error)
+	ListDietaryTagsByProductIDs(ctx context.Context, productIds []int64) ([]*ListDietaryTagsByProductIDsRow, error)
+	ListIngredientMovementsByOrderItemID(ctx context.Context, orderItemID int64) ([]*ListIngredientMovementsByOrderItemIDRow, error)
+	ListIngredients(ctx context.Context) ([]*ListIngredientsRow, error)
+	ListLowStockIngredients(ctx context.Context) ([]*ListLowStockIngredientsRow, error)
+	ListMenuSchedulesByCategoryID(ctx context.Context, categoryID int64) ([]*ListMenuSchedulesByCategoryIDRow, error)
+	ListMenuSchedulesByProductID(ctx context.Context, productID int64) ([]*ListMenuSchedulesByProductIDRow, error)
+	ListMenuVersions(ctx context.Context) ([]*ListMenuVersionsRow, error)
+	ListModifierGroupsByProductIDs(ctx context.Context, productIds []int64) ([]*ListModifierGroupsByProductIDsRow, error)
+	ListModifiersByGroupIDs(ctx context.Context, groupIds []int64) ([]*ListModifiersByGroupIDsRow, error)
 	ListOrderStatus(ctx context.Context) ([]*ListOrderStatusRow, error)
+	ListOutboxEventsByKey(ctx context.Context, arg ListOutboxEventsByKeyParams) ([]*ListOutboxEventsByKeyRow, error)
 	ListPaymentMethods(ctx context.Context) ([]*ListPaymentMethodsRow, error)
+	ListProductIngredients(ctx context.Context, productID int64) ([]*ListProductIngredientsRow, error)
+	ListProductIngredientsForUpdate(ctx context.Context, arg ListProductIngredientsForUpdateParams) ([]*ListProductIngredientsForUpdateRow, error)
+	ListProductPrices(ctx context.Context, productID int64) ([]*ListProductPricesRow, error)
+	ListProductSearchKeywords(ctx context.Context, productID int64) ([]string, error)
 	ListProductTimeExtension(ctx context.Context) ([]*ListProductTimeExtensionRow, error)
+	ListProductsForMenuSnapshot(ctx context.Context) ([]*ListProductsForMenuSnapshotRow, error)
+	ListPromotions(ctx context.Context) ([]*ListPromotionsRow, error)
+	ListPublishedProducts(ctx context.Context, arg ListPublishedProductsParams) ([][]byte, error)
+	ListRecommendedProducts(ctx context.Context, arg ListRecommendedProductsParams) ([]*ListRecommendedProductsRow, error)
+	ListSearchAliases(ctx context.Context) ([]*ListSearchAliasesRow, error)
 	ListSessionExtensionReason(ctx context.Context) ([]*ListSessionExtensionReasonRow, error)
+	ListSessionOrderedProductIDs(ctx context.Context, sessionID pgtype.UUID) ([]int64, error)
+	ListTableServiceRequests(ctx context.Context, arg ListTableServiceRequestsParams) ([]*ListTableServiceRequestsRow, error)
 	ListTableStatus(ctx context.Context) ([]*ListTableStatusRow, error)
+	ListTranslationLocales(ctx context.Context) ([]string, error)
+	ListTranslations(ctx context.Context, arg ListTranslationsParams) ([]*ListTranslationsRow, error)
+	ListTranslationsByEntity(ctx context.Context, arg ListTranslationsByEntityParams) ([]*ListTranslationsByEntityRow, error)
+	ListVariantsByProductIDs(ctx context.Context, productIds []int64) ([]*ListVariantsByProductIDsRow, error)
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
+	MarkOutboxEventSent(ctx context.Context, id int64) error
+	// the key stays locked until the transaction ends, so events of one key get their seq in commit order
+	NextOutboxEventSeq(ctx context.Context, arg NextOutboxEventSeqParams) (int64, error)
 	QuickSearchTables(ctx context.Context, arg QuickSearchTablesParams) ([]*QuickSearchTablesRow, error)
+	RecordProductPriceChange(ctx context.Context, arg RecordProductPriceChangeParams) error
+	RefreshProductAssociations(ctx context.Context, arg RefreshProductAssociationsParams) (int64, error)
 	SearchOrderItems(ctx context.Context, arg SearchOrderItemsParams) ([]*SearchOrderItemsRow, error)
 	SearchOrderItemsIsNotFinal(ctx context.Context, arg SearchOrderItemsIsNotFinalParams) ([]*SearchOrderItemsIsNotFinalRow, error)
 	SearchProducts(ctx context.Context, arg SearchProductsParams) ([]*SearchProductsRow, error)
 	SearchTables(ctx context.Context, arg SearchTablesParams) ([]*SearchTablesRow, error)
+	TryLockProductAssociations(ctx context.Context) (bool, error)
+	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (int64, error)
+	UpdateCategorySortOrder(ctx context.Context, arg UpdateCategorySortOrderParams) error
+	UpdateCategoryVisibility(ctx context.Context, arg UpdateCategoryVisibilityParams) (int64, error)
+	UpdateComboSlot(ctx context.Context, arg UpdateComboSlotParams) (int64, error)
+	UpdateIngredient(ctx context.Context, arg UpdateIngredientParams) (int64, error)
+	UpdateModifier(ctx context.Context, arg UpdateModifierParams) (int64, error)
+	UpdateModifierGroup(ctx context.Context, arg UpdateModifierGroupParams) (int64, error)
+	UpdateOrderItemsIngredientsDeducted(ctx context.Context, arg UpdateOrderItemsIngredientsDeductedParams) error
 	UpdateOrderItemsStatus(ctx context.Context, arg UpdateOrderItemsStatusParams) error
 	UpdateOrderItemsStatusServed(ctx context.Context, id int64) error
 	UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) error
@@ -86,6 +199,12 @@ type Querier interface {
 	UpdateOrderStatusWaitForPayment(ctx context.Context, id int64) error
 	UpdateProduct(ctx context.Context, arg UpdateProductParams) error
 	UpdateProductAvailability(ctx context.Context, arg UpdateProductAvailabilityParams) error
+	UpdateProductImage(ctx context.Context, arg UpdateProductImageParams) (int64, error)
+	UpdateProductStock(ctx context.Context, arg UpdateProductStockParams) (int64, error)
+	UpdateProductVisibility(ctx context.Context, arg UpdateProductVisibilityParams) error
+	UpdateProductsAvailableByIngredients(ctx context.Context, ingredientIds []int64) error
+	UpdateProductsUnavailableByIngredients(ctx context.Context, ingredientIds []int64) error
+	UpdatePromotion(ctx context.Context, arg UpdatePromotionParams) (int64, error)
 	UpdateSessionExpireBySessionID(ctx context.Context, arg UpdateSessionExpireBySessionIDParams) error
 	UpdateStatusCloseTableSession(ctx context.Context, sessionid pgtype.UUID) error
 	UpdateStatusPaymentCancelledByTransactionID(ctx context.Context, transactionID string) error
@@ -94,9 +213,12 @@ type Querier interface {
 	UpdateStatusPaymentPendingByTransactionID(ctx context.Context, transactionID string) error
 	UpdateStatusPaymentSuccessByTransactionID(ctx context.Context, transactionID string) error
 	UpdateStatusPaymentTimeOutByTransactionID(ctx context.Context, transactionID string) error
+	UpdateTableServiceRequestAcknowledged(ctx context.Context, id int64) error
+	UpdateTableServiceRequestResolved(ctx context.Context, id int64) error
 	UpdateTables(ctx context.Context, arg UpdateTablesParams) error
 	UpdateTablesStatus(ctx context.Context, arg UpdateTablesStatusParams) error
 	UpdateTablesStatusAvailable(ctx context.Context, id int64) error
+	UpdateTablesStatusCallWaiter(ctx context.Context, id int64) error
 	UpdateTablesStatusCleaning(ctx context.Context, id int64) error
 	UpdateTablesStatusDisabled(ctx context.Context, id int64) error
 	UpdateTablesStatusFoodServed(ctx context.Context, id int64) error
@@ -104,6 +226,7 @@ type Querier interface {
 	UpdateTablesStatusWaitToOrder(ctx context.Context, id int64) error
 	UpdateTablesStatusWaitingForPayment(ctx context.Context, id int64) error
 	UpdateTablesStatusWaitingToBeServed(ctx context.Context, id int64) error
+	UpdateVariant(ctx context.Context, arg UpdateVariantParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
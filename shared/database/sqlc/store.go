@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"food-story/shared/model"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -12,6 +13,27 @@ type Store interface {
 	TXCreateTableSession(ctx context.Context, arg CreateTableSessionParams) error
 	TXCreateOrder(ctx context.Context, arg TXCreateOrderParams) (int64, error)
 	TXSessionsExtension(ctx context.Context, arg TXSessionsExtensionParams) error
+	TXUpdateCategorySortOrder(ctx context.Context, arg TXUpdateCategorySortOrderParams) error
+	TXReplaceModifierGroups(ctx context.Context, arg TXReplaceModifierGroupsParams) error
+	TXReplaceVariants(ctx context.Context, arg TXReplaceVariantsParams) error
+	TXReplaceMenuSchedules(ctx context.Context, arg TXReplaceMenuSchedulesParams) error
+	TXCreateOrderItems(ctx context.Context, arg TXCreateOrderItemsParams) error
+	TXUpdateOrderItemsStatus(ctx context.Context, arg TXUpdateOrderItemsStatusParams) error
+	TXAmendOrderItem(ctx context.Context, arg TXAmendOrderItemParams) error
+	TXCreateTableServiceRequest(ctx context.Context, arg TXCreateTableServiceRequestParams) (result *model.TableServiceRequest, created bool, err error)
+	TXUpdateTableServiceRequestStatus(ctx context.Context, arg TXUpdateTableServiceRequestStatusParams) (result *model.TableServiceRequest, err error)
+	TXReplaceProductIngredients(ctx context.Context, arg TXReplaceProductIngredientsParams) error
+	TXCreateIngredientMovement(ctx context.Context, arg CreateIngredientMovementParams) error
+	TXReplaceComboSlots(ctx context.Context, arg TXReplaceComboSlotsParams) error
+	TXReplaceProductSearchKeywords(ctx context.Context, arg TXReplaceProductSearchKeywordsParams) error
+	TXReplaceProductDietaryTags(ctx context.Context, arg CreateProductDietaryTagsParams) error
+	TXReplaceTranslations(ctx context.Context, arg TXReplaceTranslationsParams) error
+	TXCreateProduct(ctx context.Context, arg TXCreateProductParams) (int64, error)
+	TXUpdateProduct(ctx context.Context, arg TXUpdateProductParams) error
+	TXPublishMenuVersion(ctx context.Context, arg TXPublishMenuVersionParams) (*CreateMenuVersionRow, error)
+	TXRefreshProductAssociations(ctx context.Context, arg RefreshProductAssociationsParams) (rows int64, refreshed bool, err error)
+	TXCreatePayment(ctx context.Context, arg CreatePaymentParams) error
+	TXCallbackPayment(ctx context.Context, arg TXCallbackPaymentParams) error
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
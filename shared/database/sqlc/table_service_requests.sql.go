@@ -0,0 +1,190 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: table_service_requests.sql
+
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createTableServiceRequest = `-- name: CreateTableServiceRequest :exec
+INSERT INTO public.table_service_requests (id, session_id, table_id, order_id, request_type, reason, previous_table_status_id)
+VALUES ($1::bigint,
+        $2::uuid,
+        $3::bigint,
+        $4::bigint,
+        $5::varchar,
+        $6::varchar,
+        $7::bigint)
+`
+
+type CreateTableServiceRequestParams struct {
+	ID                    int64       `json:"id"`
+	SessionID             pgtype.UUID `json:"session_id"`
+	TableID               int64       `json:"table_id"`
+	OrderID               pgtype.Int8 `json:"order_id"`
+	RequestType           string      `json:"request_type"`
+	Reason                pgtype.Text `json:"reason"`
+	PreviousTableStatusID int64       `json:"previous_table_status_id"`
+}
+
+func (q *Queries) CreateTableServiceRequest(ctx context.Context, arg CreateTableServiceRequestParams) error {
+	_, err := q.db.Exec(ctx, createTableServiceRequest,
+		arg.ID,
+		arg.SessionID,
+		arg.TableID,
+		arg.OrderID,
+		arg.RequestType,
+		arg.Reason,
+		arg.PreviousTableStatusID,
+	)
+	return err
+}
+
+const getOpenTableServiceRequestID = `-- name: GetOpenTableServiceRequestID :one
+SELECT id
+FROM public.table_service_requests
+WHERE session_id = $1::uuid
+  AND request_type = $2::varchar
+  AND status <> 'RESOLVED'
+`
+
+type GetOpenTableServiceRequestIDParams struct {
+	SessionID   pgtype.UUID `json:"session_id"`
+	RequestType string      `json:"request_type"`
+}
+
+func (q *Queries) GetOpenTableServiceRequestID(ctx context.Context, arg GetOpenTableServiceRequestIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getOpenTableServiceRequestID, arg.SessionID, arg.RequestType)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getTableServiceRequestForUpdate = `-- name: GetTableServiceRequestForUpdate :one
+SELECT id,
+       table_id                 as "tableID",
+       request_type             as "requestType",
+       status,
+       previous_table_status_id as "previousTableStatusID"
+FROM public.table_service_requests
+WHERE id = $1::bigint
+    FOR UPDATE
+`
+
+type GetTableServiceRequestForUpdateRow struct {
+	ID                    int64  `json:"id"`
+	TableID               int64  `json:"tableID"`
+	RequestType           string `json:"requestType"`
+	Status                string `json:"status"`
+	PreviousTableStatusID int64  `json:"previousTableStatusID"`
+}
+
+func (q *Queries) GetTableServiceRequestForUpdate(ctx context.Context, id int64) (*GetTableServiceRequestForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getTableServiceRequestForUpdate, id)
+	var i GetTableServiceRequestForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.TableID,
+		&i.RequestType,
+		&i.Status,
+		&i.PreviousTableStatusID,
+	)
+	return &i, err
+}
+
+const listTableServiceRequests = `-- name: ListTableServiceRequests :many
+SELECT r.id,
+       r.table_id        as "tableID",
+       t.table_number    as "tableNumber",
+       r.order_id        as "orderID",
+       r.request_type    as "requestType",
+       r.reason,
+       r.status,
+       r.created_at      as "createdAt",
+       r.acknowledged_at as "acknowledgedAt",
+       r.resolved_at     as "resolvedAt"
+FROM public.table_service_requests r
+         JOIN public.tables t ON t.id = r.table_id
+WHERE ($1::bigint IS NULL OR r.id = $1::bigint)
+  AND (NOT $2::bool OR r.status <> 'RESOLVED')
+ORDER BY r.created_at, r.id
+`
+
+type ListTableServiceRequestsParams struct {
+	ID       pgtype.Int8 `json:"id"`
+	OpenOnly bool        `json:"open_only"`
+}
+
+type ListTableServiceRequestsRow struct {
+	ID             int64              `json:"id"`
+	TableID        int64              `json:"tableID"`
+	TableNumber    int32              `json:"tableNumber"`
+	OrderID        pgtype.Int8        `json:"orderID"`
+	RequestType    string             `json:"requestType"`
+	Reason         pgtype.Text        `json:"reason"`
+	Status         string             `json:"status"`
+	CreatedAt      pgtype.Timestamptz `json:"createdAt"`
+	AcknowledgedAt pgtype.Timestamptz `json:"acknowledgedAt"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolvedAt"`
+}
+
+func (q *Queries) ListTableServiceRequests(ctx context.Context, arg ListTableServiceRequestsParams) ([]*ListTableServiceRequestsRow, error) {
+	rows, err := q.db.Query(ctx, listTableServiceRequests, arg.ID, arg.OpenOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListTableServiceRequestsRow{}
+	for rows.Next() {
+		var i ListTableServiceRequestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TableID,
+			&i.TableNumber,
+			&i.OrderID,
+			&i.RequestType,
+			&i.Reason,
+			&i.Status,
+			&i.CreatedAt,
+			&i.AcknowledgedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTableServiceRequestAcknowledged = `-- name: UpdateTableServiceRequestAcknowledged :exec
+UPDATE public.table_service_requests
+SET status          = 'ACKNOWLEDGED',
+    acknowledged_at = NOW()
+WHERE id = $1::bigint
+`
+
+func (q *Queries) UpdateTableServiceRequestAcknowledged(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, updateTableServiceRequestAcknowledged, id)
+	return err
+}
+
+const updateTableServiceRequestResolved = `-- name: UpdateTableServiceRequestResolved :exec
+UPDATE public.table_service_requests
+SET status          = 'RESOLVED',
+    acknowledged_at = COALESCE(acknowledged_at, NOW()),
+    resolved_at     = NOW()
+WHERE id = $1::bigint
+`
+
+func (q *Queries) UpdateTableServiceRequestResolved(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, updateTableServiceRequestResolved, id)
+	return err
+}
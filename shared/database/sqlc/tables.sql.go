@@ -41,6 +41,27 @@ func (q *Queries) GetTableNumber(ctx context.Context, id int64) (int32, error) {
 	return tableNumber, err
 }
 
+const getTableStatusForUpdate = `-- name: GetTableStatusForUpdate :one
+SELECT t.status_id as "statusID",
+       s.code      as "statusCode"
+FROM public.tables t
+         JOIN public.md_table_statuses s ON s.id = t.status_id
+WHERE t.id = $1::bigint
+    FOR UPDATE OF t
+`
+
+type GetTableStatusForUpdateRow struct {
+	StatusID   int64  `json:"statusID"`
+	StatusCode string `json:"statusCode"`
+}
+
+func (q *Queries) GetTableStatusForUpdate(ctx context.Context, id int64) (*GetTableStatusForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getTableStatusForUpdate, id)
+	var i GetTableStatusForUpdateRow
+	err := row.Scan(&i.StatusID, &i.StatusCode)
+	return &i, err
+}
+
 const getTotalPageQuickSearchTables = `-- name: GetTotalPageQuickSearchTables :one
 SELECT COUNT(*) as "totalItems"
 FROM public.tables t
@@ -382,6 +403,17 @@ func (q *Queries) UpdateTablesStatusAvailable(ctx context.Context, id int64) err
 	return err
 }
 
+const updateTablesStatusCallWaiter = `-- name: UpdateTablesStatusCallWaiter :exec
+UPDATE public.tables
+SET status_id=(select id from public.md_table_statuses WHERE code = 'CALL_WAITER'), updated_at = NOW()
+WHERE id=$1::bigint
+`
+
+func (q *Queries) UpdateTablesStatusCallWaiter(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, updateTablesStatusCallWaiter, id)
+	return err
+}
+
 const updateTablesStatusCleaning = `-- name: UpdateTablesStatusCleaning :exec
 UPDATE public.tables
 SET status_id=(select id from public.md_table_statuses WHERE code = 'CLEANING'), updated_at = NOW()
@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: translations.sql
+
+package database
+
+import (
+	"context"
+)
+
+const createTranslation = `-- name: CreateTranslation :exec
+INSERT INTO public.translations (entity_type, entity_id, field, locale, value)
+VALUES ($1::varchar, $2::bigint, $3::varchar, $4::varchar, $5::text)
+`
+
+type CreateTranslationParams struct {
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+	Field      string `json:"field"`
+	Locale     string `json:"locale"`
+	Value      string `json:"value"`
+}
+
+func (q *Queries) CreateTranslation(ctx context.Context, arg CreateTranslationParams) error {
+	_, err := q.db.Exec(ctx, createTranslation,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Field,
+		arg.Locale,
+		arg.Value,
+	)
+	return err
+}
+
+const deleteTranslationsByEntity = `-- name: DeleteTranslationsByEntity :exec
+DELETE FROM public.translations
+WHERE entity_type = $1::varchar
+  AND entity_id = $2::bigint
+`
+
+type DeleteTranslationsByEntityParams struct {
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+}
+
+func (q *Queries) DeleteTranslationsByEntity(ctx context.Context, arg DeleteTranslationsByEntityParams) error {
+	_, err := q.db.Exec(ctx, deleteTranslationsByEntity, arg.EntityType, arg.EntityID)
+	return err
+}
+
+const listTranslationLocales = `-- name: ListTranslationLocales :many
+SELECT DISTINCT locale FROM public.translations ORDER BY locale
+`
+
+func (q *Queries) ListTranslationLocales(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTranslationLocales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var locale string
+		if err := rows.Scan(&locale); err != nil {
+			return nil, err
+		}
+		items = append(items, locale)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTranslations = `-- name: ListTranslations :many
+SELECT entity_type as "entityType", entity_id as "entityID", field, locale, value
+FROM public.translations
+WHERE entity_id = ANY($1::bigint[])
+  AND locale = ANY($2::varchar[])
+`
+
+type ListTranslationsParams struct {
+	EntityIds []int64  `json:"entity_ids"`
+	Locales   []string `json:"locales"`
+}
+
+type ListTranslationsRow struct {
+	EntityType string `json:"entityType"`
+	EntityID   int64  `json:"entityID"`
+	Field      string `json:"field"`
+	Locale     string `json:"locale"`
+	Value      string `json:"value"`
+}
+
+func (q *Queries) ListTranslations(ctx context.Context, arg ListTranslationsParams) ([]*ListTranslationsRow, error) {
+	rows, err := q.db.Query(ctx, listTranslations, arg.EntityIds, arg.Locales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListTranslationsRow{}
+	for rows.Next() {
+		var i ListTranslationsRow
+		if err := rows.Scan(
+			&i.EntityType,
+			&i.EntityID,
+			&i.Field,
+			&i.Locale,
+			&i.Value,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTranslationsByEntity = `-- name: ListTranslationsByEntity :many
+SELECT field, locale, value
+FROM public.translations
+WHERE entity_type = $1::varchar
+  AND entity_id = $2::bigint
+ORDER BY locale, field
+`
+
+type ListTranslationsByEntityParams struct {
+	EntityType string `json:"entity_type"`
+	EntityID   int64  `json:"entity_id"`
+}
+
+type ListTranslationsByEntityRow struct {
+	Field  string `json:"field"`
+	Locale string `json:"locale"`
+	Value  string `json:"value"`
+}
+
+func (q *Queries) ListTranslationsByEntity(ctx context.Context, arg ListTranslationsByEntityParams) ([]*ListTranslationsByEntityRow, error) {
+	rows, err := q.db.Query(ctx, listTranslationsByEntity, arg.EntityType, arg.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListTranslationsByEntityRow{}
+	for rows.Next() {
+		var i ListTranslationsByEntityRow
+		if err := rows.Scan(&i.Field, &i.Locale, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
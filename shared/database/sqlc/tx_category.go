@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type TXUpdateCategorySortOrderParams struct {
+	ID        int64
+	SortOrder int32
+}
+
+func (store *SQLStore) TXUpdateCategorySortOrder(ctx context.Context, arg TXUpdateCategorySortOrderParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		currentSortOrder, err := q.GetCategorySortOrderForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if currentSortOrder == arg.SortOrder {
+			return nil
+		}
+
+		targetID, err := q.GetCategoryIDBySortOrderForUpdate(ctx, arg.SortOrder)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return q.UpdateCategorySortOrder(ctx, UpdateCategorySortOrderParams{
+					ID:        arg.ID,
+					SortOrder: arg.SortOrder,
+				})
+			}
+			return err
+		}
+
+		// sort_order เป็น unique จึงต้องย้ายไปค่าชั่วคราวก่อนสลับ
+		err = q.UpdateCategorySortOrder(ctx, UpdateCategorySortOrderParams{
+			ID:        arg.ID,
+			SortOrder: -currentSortOrder,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.UpdateCategorySortOrder(ctx, UpdateCategorySortOrderParams{
+			ID:        targetID,
+			SortOrder: currentSortOrder,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.UpdateCategorySortOrder(ctx, UpdateCategorySortOrderParams{
+			ID:        arg.ID,
+			SortOrder: arg.SortOrder,
+		})
+	})
+
+	return err
+}
@@ -8,6 +8,8 @@ import (
 type TXCreateOrderParams struct {
 	CreateOrder      CreateOrderParams
 	CreateOrderItems []CreateOrderItemsParams
+	GenerateID       func() int64
+	OutboxTopic      string
 }
 
 func (store *SQLStore) TXCreateOrder(ctx context.Context, arg TXCreateOrderParams) (int64, error) {
@@ -21,6 +23,11 @@ func (store *SQLStore) TXCreateOrder(ctx context.Context, arg TXCreateOrderParam
 		orderID = orderIDRaw
 
 		if len(arg.CreateOrderItems) > 0 {
+			err := decreaseOrderItemsStock(ctx, q, arg.CreateOrderItems)
+			if err != nil {
+				return err
+			}
+
 			for index := range arg.CreateOrderItems {
 				arg.CreateOrderItems[index].OrderID = orderID
 			}
@@ -28,6 +35,11 @@ func (store *SQLStore) TXCreateOrder(ctx context.Context, arg TXCreateOrderParam
 			if itemsError != nil {
 				return itemsError
 			}
+
+			err = createOrderItemsOutboxEvents(ctx, q, arg.GenerateID, arg.OutboxTopic, arg.CreateOrderItems)
+			if err != nil {
+				return err
+			}
 		}
 
 		err := q.UpdateTablesStatusWaitingToBeServed(ctx, arg.CreateOrder.TableID)
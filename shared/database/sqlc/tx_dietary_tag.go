@@ -0,0 +1,23 @@
+package database
+
+import (
+	"context"
+)
+
+func (store *SQLStore) TXReplaceProductDietaryTags(ctx context.Context, arg CreateProductDietaryTagsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteProductDietaryTags(ctx, arg.ProductID)
+		if err != nil {
+			return err
+		}
+
+		if len(arg.Codes) == 0 {
+			return nil
+		}
+
+		return q.CreateProductDietaryTags(ctx, arg)
+	})
+
+	return err
+}
@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/exceptions"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	IngredientMovementOrder   = "ORDER"
+	IngredientMovementCancel  = "CANCEL"
+	IngredientMovementRestock = "RESTOCK"
+	IngredientMovementAdjust  = "ADJUST"
+)
+
+type TXReplaceProductIngredientsParams struct {
+	ProductID                int64
+	CreateProductIngredients []CreateProductIngredientParams
+}
+
+func (store *SQLStore) TXReplaceProductIngredients(ctx context.Context, arg TXReplaceProductIngredientsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteProductIngredients(ctx, arg.ProductID)
+		if err != nil {
+			return err
+		}
+
+		ingredientIDs := make([]int64, len(arg.CreateProductIngredients))
+		for index, ingredient := range arg.CreateProductIngredients {
+			ingredient.ProductID = arg.ProductID
+			err = q.CreateProductIngredient(ctx, ingredient)
+			if err != nil {
+				return err
+			}
+			ingredientIDs[index] = ingredient.IngredientID
+		}
+
+		return syncProductsAvailabilityByIngredients(ctx, q, ingredientIDs)
+	})
+
+	return err
+}
+
+func (store *SQLStore) TXCreateIngredientMovement(ctx context.Context, arg CreateIngredientMovementParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		rowsAffected, err := q.AdjustIngredientStock(ctx, AdjustIngredientStockParams{
+			ID:       arg.IngredientID,
+			Quantity: arg.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("%w: ingredient id %d", exceptions.ErrIngredientInsufficient, arg.IngredientID)
+		}
+
+		err = q.CreateIngredientMovement(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		return syncProductsAvailabilityByIngredients(ctx, q, []int64{arg.IngredientID})
+	})
+
+	return err
+}
+
+// syncProductsAvailabilityByIngredients ปิดการขายสินค้าที่วัตถุดิบไม่พอ และเปิดขายคืนสินค้าที่ระบบเคยปิดไว้เมื่อวัตถุดิบทุกรายการพอแล้ว
+func syncProductsAvailabilityByIngredients(ctx context.Context, q *Queries, ingredientIDs []int64) error {
+	err := q.UpdateProductsUnavailableByIngredients(ctx, ingredientIDs)
+	if err != nil {
+		return err
+	}
+
+	return q.UpdateProductsAvailableByIngredients(ctx, ingredientIDs)
+}
+
+func deductOrderItemIngredients(ctx context.Context, q *Queries, generateID func() int64, orderItemID, productID int64, quantity int32) ([]int64, error) {
+	ingredients, err := q.ListProductIngredientsForUpdate(ctx, ListProductIngredientsForUpdateParams{
+		ProductID: productID,
+		Quantity:  quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ingredients) == 0 {
+		return nil, nil
+	}
+
+	ingredientIDs := make([]int64, len(ingredients))
+	for index, ingredient := range ingredients {
+		usedQuantity := negateNumeric(ingredient.RequiredQuantity)
+		rowsAffected, err := q.AdjustIngredientStock(ctx, AdjustIngredientStockParams{
+			ID:       ingredient.ID,
+			Quantity: usedQuantity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if rowsAffected == 0 {
+			return nil, fmt.Errorf("%w: %s", exceptions.ErrIngredientInsufficient, ingredient.NameEN)
+		}
+
+		err = q.CreateIngredientMovement(ctx, CreateIngredientMovementParams{
+			ID:           generateID(),
+			IngredientID: ingredient.ID,
+			OrderItemID:  pgtype.Int8{Int64: orderItemID, Valid: true},
+			Quantity:     usedQuantity,
+			Reason:       IngredientMovementOrder,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ingredientIDs[index] = ingredient.ID
+	}
+
+	err = q.UpdateOrderItemsIngredientsDeducted(ctx, UpdateOrderItemsIngredientsDeductedParams{
+		ID:                  orderItemID,
+		IngredientsDeducted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ingredientIDs, nil
+}
+
+// reverseOrderItemIngredients คืนวัตถุดิบที่ตัดไปของรายการ แล้วเปิดขายคืนสินค้าที่ระบบปิดไว้ถ้าวัตถุดิบพอแล้ว
+func reverseOrderItemIngredients(ctx context.Context, q *Queries, generateID func() int64, orderItemID int64) error {
+	movements, err := q.ListIngredientMovementsByOrderItemID(ctx, orderItemID)
+	if err != nil {
+		return err
+	}
+
+	var ingredientIDs []int64
+	for _, movement := range movements {
+		if !movement.Quantity.Valid || movement.Quantity.Int.Sign() >= 0 {
+			continue
+		}
+
+		returnedQuantity := negateNumeric(movement.Quantity)
+		_, err = q.AdjustIngredientStock(ctx, AdjustIngredientStockParams{
+			ID:       movement.IngredientID,
+			Quantity: returnedQuantity,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.CreateIngredientMovement(ctx, CreateIngredientMovementParams{
+			ID:           generateID(),
+			IngredientID: movement.IngredientID,
+			OrderItemID:  pgtype.Int8{Int64: orderItemID, Valid: true},
+			Quantity:     returnedQuantity,
+			Reason:       IngredientMovementCancel,
+		})
+		if err != nil {
+			return err
+		}
+
+		ingredientIDs = append(ingredientIDs, movement.IngredientID)
+	}
+
+	if len(ingredientIDs) > 0 {
+		err = q.UpdateProductsAvailableByIngredients(ctx, ingredientIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.UpdateOrderItemsIngredientsDeducted(ctx, UpdateOrderItemsIngredientsDeductedParams{
+		ID:                  orderItemID,
+		IngredientsDeducted: false,
+	})
+}
+
+func negateNumeric(value pgtype.Numeric) pgtype.Numeric {
+	if !value.Valid || value.Int == nil {
+		return value
+	}
+
+	return pgtype.Numeric{
+		Int:   new(big.Int).Neg(value.Int),
+		Exp:   value.Exp,
+		Valid: true,
+	}
+}
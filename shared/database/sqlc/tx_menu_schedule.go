@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TXReplaceMenuSchedulesParams struct {
+	ProductID           pgtype.Int8
+	CategoryID          pgtype.Int8
+	CreateMenuSchedules []CreateMenuScheduleParams
+}
+
+func (store *SQLStore) TXReplaceMenuSchedules(ctx context.Context, arg TXReplaceMenuSchedulesParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		if arg.ProductID.Valid {
+			err = q.DeleteMenuSchedulesByProductID(ctx, arg.ProductID.Int64)
+		} else {
+			err = q.DeleteMenuSchedulesByCategoryID(ctx, arg.CategoryID.Int64)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range arg.CreateMenuSchedules {
+			schedule.ProductID = arg.ProductID
+			schedule.CategoryID = arg.CategoryID
+			err = q.CreateMenuSchedule(ctx, schedule)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TXPublishMenuVersionParams struct {
+	CreateMenuVersion CreateMenuVersionParams
+	ProductPrices     []RecordProductPriceChangeParams
+}
+
+// TXPublishMenuVersion stores the snapshot and schedules its product prices for the moment it goes live, so
+// orders are charged what the published menu shows. Deleting a scheduled version cascades to those prices.
+func (store *SQLStore) TXPublishMenuVersion(ctx context.Context, arg TXPublishMenuVersionParams) (*CreateMenuVersionRow, error) {
+	var version *CreateMenuVersionRow
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		version, err = q.CreateMenuVersion(ctx, arg.CreateMenuVersion)
+		if err != nil {
+			return err
+		}
+
+		for _, price := range arg.ProductPrices {
+			price.EffectiveFrom = version.PublishAt
+			price.MenuVersionID = pgtype.Int8{Int64: arg.CreateMenuVersion.ID, Valid: true}
+			err = q.RecordProductPriceChange(ctx, price)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return version, err
+}
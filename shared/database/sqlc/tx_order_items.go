@@ -0,0 +1,404 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	"food-story/shared/model"
+	"food-story/shared/orderstatus"
+	"sort"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TXCreateOrderItemsParams struct {
+	CreateOrderItems []CreateOrderItemsParams
+	GenerateID       func() int64
+	OutboxTopic      string
+}
+
+func (store *SQLStore) TXCreateOrderItems(ctx context.Context, arg TXCreateOrderItemsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		return createOrderItems(ctx, q, arg)
+	})
+
+	return err
+}
+
+// createOrderItems จองสต็อกสินค้าเมื่อสั่ง ส่วนวัตถุดิบยังไม่ตัดจนกว่ารายการจะเริ่มทำหรือเสิร์ฟ
+func createOrderItems(ctx context.Context, q *Queries, arg TXCreateOrderItemsParams) error {
+	err := decreaseOrderItemsStock(ctx, q, arg.CreateOrderItems)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateOrderItems(ctx, arg.CreateOrderItems)
+	if err != nil {
+		return err
+	}
+
+	err = createOrderItemsOutboxEvents(ctx, q, arg.GenerateID, arg.OutboxTopic, arg.CreateOrderItems)
+	if err != nil {
+		return err
+	}
+
+	tableID, err := q.GetTableIDByOrderID(ctx, arg.CreateOrderItems[0].OrderID)
+	if err != nil {
+		return err
+	}
+
+	return q.UpdateTablesStatusWaitingToBeServed(ctx, tableID)
+}
+
+type TXUpdateOrderItemsStatusParams struct {
+	UpdateOrderItemsStatus UpdateOrderItemsStatusParams
+	Actor                  orderstatus.Actor
+	GenerateID             func() int64
+	OutboxTopic            string
+}
+
+// TXUpdateOrderItemsStatus ตรวจสอบการเปลี่ยนสถานะกับ orderstatus.OrderItem ขณะล็อกแถวไว้ แล้วตัดวัตถุดิบเมื่อเริ่มทำ/เสิร์ฟ
+// และคืนสต็อกสินค้ากับวัตถุดิบเมื่อรายการถูกยกเลิก หากรายการเป็นส่วนประกอบของชุดเมนู จะปรับสถานะรายการชุดเมนูตามส่วนประกอบด้วย
+// ทุกการเปลี่ยนสถานะจะเขียนข้อความลง outbox ใน transaction เดียวกัน
+func (store *SQLStore) TXUpdateOrderItemsStatus(ctx context.Context, arg TXUpdateOrderItemsStatusParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		orderItem, err := updateOrderItemStatus(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		if !orderItem.ParentOrderItemID.Valid {
+			return nil
+		}
+
+		return syncComboOrderItemStatus(ctx, q, arg, orderItem.ParentOrderItemID.Int64)
+	})
+
+	return err
+}
+
+func updateOrderItemStatus(ctx context.Context, q *Queries, tx TXUpdateOrderItemsStatusParams) (*GetOrderItemsStatusForUpdateRow, error) {
+	arg, generateID := tx.UpdateOrderItemsStatus, tx.GenerateID
+	orderItem, err := q.GetOrderItemsStatusForUpdate(ctx, arg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = orderstatus.OrderItem.Validate(orderstatus.Status{Code: orderItem.StatusCode, IsFinal: orderItem.IsFinal}, arg.StatusCode, tx.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg.StatusCode == orderstatus.Served {
+		err = q.UpdateOrderItemsStatusServed(ctx, arg.ID)
+	} else {
+		err = q.UpdateOrderItemsStatus(ctx, arg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = createOrderItemStatusChangedOutboxEvent(ctx, q, tx, orderItem)
+	if err != nil {
+		return nil, err
+	}
+
+	switch arg.StatusCode {
+	case orderstatus.Preparing, orderstatus.Served:
+		if orderItem.IngredientsDeducted {
+			return orderItem, nil
+		}
+
+		ingredientIDs, err := deductOrderItemIngredients(ctx, q, generateID, arg.ID, orderItem.ProductID, orderItem.Quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ingredientIDs) == 0 {
+			return orderItem, nil
+		}
+
+		return orderItem, q.UpdateProductsUnavailableByIngredients(ctx, ingredientIDs)
+	case orderstatus.Cancelled:
+		err = q.IncreaseProductStock(ctx, IncreaseProductStockParams{
+			ID:       orderItem.ProductID,
+			Quantity: orderItem.Quantity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !orderItem.IngredientsDeducted {
+			return orderItem, nil
+		}
+
+		return orderItem, reverseOrderItemIngredients(ctx, q, generateID, arg.ID)
+	}
+
+	return orderItem, nil
+}
+
+type TXAmendOrderItemParams struct {
+	AmendOrderItem AmendOrderItemParams
+	ProductNameEn  string
+	AmendedAt      string
+	GenerateID     func() int64
+	OutboxTopic    string
+}
+
+// TXAmendOrderItem แก้ไขจำนวน หมายเหตุ และตัวเลือกเสริมของรายการที่ครัวยังไม่เริ่มทำ
+// ปรับสต็อกสินค้าและวัตถุดิบตามจำนวนใหม่ แล้วเขียนข้อความแจ้งครัวลง outbox ใน transaction เดียวกัน
+func (store *SQLStore) TXAmendOrderItem(ctx context.Context, arg TXAmendOrderItemParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		orderItem, err := q.GetOrderItemsStatusForUpdate(ctx, arg.AmendOrderItem.ID)
+		if err != nil {
+			return err
+		}
+
+		if orderItem.StatusCode != orderstatus.Pending && orderItem.StatusCode != orderstatus.Confirmed {
+			return exceptions.ErrOrderItemNotAmendable
+		}
+
+		quantityDelta := arg.AmendOrderItem.Quantity - orderItem.Quantity
+		if quantityDelta > 0 {
+			err = decreaseOrderItemStock(ctx, q, orderItem.ProductID, quantityDelta, arg.ProductNameEn)
+		} else if quantityDelta < 0 {
+			err = q.IncreaseProductStock(ctx, IncreaseProductStockParams{
+				ID:       orderItem.ProductID,
+				Quantity: -quantityDelta,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		err = q.AmendOrderItem(ctx, arg.AmendOrderItem)
+		if err != nil {
+			return err
+		}
+
+		if quantityDelta != 0 && orderItem.IngredientsDeducted {
+			err = reverseOrderItemIngredients(ctx, q, arg.GenerateID, arg.AmendOrderItem.ID)
+			if err != nil {
+				return err
+			}
+
+			ingredientIDs, err := deductOrderItemIngredients(ctx, q, arg.GenerateID, arg.AmendOrderItem.ID, orderItem.ProductID, arg.AmendOrderItem.Quantity)
+			if err != nil {
+				return err
+			}
+
+			if len(ingredientIDs) > 0 {
+				err = q.UpdateProductsUnavailableByIngredients(ctx, ingredientIDs)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return createOrderItemAmendedOutboxEvent(ctx, q, arg)
+	})
+
+	return err
+}
+
+// syncComboOrderItemStatus รายการชุดเมนูจะเสร็จสิ้นเมื่อส่วนประกอบทุกรายการเสร็จสิ้น
+// เสิร์ฟแล้วหากมีส่วนประกอบที่เสิร์ฟอย่างน้อยหนึ่งรายการ หรือยกเลิกหากทุกรายการถูกยกเลิก
+func syncComboOrderItemStatus(ctx context.Context, q *Queries, tx TXUpdateOrderItemsStatusParams, parentID int64) error {
+	progress, err := q.GetComboOrderItemProgress(ctx, parentID)
+	if err != nil {
+		return err
+	}
+
+	if progress.Served+progress.Cancelled < progress.Total {
+		return nil
+	}
+
+	statusCode := orderstatus.Served
+	if progress.Served == 0 {
+		statusCode = orderstatus.Cancelled
+	}
+
+	parent, err := q.GetOrderItemsStatusForUpdate(ctx, parentID)
+	if err != nil {
+		return err
+	}
+
+	if parent.IsFinal || parent.StatusCode == statusCode {
+		return nil
+	}
+
+	_, err = updateOrderItemStatus(ctx, q, TXUpdateOrderItemsStatusParams{
+		UpdateOrderItemsStatus: UpdateOrderItemsStatusParams{
+			StatusCode: statusCode,
+			ID:         parentID,
+		},
+		Actor:       orderstatus.ActorSystem,
+		GenerateID:  tx.GenerateID,
+		OutboxTopic: tx.OutboxTopic,
+	})
+
+	return err
+}
+
+// createOrderItemsOutboxEvents เขียนข้อความถึงครัวหนึ่งรายการต่อหนึ่งข้อความใน transaction เดียวกับรายการอาหาร
+// รายการที่บันทึกสำเร็จจึงไม่มีทางหายไปจากครัว relay worker จะส่งข้อความเหล่านี้ไปยัง Kafka ภายหลัง
+// ใช้ order ID เป็น key เพื่อให้รายการของออเดอร์เดียวกันอยู่ partition เดียวกัน
+func createOrderItemsOutboxEvents(ctx context.Context, q *Queries, generateID func() int64, topic string, items []CreateOrderItemsParams) error {
+	orderItemsID := make([]int64, len(items))
+	for index, item := range items {
+		orderItemsID[index] = item.ID
+	}
+
+	rows, err := q.GetOrderWithItemsGroupID(ctx, orderItemsID)
+	if err != nil {
+		return err
+	}
+
+	orderItems := model.TransformOrderItemsResults(rows)
+	sort.Slice(orderItems, func(i, j int) bool {
+		return orderItems[i].ID < orderItems[j].ID
+	})
+
+	for _, item := range orderItems {
+		payload, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+
+		messageKey := strconv.FormatInt(item.OrderID, 10)
+		seq, err := q.NextOutboxEventSeq(ctx, NextOutboxEventSeqParams{Topic: topic, MessageKey: messageKey})
+		if err != nil {
+			return err
+		}
+
+		err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+			ID:         generateID(),
+			Seq:        seq,
+			Topic:      topic,
+			MessageKey: pgtype.Text{String: messageKey, Valid: true},
+			Payload:    payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createOrderItemStatusChangedOutboxEvent(ctx context.Context, q *Queries, tx TXUpdateOrderItemsStatusParams, orderItem *GetOrderItemsStatusForUpdateRow) error {
+	currentTime, err := q.GetTimeNow(ctx)
+	if err != nil {
+		return err
+	}
+
+	changedAt, err := utils.PgTimestampToThaiISO8601(currentTime)
+	if err != nil {
+		return err
+	}
+
+	// the outbox seq doubles as the event ID, customers resume their status stream from it
+	messageKey := strconv.FormatInt(orderItem.OrderID, 10)
+	eventID, err := q.NextOutboxEventSeq(ctx, NextOutboxEventSeqParams{Topic: tx.OutboxTopic, MessageKey: messageKey})
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(model.OrderItemsStatusChanged{
+		EventID:           eventID,
+		ID:                tx.UpdateOrderItemsStatus.ID,
+		OrderID:           orderItem.OrderID,
+		ParentOrderItemID: utils.PgInt8ToInt64Ptr(orderItem.ParentOrderItemID),
+		OldStatus:         orderItem.StatusCode,
+		NewStatus:         tx.UpdateOrderItemsStatus.StatusCode,
+		Actor:             tx.Actor,
+		ChangedAt:         changedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		ID:         tx.GenerateID(),
+		Seq:        eventID,
+		Topic:      tx.OutboxTopic,
+		MessageKey: pgtype.Text{String: messageKey, Valid: true},
+		Payload:    payload,
+	})
+}
+
+func createOrderItemAmendedOutboxEvent(ctx context.Context, q *Queries, arg TXAmendOrderItemParams) error {
+	rows, err := q.GetOrderWithItemsGroupID(ctx, []int64{arg.AmendOrderItem.ID})
+	if err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return exceptions.ErrRowDatabaseNotFound
+	}
+
+	payload, err := json.Marshal(model.OrderItemsAmended{
+		OrderItems: *model.TransformOrderItemsByIDResults(rows[0]),
+		AmendedAt:  arg.AmendedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	messageKey := strconv.FormatInt(rows[0].OrderID, 10)
+	seq, err := q.NextOutboxEventSeq(ctx, NextOutboxEventSeqParams{Topic: arg.OutboxTopic, MessageKey: messageKey})
+	if err != nil {
+		return err
+	}
+
+	return q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		ID:         arg.GenerateID(),
+		Seq:        seq,
+		Topic:      arg.OutboxTopic,
+		MessageKey: pgtype.Text{String: messageKey, Valid: true},
+		Payload:    payload,
+	})
+}
+
+func decreaseOrderItemsStock(ctx context.Context, q *Queries, items []CreateOrderItemsParams) error {
+	for _, item := range items {
+		err := decreaseOrderItemStock(ctx, q, item.ProductID, item.Quantity, item.ProductNameEn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decreaseOrderItemStock(ctx context.Context, q *Queries, productID int64, quantity int32, productNameEn string) error {
+	rowsAffected, err := q.DecreaseProductStock(ctx, DecreaseProductStockParams{
+		ID:       productID,
+		Quantity: quantity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	isTracked, err := q.IsProductStockTracked(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	if isTracked {
+		return fmt.Errorf("%w: %s", exceptions.ErrProductOutOfStock, productNameEn)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/utils"
+	"food-story/shared/orderstatus"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	testOrderID      int64 = 500
+	testTableID      int64 = 7
+	testProductID    int64 = 1
+	testIngredientID int64 = 10
+	testOrderItemID  int64 = 900
+)
+
+type fakeOrderItem struct {
+	productID           int64
+	quantity            int32
+	statusCode          string
+	ingredientsDeducted bool
+}
+
+// fakeStockDB answers the queries that order item transactions run against product and ingredient stock. Anything
+// else fails the transaction, so a test notices when the code starts touching data the fake does not model.
+type fakeStockDB struct {
+	productStock    map[int64]int32
+	ingredientStock map[int64]float64
+	// recipe is the quantity of each ingredient one unit of a product uses
+	recipe     map[int64]map[int64]float64
+	orderItems map[int64]*fakeOrderItem
+	movements  map[int64][]float64
+	seq        int64
+}
+
+func newFakeStockDB() *fakeStockDB {
+	return &fakeStockDB{
+		productStock:    map[int64]int32{testProductID: 10},
+		ingredientStock: map[int64]float64{testIngredientID: 5},
+		recipe:          map[int64]map[int64]float64{testProductID: {testIngredientID: 0.5}},
+		orderItems:      make(map[int64]*fakeOrderItem),
+		movements:       make(map[int64][]float64),
+	}
+}
+
+func queryName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) < 3 || fields[1] != "name:" {
+		return ""
+	}
+	return fields[2]
+}
+
+func (db *fakeStockDB) Exec(_ context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	switch name := queryName(sql); name {
+	case "DecreaseProductStock":
+		quantity, id := args[0].(int32), args[1].(int64)
+		if db.productStock[id] < quantity {
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		}
+		db.productStock[id] -= quantity
+	case "IncreaseProductStock":
+		quantity, id := args[0].(int32), args[1].(int64)
+		db.productStock[id] += quantity
+	case "UpdateOrderItemsStatus":
+		db.orderItems[args[1].(int64)].statusCode = args[0].(string)
+	case "UpdateOrderItemsIngredientsDeducted":
+		db.orderItems[args[1].(int64)].ingredientsDeducted = args[0].(bool)
+	case "AdjustIngredientStock":
+		quantity, id := utils.PgNumericToFloat64(args[0].(pgtype.Numeric)), args[1].(int64)
+		if db.ingredientStock[id]+quantity < 0 {
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		}
+		db.ingredientStock[id] += quantity
+	case "CreateIngredientMovement":
+		orderItemID := args[2].(pgtype.Int8).Int64
+		db.movements[orderItemID] = append(db.movements[orderItemID], utils.PgNumericToFloat64(args[3].(pgtype.Numeric)))
+	case "CreateOutboxEvent", "UpdateTablesStatusWaitingToBeServed",
+		"UpdateProductsUnavailableByIngredients", "UpdateProductsAvailableByIngredients":
+	default:
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected exec %s", name)
+	}
+
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (db *fakeStockDB) Query(_ context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	switch name := queryName(sql); name {
+	case "GetOrderWithItemsGroupID":
+		return &fakeRows{}, nil
+	case "ListProductIngredientsForUpdate":
+		quantity, productID := args[0].(int32), args[1].(int64)
+		rows := new(fakeRows)
+		for ingredientID, perUnit := range db.recipe[productID] {
+			rows.values = append(rows.values, []any{
+				ingredientID,
+				fmt.Sprintf("ingredient %d", ingredientID),
+				utils.Float64ToPgNumeric(db.ingredientStock[ingredientID]),
+				utils.Float64ToPgNumeric(perUnit * float64(quantity)),
+			})
+		}
+		return rows, nil
+	case "ListIngredientMovementsByOrderItemID":
+		rows := new(fakeRows)
+		for _, quantity := range db.movements[args[0].(int64)] {
+			rows.values = append(rows.values, []any{testIngredientID, utils.Float64ToPgNumeric(quantity)})
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("unexpected query %s", name)
+	}
+}
+
+func (db *fakeStockDB) QueryRow(_ context.Context, sql string, args ...interface{}) pgx.Row {
+	switch name := queryName(sql); name {
+	case "GetTableIDByOrderID":
+		return &fakeRow{values: []any{testTableID}}
+	case "GetTimeNow":
+		return &fakeRow{values: []any{pgtype.Timestamptz{Valid: true}}}
+	case "NextOutboxEventSeq":
+		db.seq++
+		return &fakeRow{values: []any{db.seq}}
+	case "GetOrderItemsStatusForUpdate":
+		item, ok := db.orderItems[args[0].(int64)]
+		if !ok {
+			return &fakeRow{err: pgx.ErrNoRows}
+		}
+		isFinal := item.statusCode == orderstatus.Served || item.statusCode == orderstatus.Cancelled
+		return &fakeRow{values: []any{testOrderID, item.productID, item.quantity, item.ingredientsDeducted, pgtype.Int8{}, item.statusCode, isFinal}}
+	default:
+		return &fakeRow{err: fmt.Errorf("unexpected query row %s", name)}
+	}
+}
+
+// CopyFrom only models CreateOrderItems, every new item starts PENDING
+func (db *fakeStockDB) CopyFrom(_ context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	if tableName.Sanitize() != `"public"."order_items"` {
+		return 0, fmt.Errorf("unexpected copy into %s", tableName.Sanitize())
+	}
+
+	var count int64
+	for rowSrc.Next() {
+		values, err := rowSrc.Values()
+		if err != nil {
+			return 0, err
+		}
+
+		item := &fakeOrderItem{statusCode: orderstatus.Pending}
+		var id int64
+		for index, column := range columnNames {
+			switch column {
+			case "id":
+				id = values[index].(int64)
+			case "product_id":
+				item.productID = values[index].(int64)
+			case "quantity":
+				item.quantity = values[index].(int32)
+			}
+		}
+		db.orderItems[id] = item
+		count++
+	}
+
+	return count, rowSrc.Err()
+}
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r *fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	return scanValues(r.values, dest)
+}
+
+type fakeRows struct {
+	values  [][]any
+	current []any
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]any, error)                       { return r.current, nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *fakeRows) Next() bool {
+	if len(r.values) == 0 {
+		return false
+	}
+	r.current, r.values = r.values[0], r.values[1:]
+	return true
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	return scanValues(r.current, dest)
+}
+
+func scanValues(values []any, dest []any) error {
+	if len(values) != len(dest) {
+		return fmt.Errorf("scan %d values into %d destinations", len(values), len(dest))
+	}
+
+	for index, value := range values {
+		reflect.ValueOf(dest[index]).Elem().Set(reflect.ValueOf(value))
+	}
+	return nil
+}
+
+func (db *fakeStockDB) assertStock(t *testing.T, step string, wantProduct int32, wantIngredient float64, wantDeducted bool) {
+	t.Helper()
+
+	if got := db.productStock[testProductID]; got != wantProduct {
+		t.Fatalf("%s: expected product stock %d, got %d", step, wantProduct, got)
+	}
+	if got := db.ingredientStock[testIngredientID]; got != wantIngredient {
+		t.Fatalf("%s: expected ingredient stock %v, got %v", step, wantIngredient, got)
+	}
+	if got := db.orderItems[testOrderItemID].ingredientsDeducted; got != wantDeducted {
+		t.Fatalf("%s: expected ingredients deducted %t, got %t", step, wantDeducted, got)
+	}
+}
+
+func TestOrderItemIngredientsFollowStatus(t *testing.T) {
+	var nextID int64
+	generateID := func() int64 {
+		nextID++
+		return nextID
+	}
+
+	updateStatus := func(q *Queries, statusCode string) error {
+		_, err := updateOrderItemStatus(context.Background(), q, TXUpdateOrderItemsStatusParams{
+			UpdateOrderItemsStatus: UpdateOrderItemsStatusParams{ID: testOrderItemID, StatusCode: statusCode},
+			Actor:                  orderstatus.ActorKitchen,
+			GenerateID:             generateID,
+		})
+		return err
+	}
+
+	createItem := func(t *testing.T, db *fakeStockDB) *Queries {
+		t.Helper()
+
+		q := New(db)
+		err := createOrderItems(context.Background(), q, TXCreateOrderItemsParams{
+			CreateOrderItems: []CreateOrderItemsParams{{
+				ID:        testOrderItemID,
+				OrderID:   testOrderID,
+				ProductID: testProductID,
+				Quantity:  2,
+			}},
+			GenerateID: generateID,
+		})
+		if err != nil {
+			t.Fatalf("failed to create order item: %v", err)
+		}
+		return q
+	}
+
+	t.Run("pending, preparing then cancelled", func(t *testing.T) {
+		db := newFakeStockDB()
+		q := createItem(t, db)
+		db.assertStock(t, "pending", 8, 5, false)
+
+		if err := updateStatus(q, orderstatus.Preparing); err != nil {
+			t.Fatalf("failed to start preparing: %v", err)
+		}
+		db.assertStock(t, "preparing", 8, 4, true)
+
+		if err := updateStatus(q, orderstatus.Cancelled); err != nil {
+			t.Fatalf("failed to cancel: %v", err)
+		}
+		db.assertStock(t, "cancelled", 10, 5, false)
+	})
+
+	t.Run("pending then cancelled", func(t *testing.T) {
+		db := newFakeStockDB()
+		q := createItem(t, db)
+		db.assertStock(t, "pending", 8, 5, false)
+
+		if err := updateStatus(q, orderstatus.Cancelled); err != nil {
+			t.Fatalf("failed to cancel: %v", err)
+		}
+		db.assertStock(t, "cancelled", 10, 5, false)
+
+		if movements := db.movements[testOrderItemID]; len(movements) != 0 {
+			t.Fatalf("expected no ingredient movements, got %v", movements)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"food-story/pkg/exceptions"
+	"food-story/shared/orderstatus"
+)
+
+type TXCallbackPaymentParams struct {
+	OrderID       int64
+	TransactionID string
+	// StatusCode is the payment status reported by the provider, SUCCESS, CANCELLED, FAILED or TIMEOUT
+	StatusCode string
+}
+
+// validateOrderStatusForUpdate ล็อกแถวของออเดอร์แล้วตรวจว่าเปลี่ยนไปสถานะใหม่ได้ การชำระเงินสองรายการของออเดอร์เดียวกัน
+// จึงตรวจและเปลี่ยนสถานะทีละรายการ
+func validateOrderStatusForUpdate(ctx context.Context, q *Queries, orderID int64, statusCode string) error {
+	status, err := q.GetOrderStatusByIDForUpdate(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	return orderstatus.Order.Validate(orderstatus.Status{Code: status.StatusCode, IsFinal: status.IsFinal}, statusCode, orderstatus.ActorPayment)
+}
+
+// TXCreatePayment สร้างรายการชำระเงินและเปลี่ยนออเดอร์กับโต๊ะเป็นรอชำระเงิน ยอดที่ต้องชำระอ่านหลังล็อกออเดอร์แล้ว
+func (store *SQLStore) TXCreatePayment(ctx context.Context, arg CreatePaymentParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		err := validateOrderStatusForUpdate(ctx, q, arg.OrderID, orderstatus.WaitingPayment)
+		if err != nil {
+			return err
+		}
+
+		arg.Amount, err = q.GetTotalAmountToPayForServedItems(ctx, arg.OrderID)
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreatePayment(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		err = q.UpdateOrderStatusWaitForPayment(ctx, arg.OrderID)
+		if err != nil {
+			return err
+		}
+
+		tableID, err := q.GetTableIDByOrderID(ctx, arg.OrderID)
+		if err != nil {
+			return err
+		}
+
+		return q.UpdateTablesStatusWaitingForPayment(ctx, tableID)
+	})
+}
+
+// TXCallbackPayment บันทึกผลการชำระเงินจากผู้ให้บริการ ชำระสำเร็จจะปิดออเดอร์ด้วยยอดของรายการที่เสิร์ฟแล้วและเปลี่ยนโต๊ะเป็นรอทำความสะอาด
+// ผลอื่นคงออเดอร์ไว้ที่รอชำระเงินเพื่อชำระใหม่ได้
+func (store *SQLStore) TXCallbackPayment(ctx context.Context, arg TXCallbackPaymentParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		return callbackPayment(ctx, q, arg)
+	})
+}
+
+func callbackPayment(ctx context.Context, q *Queries, arg TXCallbackPaymentParams) error {
+	// a callback for an order that is already completed or cancelled is rejected before the payment is touched
+	orderStatusCode := orderstatus.WaitingPayment
+	if arg.StatusCode == "SUCCESS" {
+		orderStatusCode = orderstatus.Completed
+	}
+
+	err := validateOrderStatusForUpdate(ctx, q, arg.OrderID, orderStatusCode)
+	if err != nil {
+		return err
+	}
+
+	switch arg.StatusCode {
+	case "SUCCESS":
+		err = q.UpdateStatusPaymentSuccessByTransactionID(ctx, arg.TransactionID)
+	case "CANCELLED":
+		err = q.UpdateStatusPaymentCancelledByTransactionID(ctx, arg.TransactionID)
+	case "FAILED":
+		err = q.UpdateStatusPaymentFailedByTransactionID(ctx, arg.TransactionID)
+	case "TIMEOUT":
+		err = q.UpdateStatusPaymentTimeOutByTransactionID(ctx, arg.TransactionID)
+	default:
+		return exceptions.ErrPaymentStatusCode
+	}
+	if err != nil {
+		return err
+	}
+
+	if arg.StatusCode != "SUCCESS" {
+		return q.UpdateOrderStatusWaitForPayment(ctx, arg.OrderID)
+	}
+
+	amount, err := q.GetTotalAmountToPayForServedItems(ctx, arg.OrderID)
+	if err != nil {
+		return err
+	}
+
+	err = q.UpdateOrderStatusCompletedAndAmount(ctx, UpdateOrderStatusCompletedAndAmountParams{
+		ID:     arg.OrderID,
+		Amount: amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	tableID, err := q.GetTableIDByOrderID(ctx, arg.OrderID)
+	if err != nil {
+		return err
+	}
+
+	return q.UpdateTablesStatusCleaning(ctx, tableID)
+}
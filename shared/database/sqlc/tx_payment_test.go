@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"food-story/pkg/exceptions"
+	"food-story/pkg/utils"
+	"food-story/shared/orderstatus"
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakePaymentDB records every statement a payment transaction runs, so a test can check the order row is locked
+// before anything is written.
+type fakePaymentDB struct {
+	orderStatus orderstatus.Status
+	statements  []string
+}
+
+func (db *fakePaymentDB) Exec(_ context.Context, sql string, _ ...interface{}) (pgconn.CommandTag, error) {
+	db.statements = append(db.statements, queryName(sql))
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (db *fakePaymentDB) Query(_ context.Context, sql string, _ ...interface{}) (pgx.Rows, error) {
+	return nil, fmt.Errorf("unexpected query %s", queryName(sql))
+}
+
+func (db *fakePaymentDB) QueryRow(_ context.Context, sql string, _ ...interface{}) pgx.Row {
+	name := queryName(sql)
+	db.statements = append(db.statements, name)
+
+	switch name {
+	case "GetOrderStatusByIDForUpdate":
+		return &fakeRow{values: []any{db.orderStatus.Code, db.orderStatus.IsFinal}}
+	case "GetTotalAmountToPayForServedItems":
+		return &fakeRow{values: []any{utils.Float64ToPgNumeric(120)}}
+	case "GetTableIDByOrderID":
+		return &fakeRow{values: []any{testTableID}}
+	default:
+		return &fakeRow{err: fmt.Errorf("unexpected query row %s", name)}
+	}
+}
+
+func (db *fakePaymentDB) CopyFrom(_ context.Context, tableName pgx.Identifier, _ []string, _ pgx.CopyFromSource) (int64, error) {
+	return 0, fmt.Errorf("unexpected copy into %s", tableName.Sanitize())
+}
+
+func TestCallbackPayment(t *testing.T) {
+	waitingPayment := orderstatus.Status{Code: orderstatus.WaitingPayment}
+	completed := orderstatus.Status{Code: orderstatus.Completed, IsFinal: true}
+
+	tests := []struct {
+		name           string
+		orderStatus    orderstatus.Status
+		statusCode     string
+		wantErr        error
+		wantStatements []string
+	}{
+		{
+			name:        "success completes the order",
+			orderStatus: waitingPayment,
+			statusCode:  "SUCCESS",
+			wantStatements: []string{
+				"GetOrderStatusByIDForUpdate",
+				"UpdateStatusPaymentSuccessByTransactionID",
+				"GetTotalAmountToPayForServedItems",
+				"UpdateOrderStatusCompletedAndAmount",
+				"GetTableIDByOrderID",
+				"UpdateTablesStatusCleaning",
+			},
+		},
+		{
+			name:        "failure keeps the order waiting for payment",
+			orderStatus: waitingPayment,
+			statusCode:  "FAILED",
+			wantStatements: []string{
+				"GetOrderStatusByIDForUpdate",
+				"UpdateStatusPaymentFailedByTransactionID",
+				"UpdateOrderStatusWaitForPayment",
+			},
+		},
+		{
+			name:           "second callback of a completed order",
+			orderStatus:    completed,
+			statusCode:     "SUCCESS",
+			wantErr:        exceptions.ErrOrderStatusTransition,
+			wantStatements: []string{"GetOrderStatusByIDForUpdate"},
+		},
+		{
+			name:           "unsupported status code",
+			orderStatus:    waitingPayment,
+			statusCode:     "REFUNDED",
+			wantErr:        exceptions.ErrPaymentStatusCode,
+			wantStatements: []string{"GetOrderStatusByIDForUpdate"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &fakePaymentDB{orderStatus: tt.orderStatus}
+			err := callbackPayment(context.Background(), New(db), TXCallbackPaymentParams{
+				OrderID:       testOrderID,
+				TransactionID: "transaction",
+				StatusCode:    tt.statusCode,
+			})
+
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+
+			if !slices.Equal(db.statements, tt.wantStatements) {
+				t.Fatalf("expected statements %v, got %v", tt.wantStatements, db.statements)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package database
+
+import "context"
+
+// TXRefreshProductAssociations replaces the association statistics in one go, so readers never see a half
+// computed table. Only one instance refreshes at a time, the others return refreshed=false.
+func (store *SQLStore) TXRefreshProductAssociations(ctx context.Context, arg RefreshProductAssociationsParams) (rows int64, refreshed bool, err error) {
+	err = store.execTx(ctx, func(q *Queries) error {
+		locked, err := q.TryLockProductAssociations(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !locked {
+			return nil
+		}
+
+		err = q.DeleteProductAssociations(ctx)
+		if err != nil {
+			return err
+		}
+
+		rows, err = q.RefreshProductAssociations(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		refreshed = true
+		return nil
+	})
+
+	return rows, refreshed, err
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/exceptions"
+)
+
+type TXReplaceComboSlotsParams struct {
+	ComboProductID int64
+	ComboSlots     []TXComboSlotParams
+}
+
+// TXComboSlotParams updates the slot with the ID in place when IsNew is false, so its ID stays valid in orders,
+// published menus and translations. Options have no ID of their own and are replaced.
+type TXComboSlotParams struct {
+	CreateComboSlot        CreateComboSlotParams
+	IsNew                  bool
+	CreateComboSlotOptions []CreateComboSlotOptionParams
+}
+
+func (store *SQLStore) TXReplaceComboSlots(ctx context.Context, arg TXReplaceComboSlotsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		keepIDs := make([]int64, 0, len(arg.ComboSlots))
+		for _, slot := range arg.ComboSlots {
+			if !slot.IsNew {
+				keepIDs = append(keepIDs, slot.CreateComboSlot.ID)
+			}
+		}
+
+		err := q.DeleteComboSlotsExcept(ctx, DeleteComboSlotsExceptParams{
+			ComboProductID: arg.ComboProductID,
+			KeepIds:        keepIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, slot := range arg.ComboSlots {
+			slot.CreateComboSlot.ComboProductID = arg.ComboProductID
+			err = upsertComboSlot(ctx, q, slot)
+			if err != nil {
+				return err
+			}
+
+			err = q.DeleteComboSlotOptionsBySlotID(ctx, slot.CreateComboSlot.ID)
+			if err != nil {
+				return err
+			}
+
+			for _, option := range slot.CreateComboSlotOptions {
+				option.SlotID = slot.CreateComboSlot.ID
+				err = q.CreateComboSlotOption(ctx, option)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func upsertComboSlot(ctx context.Context, q *Queries, slot TXComboSlotParams) error {
+	if slot.IsNew {
+		return q.CreateComboSlot(ctx, slot.CreateComboSlot)
+	}
+
+	rows, err := q.UpdateComboSlot(ctx, UpdateComboSlotParams{
+		Name:           slot.CreateComboSlot.Name,
+		NameEn:         slot.CreateComboSlot.NameEn,
+		SortOrder:      slot.CreateComboSlot.SortOrder,
+		ID:             slot.CreateComboSlot.ID,
+		ComboProductID: slot.CreateComboSlot.ComboProductID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("combo slot id '%d' %w", slot.CreateComboSlot.ID, exceptions.ErrMenuOptionNotFound)
+	}
+
+	return nil
+}
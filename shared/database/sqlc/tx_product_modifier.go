@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/exceptions"
+)
+
+type TXReplaceModifierGroupsParams struct {
+	ProductID      int64
+	ModifierGroups []TXModifierGroupParams
+}
+
+// TXModifierGroupParams and TXModifierParams update the row with the ID in place when IsNew is false, so its ID
+// stays valid in orders, published menus and translations.
+type TXModifierGroupParams struct {
+	CreateModifierGroup CreateModifierGroupParams
+	IsNew               bool
+	Modifiers           []TXModifierParams
+}
+
+type TXModifierParams struct {
+	CreateModifier CreateModifierParams
+	IsNew          bool
+}
+
+func (store *SQLStore) TXReplaceModifierGroups(ctx context.Context, arg TXReplaceModifierGroupsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		keepIDs := make([]int64, 0, len(arg.ModifierGroups))
+		for _, group := range arg.ModifierGroups {
+			if !group.IsNew {
+				keepIDs = append(keepIDs, group.CreateModifierGroup.ID)
+			}
+		}
+
+		err := q.DeleteModifierGroupsExcept(ctx, DeleteModifierGroupsExceptParams{
+			ProductID: arg.ProductID,
+			KeepIds:   keepIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, group := range arg.ModifierGroups {
+			group.CreateModifierGroup.ProductID = arg.ProductID
+			err = upsertModifierGroup(ctx, q, group)
+			if err != nil {
+				return err
+			}
+
+			err = replaceModifiers(ctx, q, group.CreateModifierGroup.ID, group.Modifiers)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func upsertModifierGroup(ctx context.Context, q *Queries, group TXModifierGroupParams) error {
+	if group.IsNew {
+		return q.CreateModifierGroup(ctx, group.CreateModifierGroup)
+	}
+
+	rows, err := q.UpdateModifierGroup(ctx, UpdateModifierGroupParams{
+		Name:          group.CreateModifierGroup.Name,
+		NameEn:        group.CreateModifierGroup.NameEn,
+		MinSelections: group.CreateModifierGroup.MinSelections,
+		MaxSelections: group.CreateModifierGroup.MaxSelections,
+		SortOrder:     group.CreateModifierGroup.SortOrder,
+		ID:            group.CreateModifierGroup.ID,
+		ProductID:     group.CreateModifierGroup.ProductID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("modifier group id '%d' %w", group.CreateModifierGroup.ID, exceptions.ErrMenuOptionNotFound)
+	}
+
+	return nil
+}
+
+func replaceModifiers(ctx context.Context, q *Queries, groupID int64, modifiers []TXModifierParams) error {
+	keepIDs := make([]int64, 0, len(modifiers))
+	for _, modifier := range modifiers {
+		if !modifier.IsNew {
+			keepIDs = append(keepIDs, modifier.CreateModifier.ID)
+		}
+	}
+
+	err := q.DeleteModifiersExcept(ctx, DeleteModifiersExceptParams{
+		GroupID: groupID,
+		KeepIds: keepIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, modifier := range modifiers {
+		modifier.CreateModifier.GroupID = groupID
+		if modifier.IsNew {
+			err = q.CreateModifier(ctx, modifier.CreateModifier)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		rows, err := q.UpdateModifier(ctx, UpdateModifierParams{
+			Name:        modifier.CreateModifier.Name,
+			NameEn:      modifier.CreateModifier.NameEn,
+			PriceDelta:  modifier.CreateModifier.PriceDelta,
+			IsAvailable: modifier.CreateModifier.IsAvailable,
+			SortOrder:   modifier.CreateModifier.SortOrder,
+			ID:          modifier.CreateModifier.ID,
+			GroupID:     groupID,
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return fmt.Errorf("modifier id '%d' %w", modifier.CreateModifier.ID, exceptions.ErrMenuOptionNotFound)
+		}
+	}
+
+	return nil
+}
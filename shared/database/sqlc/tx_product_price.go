@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+)
+
+// TXCreateProductParams carries the id of the first price history row, the product keeps its price column as
+// the fallback for products created before price history existed.
+type TXCreateProductParams struct {
+	CreateProduct CreateProductParams
+	PriceID       int64
+}
+
+// TXUpdateProductParams leaves RecordPrice off once the menu is versioned, the price then changes for orders
+// when the version containing it is published.
+type TXUpdateProductParams struct {
+	UpdateProduct UpdateProductParams
+	PriceID       int64
+	RecordPrice   bool
+}
+
+func (store *SQLStore) TXCreateProduct(ctx context.Context, arg TXCreateProductParams) (int64, error) {
+	var productID int64
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		productID, err = q.CreateProduct(ctx, arg.CreateProduct)
+		if err != nil {
+			return err
+		}
+
+		return q.RecordProductPriceChange(ctx, RecordProductPriceChangeParams{
+			ID:        arg.PriceID,
+			ProductID: productID,
+			Price:     arg.CreateProduct.Price,
+		})
+	})
+
+	return productID, err
+}
+
+func (store *SQLStore) TXUpdateProduct(ctx context.Context, arg TXUpdateProductParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.UpdateProduct(ctx, arg.UpdateProduct)
+		if err != nil {
+			return err
+		}
+
+		if !arg.RecordPrice {
+			return nil
+		}
+
+		// only a price that differs from the one in effect right now starts a new history row
+		return q.RecordProductPriceChange(ctx, RecordProductPriceChangeParams{
+			ID:        arg.PriceID,
+			ProductID: arg.UpdateProduct.ID,
+			Price:     arg.UpdateProduct.Price,
+		})
+	})
+
+	return err
+}
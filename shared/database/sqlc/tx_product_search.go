@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+)
+
+type TXReplaceProductSearchKeywordsParams struct {
+	ProductID int64
+	Keywords  []string
+}
+
+func (store *SQLStore) TXReplaceProductSearchKeywords(ctx context.Context, arg TXReplaceProductSearchKeywordsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteProductSearchKeywords(ctx, arg.ProductID)
+		if err != nil {
+			return err
+		}
+
+		for _, keyword := range arg.Keywords {
+			err = q.CreateProductSearchKeyword(ctx, CreateProductSearchKeywordParams{
+				ProductID: arg.ProductID,
+				Keyword:   keyword,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
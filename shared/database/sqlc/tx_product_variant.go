@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"food-story/pkg/exceptions"
+)
+
+type TXReplaceVariantsParams struct {
+	ProductID int64
+	Variants  []TXVariantParams
+}
+
+// TXVariantParams updates the variant with the ID in place when IsNew is false, so its ID stays valid in orders,
+// published menus and translations.
+type TXVariantParams struct {
+	CreateVariant CreateVariantParams
+	IsNew         bool
+}
+
+func (store *SQLStore) TXReplaceVariants(ctx context.Context, arg TXReplaceVariantsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		keepIDs := make([]int64, 0, len(arg.Variants))
+		for _, variant := range arg.Variants {
+			if !variant.IsNew {
+				keepIDs = append(keepIDs, variant.CreateVariant.ID)
+			}
+		}
+
+		// dropped variants go first, a new variant may reuse the name of one that was removed
+		err := q.DeleteVariantsExcept(ctx, DeleteVariantsExceptParams{
+			ProductID: arg.ProductID,
+			KeepIds:   keepIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, variant := range arg.Variants {
+			variant.CreateVariant.ProductID = arg.ProductID
+			if variant.IsNew {
+				err = q.CreateVariant(ctx, variant.CreateVariant)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			rows, err := q.UpdateVariant(ctx, UpdateVariantParams{
+				Name:        variant.CreateVariant.Name,
+				NameEn:      variant.CreateVariant.NameEn,
+				Price:       variant.CreateVariant.Price,
+				IsAvailable: variant.CreateVariant.IsAvailable,
+				SortOrder:   variant.CreateVariant.SortOrder,
+				ID:          variant.CreateVariant.ID,
+				ProductID:   arg.ProductID,
+			})
+			if err != nil {
+				return err
+			}
+			if rows == 0 {
+				return fmt.Errorf("variant id '%d' %w", variant.CreateVariant.ID, exceptions.ErrMenuOptionNotFound)
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
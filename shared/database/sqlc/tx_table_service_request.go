@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"food-story/pkg/exceptions"
+	"food-story/shared/model"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// the table status each request type puts the table in, resolving the request restores the table only from it
+var tableStatusByRequestType = map[string]string{
+	model.ServiceRequestCallWaiter:  "CALL_WAITER",
+	model.ServiceRequestRequestBill: "WAITING_PAYMENT",
+}
+
+type TXCreateTableServiceRequestParams struct {
+	CreateTableServiceRequest CreateTableServiceRequestParams
+	GenerateID                func() int64
+	OutboxTopic               string
+}
+
+// TXCreateTableServiceRequest บันทึกคำขอของลูกค้าและเปลี่ยนสถานะโต๊ะ ถ้ามีคำขอประเภทเดียวกันที่ยังไม่ปิดจะคืนคำขอเดิม
+// แทนการสร้างใหม่ ข้อความแจ้งพนักงานเขียนลง outbox ใน transaction เดียวกัน
+func (store *SQLStore) TXCreateTableServiceRequest(ctx context.Context, arg TXCreateTableServiceRequestParams) (result *model.TableServiceRequest, created bool, err error) {
+	err = store.execTx(ctx, func(q *Queries) error {
+		// lock the table first so two taps of the same button cannot both create a request
+		tableStatus, err := q.GetTableStatusForUpdate(ctx, arg.CreateTableServiceRequest.TableID)
+		if err != nil {
+			return err
+		}
+
+		openID, err := q.GetOpenTableServiceRequestID(ctx, GetOpenTableServiceRequestIDParams{
+			SessionID:   arg.CreateTableServiceRequest.SessionID,
+			RequestType: arg.CreateTableServiceRequest.RequestType,
+		})
+		if err == nil {
+			result, err = getTableServiceRequest(ctx, q, openID)
+			return err
+		}
+		if !errors.Is(err, exceptions.ErrRowDatabaseNotFound) {
+			return err
+		}
+
+		switch arg.CreateTableServiceRequest.RequestType {
+		case model.ServiceRequestCallWaiter:
+			err = q.UpdateTablesStatusCallWaiter(ctx, arg.CreateTableServiceRequest.TableID)
+		case model.ServiceRequestRequestBill:
+			err = q.UpdateTablesStatusWaitingForPayment(ctx, arg.CreateTableServiceRequest.TableID)
+		}
+		if err != nil {
+			return err
+		}
+
+		arg.CreateTableServiceRequest.PreviousTableStatusID = tableStatus.StatusID
+		err = q.CreateTableServiceRequest(ctx, arg.CreateTableServiceRequest)
+		if err != nil {
+			return err
+		}
+
+		result, err = getTableServiceRequest(ctx, q, arg.CreateTableServiceRequest.ID)
+		if err != nil {
+			return err
+		}
+
+		created = true
+		return createTableServiceRequestOutboxEvent(ctx, q, arg.GenerateID, arg.OutboxTopic, result)
+	})
+
+	return result, created, err
+}
+
+type TXUpdateTableServiceRequestStatusParams struct {
+	ID          int64
+	Status      string
+	GenerateID  func() int64
+	OutboxTopic string
+}
+
+// TXUpdateTableServiceRequestStatus รับทราบหรือปิดคำขอ เมื่อปิดคำขอจะคืนสถานะโต๊ะเดิม
+// ถ้าโต๊ะยังอยู่ในสถานะที่คำขอนั้นตั้งไว้ คือเรียกพนักงานหรือรอชำระเงิน
+func (store *SQLStore) TXUpdateTableServiceRequestStatus(ctx context.Context, arg TXUpdateTableServiceRequestStatusParams) (result *model.TableServiceRequest, err error) {
+	err = store.execTx(ctx, func(q *Queries) error {
+		request, err := q.GetTableServiceRequestForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case arg.Status == model.ServiceRequestAcknowledged && request.Status == model.ServiceRequestPending:
+			err = q.UpdateTableServiceRequestAcknowledged(ctx, arg.ID)
+		case arg.Status == model.ServiceRequestResolved && request.Status != model.ServiceRequestResolved:
+			err = q.UpdateTableServiceRequestResolved(ctx, arg.ID)
+		default:
+			return fmt.Errorf("%w: request is %s and cannot change to %s", exceptions.ErrServiceRequestStatus, request.Status, arg.Status)
+		}
+		if err != nil {
+			return err
+		}
+
+		if arg.Status == model.ServiceRequestResolved {
+			err = restoreTableStatus(ctx, q, request)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = getTableServiceRequest(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		return createTableServiceRequestOutboxEvent(ctx, q, arg.GenerateID, arg.OutboxTopic, result)
+	})
+
+	return result, err
+}
+
+// restoreTableStatus leaves the table alone when it moved on since the request, e.g. the bill was requested after
+// a waiter call or the bill was paid.
+func restoreTableStatus(ctx context.Context, q *Queries, request *GetTableServiceRequestForUpdateRow) error {
+	tableStatus, err := q.GetTableStatusForUpdate(ctx, request.TableID)
+	if err != nil {
+		return err
+	}
+
+	if tableStatus.StatusCode != tableStatusByRequestType[request.RequestType] {
+		return nil
+	}
+
+	return q.UpdateTablesStatus(ctx, UpdateTablesStatusParams{
+		ID:       request.TableID,
+		StatusID: request.PreviousTableStatusID,
+	})
+}
+
+func getTableServiceRequest(ctx context.Context, q *Queries, id int64) (*model.TableServiceRequest, error) {
+	rows, err := q.ListTableServiceRequests(ctx, ListTableServiceRequestsParams{
+		ID: pgtype.Int8{Int64: id, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, exceptions.ErrRowDatabaseNotFound
+	}
+
+	return rows[0].ToModel()
+}
+
+func createTableServiceRequestOutboxEvent(ctx context.Context, q *Queries, generateID func() int64, topic string, request *model.TableServiceRequest) error {
+	messageKey := strconv.FormatInt(request.TableID, 10)
+	eventID, err := q.NextOutboxEventSeq(ctx, NextOutboxEventSeqParams{Topic: topic, MessageKey: messageKey})
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(model.TableServiceRequestChanged{
+		EventID:             eventID,
+		TableServiceRequest: *request,
+	})
+	if err != nil {
+		return err
+	}
+
+	return q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		ID:         generateID(),
+		Seq:        eventID,
+		Topic:      topic,
+		MessageKey: pgtype.Text{String: messageKey, Valid: true},
+		Payload:    payload,
+	})
+}
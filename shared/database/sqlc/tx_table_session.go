@@ -67,7 +67,15 @@ func (store *SQLStore) TXSessionsExtension(ctx context.Context, arg TXSessionsEx
 			return err
 		}
 
-		product, err := q.GetProductByID(ctx, arg.ProductID)
+		currentTime, err := q.GetTimeNow(ctx)
+		if err != nil {
+			return err
+		}
+
+		product, err := q.GetProductByID(ctx, GetProductByIDParams{
+			ID:      arg.ProductID,
+			PriceAt: currentTime,
+		})
 		if err != nil {
 			return err
 		}
@@ -81,11 +89,6 @@ func (store *SQLStore) TXSessionsExtension(ctx context.Context, arg TXSessionsEx
 			return err
 		}
 
-		currentTime, err := q.GetTimeNow(ctx)
-		if err != nil {
-			return err
-		}
-
 		isFree, err := q.IsSessionExtensionModeFree(ctx, arg.CreateSessionExtension.ModeID.Int64)
 		if err != nil {
 			return err
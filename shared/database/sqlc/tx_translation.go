@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+)
+
+type TXReplaceTranslationsParams struct {
+	EntityType         string
+	EntityID           int64
+	CreateTranslations []CreateTranslationParams
+}
+
+func (store *SQLStore) TXReplaceTranslations(ctx context.Context, arg TXReplaceTranslationsParams) error {
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := q.DeleteTranslationsByEntity(ctx, DeleteTranslationsByEntityParams{
+			EntityType: arg.EntityType,
+			EntityID:   arg.EntityID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, translation := range arg.CreateTranslations {
+			translation.EntityType = arg.EntityType
+			translation.EntityID = arg.EntityID
+			err = q.CreateTranslation(ctx, translation)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
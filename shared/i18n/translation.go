@@ -0,0 +1,100 @@
+package i18n
+
+import (
+	"context"
+	"food-story/pkg/utils"
+	database "food-story/shared/database/sqlc"
+)
+
+const (
+	EntityProduct       = "product"
+	EntityCategory      = "category"
+	EntityVariant       = "variant"
+	EntityModifierGroup = "modifier_group"
+	EntityModifier      = "modifier"
+	EntityComboSlot     = "combo_slot"
+	EntityOrderStatus   = "order_status"
+)
+
+const (
+	FieldName        = "name"
+	FieldDescription = "description"
+)
+
+type TranslationQuerier interface {
+	ListTranslations(ctx context.Context, arg database.ListTranslationsParams) ([]*database.ListTranslationsRow, error)
+}
+
+type translationKey struct {
+	entityType string
+	entityID   int64
+	field      string
+	locale     string
+}
+
+// Translations resolves text through a locale fallback chain. Thai and English come from the entity's own
+// name / name_en columns, every other language from the translations table.
+type Translations struct {
+	locales []string
+	values  map[translationKey]string
+}
+
+func Load(ctx context.Context, querier TranslationQuerier, locales []string, entityIDs []int64) (*Translations, error) {
+	result := &Translations{
+		locales: locales,
+		values:  make(map[translationKey]string),
+	}
+
+	if len(entityIDs) == 0 || !needsLookup(locales) {
+		return result, nil
+	}
+
+	rows, err := querier.ListTranslations(ctx, database.ListTranslationsParams{
+		EntityIds: entityIDs,
+		Locales:   locales,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result.values[translationKey{row.EntityType, row.EntityID, row.Field, row.Locale}] = row.Value
+	}
+
+	return result, nil
+}
+
+// Text returns the best translation of a field. thai and english are the values of the source columns,
+// english may be empty when the entity has no English column.
+func (t *Translations) Text(entityType string, entityID int64, field string, thai string, english string) string {
+	for _, locale := range t.locales {
+		if value, ok := t.values[translationKey{entityType, entityID, field, locale}]; ok {
+			return value
+		}
+
+		switch locale {
+		case utils.LocaleEnglish:
+			if english != "" {
+				return english
+			}
+		case utils.LocaleThai:
+			return thai
+		}
+	}
+
+	return thai
+}
+
+func (t *Translations) Name(entityType string, entityID int64, thai string, english string) string {
+	return t.Text(entityType, entityID, FieldName, thai, english)
+}
+
+// needsLookup skips the query when the chain only asks for the languages stored on the entity itself.
+func needsLookup(locales []string) bool {
+	for _, locale := range locales {
+		if locale != utils.LocaleEnglish && locale != utils.LocaleThai {
+			return true
+		}
+	}
+	return false
+}
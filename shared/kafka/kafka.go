@@ -27,13 +27,18 @@ func InitConsumer(group string, brokers []string) (consumer sarama.ConsumerGroup
 	return consumer, client, nil
 }
 
-func InitProducer(brokers []string) (producer sarama.SyncProducer, client sarama.Client, err error) {
+func InitProducer(brokers []string, producerConfig ProducerConfig) (producer sarama.SyncProducer, client sarama.Client, err error) {
 
 	config := sarama.NewConfig()
 	config.Version = sarama.V3_9_0_0 // confluentinc 7.9.X
 	config.Net.DialTimeout = 5 * time.Second
 	config.Producer.Return.Successes = true
 
+	err = producerConfig.apply(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// สร้าง client
 	client, err = sarama.NewClient(brokers, config)
 	if err != nil {
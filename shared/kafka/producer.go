@@ -0,0 +1,102 @@
+package kafka
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+)
+
+// Message is an encoded message ready to publish. Messages with the same Key land on the same partition and
+// are consumed in the order they were sent, order-service keys them by order ID.
+type Message struct {
+	Topic string
+	Key   *string
+	Value []byte
+}
+
+// ProducerConfig holds the producer settings that can be tuned per environment. The defaults favour delivery
+// guarantees, every message is acknowledged by all in-sync replicas and written exactly once per partition.
+type ProducerConfig struct {
+	RequiredAcks sarama.RequiredAcks
+	Idempotent   bool
+	Retries      int
+	Compression  sarama.CompressionCodec
+}
+
+func DefaultProducerConfig() ProducerConfig {
+	return ProducerConfig{
+		RequiredAcks: sarama.WaitForAll,
+		Idempotent:   true,
+		Retries:      10,
+		Compression:  sarama.CompressionSnappy,
+	}
+}
+
+// ParseProducerConfig reads settings given as strings, e.g. from environment variables. An empty value keeps
+// the default. acks is one of all, leader or none, compression one of none, gzip, snappy, lz4 or zstd.
+func ParseProducerConfig(acks, idempotent, retries, compression string) (ProducerConfig, error) {
+	cfg := DefaultProducerConfig()
+
+	switch strings.ToLower(acks) {
+	case "":
+	case "all", "-1":
+		cfg.RequiredAcks = sarama.WaitForAll
+	case "leader", "1":
+		cfg.RequiredAcks = sarama.WaitForLocal
+	case "none", "0":
+		cfg.RequiredAcks = sarama.NoResponse
+	default:
+		return ProducerConfig{}, fmt.Errorf("invalid producer acks %q", acks)
+	}
+
+	if idempotent != "" {
+		value, err := strconv.ParseBool(idempotent)
+		if err != nil {
+			return ProducerConfig{}, fmt.Errorf("invalid producer idempotent %q: %w", idempotent, err)
+		}
+		cfg.Idempotent = value
+	}
+
+	if retries != "" {
+		value, err := strconv.Atoi(retries)
+		if err != nil || value < 0 {
+			return ProducerConfig{}, fmt.Errorf("invalid producer retries %q", retries)
+		}
+		cfg.Retries = value
+	}
+
+	if compression != "" {
+		err := cfg.Compression.UnmarshalText([]byte(strings.ToLower(compression)))
+		if err != nil {
+			return ProducerConfig{}, fmt.Errorf("invalid producer compression %q: %w", compression, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+func (c ProducerConfig) apply(config *sarama.Config) error {
+	config.Producer.RequiredAcks = c.RequiredAcks
+	config.Producer.Retry.Max = c.Retries
+	config.Producer.Compression = c.Compression
+
+	// messages are keyed by order, the hash partitioner keeps every item of an order on one partition
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+
+	if c.Idempotent {
+		if c.RequiredAcks != sarama.WaitForAll {
+			return fmt.Errorf("idempotent producer requires acks=all")
+		}
+
+		if c.Retries == 0 {
+			return fmt.Errorf("idempotent producer requires retries")
+		}
+
+		config.Producer.Idempotent = true
+		config.Net.MaxOpenRequests = 1
+	}
+
+	return nil
+}
@@ -2,8 +2,14 @@ package kafka
 
 const (
 	Group = "kitchen-group"
+	// OrderStatusStreamGroup is a prefix, every order-service instance streams to its own clients and needs
+	// all events, so each one joins with its own group
+	OrderStatusStreamGroup = "order-status-stream-group"
 )
 
 const (
-	OrderItemsCreatedTopic = "order.items.placed"
+	OrderItemsCreatedTopic           = "order.items.placed"
+	OrderItemsAmendedTopic           = "order.items.amended"
+	OrderItemsStatusChangedTopic     = "order.items.status-changed"
+	TableServiceRequestsChangedTopic = "table.service-requests.changed"
 )
@@ -12,6 +12,7 @@ package mockdb
 import (
 	context "context"
 	database "food-story/shared/database/sqlc"
+	model "food-story/shared/model"
 	reflect "reflect"
 
 	pgtype "github.com/jackc/pgx/v5/pgtype"
@@ -42,6 +43,179 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AdjustIngredientStock mocks base method.
+func (m *MockStore) AdjustIngredientStock(ctx context.Context, arg database.AdjustIngredientStockParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustIngredientStock", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustIngredientStock indicates an expected call of AdjustIngredientStock.
+func (mr *MockStoreMockRecorder) AdjustIngredientStock(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustIngredientStock", reflect.TypeOf((*MockStore)(nil).AdjustIngredientStock), ctx, arg)
+}
+
+// AmendOrderItem mocks base method.
+func (m *MockStore) AmendOrderItem(ctx context.Context, arg database.AmendOrderItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendOrderItem", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AmendOrderItem indicates an expected call of AmendOrderItem.
+func (mr *MockStoreMockRecorder) AmendOrderItem(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendOrderItem", reflect.TypeOf((*MockStore)(nil).AmendOrderItem), ctx, arg)
+}
+
+// ClaimOutboxEvents mocks base method.
+func (m *MockStore) ClaimOutboxEvents(ctx context.Context, arg database.ClaimOutboxEventsParams) ([]*database.ClaimOutboxEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEvents", ctx, arg)
+	ret0, _ := ret[0].([]*database.ClaimOutboxEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEvents indicates an expected call of ClaimOutboxEvents.
+func (mr *MockStoreMockRecorder) ClaimOutboxEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEvents", reflect.TypeOf((*MockStore)(nil).ClaimOutboxEvents), ctx, arg)
+}
+
+// CreateCategory mocks base method.
+func (m *MockStore) CreateCategory(ctx context.Context, arg database.CreateCategoryParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockStoreMockRecorder) CreateCategory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockStore)(nil).CreateCategory), ctx, arg)
+}
+
+// CreateComboSlot mocks base method.
+func (m *MockStore) CreateComboSlot(ctx context.Context, arg database.CreateComboSlotParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComboSlot", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateComboSlot indicates an expected call of CreateComboSlot.
+func (mr *MockStoreMockRecorder) CreateComboSlot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComboSlot", reflect.TypeOf((*MockStore)(nil).CreateComboSlot), ctx, arg)
+}
+
+// CreateComboSlotOption mocks base method.
+func (m *MockStore) CreateComboSlotOption(ctx context.Context, arg database.CreateComboSlotOptionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComboSlotOption", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateComboSlotOption indicates an expected call of CreateComboSlotOption.
+func (mr *MockStoreMockRecorder) CreateComboSlotOption(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComboSlotOption", reflect.TypeOf((*MockStore)(nil).CreateComboSlotOption), ctx, arg)
+}
+
+// CreateIngredient mocks base method.
+func (m *MockStore) CreateIngredient(ctx context.Context, arg database.CreateIngredientParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngredient", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIngredient indicates an expected call of CreateIngredient.
+func (mr *MockStoreMockRecorder) CreateIngredient(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngredient", reflect.TypeOf((*MockStore)(nil).CreateIngredient), ctx, arg)
+}
+
+// CreateIngredientMovement mocks base method.
+func (m *MockStore) CreateIngredientMovement(ctx context.Context, arg database.CreateIngredientMovementParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIngredientMovement", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIngredientMovement indicates an expected call of CreateIngredientMovement.
+func (mr *MockStoreMockRecorder) CreateIngredientMovement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIngredientMovement", reflect.TypeOf((*MockStore)(nil).CreateIngredientMovement), ctx, arg)
+}
+
+// CreateMenuSchedule mocks base method.
+func (m *MockStore) CreateMenuSchedule(ctx context.Context, arg database.CreateMenuScheduleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMenuSchedule", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMenuSchedule indicates an expected call of CreateMenuSchedule.
+func (mr *MockStoreMockRecorder) CreateMenuSchedule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMenuSchedule", reflect.TypeOf((*MockStore)(nil).CreateMenuSchedule), ctx, arg)
+}
+
+// CreateMenuVersion mocks base method.
+func (m *MockStore) CreateMenuVersion(ctx context.Context, arg database.CreateMenuVersionParams) (*database.CreateMenuVersionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMenuVersion", ctx, arg)
+	ret0, _ := ret[0].(*database.CreateMenuVersionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMenuVersion indicates an expected call of CreateMenuVersion.
+func (mr *MockStoreMockRecorder) CreateMenuVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMenuVersion", reflect.TypeOf((*MockStore)(nil).CreateMenuVersion), ctx, arg)
+}
+
+// CreateModifier mocks base method.
+func (m *MockStore) CreateModifier(ctx context.Context, arg database.CreateModifierParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModifier", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateModifier indicates an expected call of CreateModifier.
+func (mr *MockStoreMockRecorder) CreateModifier(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModifier", reflect.TypeOf((*MockStore)(nil).CreateModifier), ctx, arg)
+}
+
+// CreateModifierGroup mocks base method.
+func (m *MockStore) CreateModifierGroup(ctx context.Context, arg database.CreateModifierGroupParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModifierGroup", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateModifierGroup indicates an expected call of CreateModifierGroup.
+func (mr *MockStoreMockRecorder) CreateModifierGroup(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModifierGroup", reflect.TypeOf((*MockStore)(nil).CreateModifierGroup), ctx, arg)
+}
+
 // CreateOrder mocks base method.
 func (m *MockStore) CreateOrder(ctx context.Context, arg database.CreateOrderParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +260,20 @@ func (mr *MockStoreMockRecorder) CreateOrderItemsPerRow(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItemsPerRow", reflect.TypeOf((*MockStore)(nil).CreateOrderItemsPerRow), ctx, arg)
 }
 
+// CreateOutboxEvent mocks base method.
+func (m *MockStore) CreateOutboxEvent(ctx context.Context, arg database.CreateOutboxEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockStoreMockRecorder) CreateOutboxEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockStore)(nil).CreateOutboxEvent), ctx, arg)
+}
+
 // CreatePayment mocks base method.
 func (m *MockStore) CreatePayment(ctx context.Context, arg database.CreatePaymentParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +304,91 @@ func (mr *MockStoreMockRecorder) CreateProduct(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockStore)(nil).CreateProduct), ctx, arg)
 }
 
+// CreateProductDietaryTags mocks base method.
+func (m *MockStore) CreateProductDietaryTags(ctx context.Context, arg database.CreateProductDietaryTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductDietaryTags", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductDietaryTags indicates an expected call of CreateProductDietaryTags.
+func (mr *MockStoreMockRecorder) CreateProductDietaryTags(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductDietaryTags", reflect.TypeOf((*MockStore)(nil).CreateProductDietaryTags), ctx, arg)
+}
+
+// CreateProductIngredient mocks base method.
+func (m *MockStore) CreateProductIngredient(ctx context.Context, arg database.CreateProductIngredientParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductIngredient", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductIngredient indicates an expected call of CreateProductIngredient.
+func (mr *MockStoreMockRecorder) CreateProductIngredient(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductIngredient", reflect.TypeOf((*MockStore)(nil).CreateProductIngredient), ctx, arg)
+}
+
+// CreateProductSearchKeyword mocks base method.
+func (m *MockStore) CreateProductSearchKeyword(ctx context.Context, arg database.CreateProductSearchKeywordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductSearchKeyword", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductSearchKeyword indicates an expected call of CreateProductSearchKeyword.
+func (mr *MockStoreMockRecorder) CreateProductSearchKeyword(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductSearchKeyword", reflect.TypeOf((*MockStore)(nil).CreateProductSearchKeyword), ctx, arg)
+}
+
+// CreatePromotion mocks base method.
+func (m *MockStore) CreatePromotion(ctx context.Context, arg database.CreatePromotionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockStoreMockRecorder) CreatePromotion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockStore)(nil).CreatePromotion), ctx, arg)
+}
+
+// CreateScheduledProductPrice mocks base method.
+func (m *MockStore) CreateScheduledProductPrice(ctx context.Context, arg database.CreateScheduledProductPriceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledProductPrice", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledProductPrice indicates an expected call of CreateScheduledProductPrice.
+func (mr *MockStoreMockRecorder) CreateScheduledProductPrice(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledProductPrice", reflect.TypeOf((*MockStore)(nil).CreateScheduledProductPrice), ctx, arg)
+}
+
+// CreateSearchAlias mocks base method.
+func (m *MockStore) CreateSearchAlias(ctx context.Context, arg database.CreateSearchAliasParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSearchAlias", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSearchAlias indicates an expected call of CreateSearchAlias.
+func (mr *MockStoreMockRecorder) CreateSearchAlias(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSearchAlias", reflect.TypeOf((*MockStore)(nil).CreateSearchAlias), ctx, arg)
+}
+
 // CreateSessionExtension mocks base method.
 func (m *MockStore) CreateSessionExtension(ctx context.Context, arg database.CreateSessionExtensionParams) (int64, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +419,20 @@ func (mr *MockStoreMockRecorder) CreateTable(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTable", reflect.TypeOf((*MockStore)(nil).CreateTable), ctx, arg)
 }
 
+// CreateTableServiceRequest mocks base method.
+func (m *MockStore) CreateTableServiceRequest(ctx context.Context, arg database.CreateTableServiceRequestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTableServiceRequest", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTableServiceRequest indicates an expected call of CreateTableServiceRequest.
+func (mr *MockStoreMockRecorder) CreateTableServiceRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTableServiceRequest", reflect.TypeOf((*MockStore)(nil).CreateTableServiceRequest), ctx, arg)
+}
+
 // CreateTableSession mocks base method.
 func (m *MockStore) CreateTableSession(ctx context.Context, arg database.CreateTableSessionParams) error {
 	m.ctrl.T.Helper()
@@ -175,932 +462,2563 @@ func (mr *MockStoreMockRecorder) CreateTableStatus(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTableStatus", reflect.TypeOf((*MockStore)(nil).CreateTableStatus), ctx, arg)
 }
 
-// GetDurationMinutesByProductID mocks base method.
-func (m *MockStore) GetDurationMinutesByProductID(ctx context.Context, productsID int64) (int32, error) {
+// CreateTranslation mocks base method.
+func (m *MockStore) CreateTranslation(ctx context.Context, arg database.CreateTranslationParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDurationMinutesByProductID", ctx, productsID)
-	ret0, _ := ret[0].(int32)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateTranslation", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetDurationMinutesByProductID indicates an expected call of GetDurationMinutesByProductID.
-func (mr *MockStoreMockRecorder) GetDurationMinutesByProductID(ctx, productsID any) *gomock.Call {
+// CreateTranslation indicates an expected call of CreateTranslation.
+func (mr *MockStoreMockRecorder) CreateTranslation(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDurationMinutesByProductID", reflect.TypeOf((*MockStore)(nil).GetDurationMinutesByProductID), ctx, productsID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTranslation", reflect.TypeOf((*MockStore)(nil).CreateTranslation), ctx, arg)
 }
 
-// GetExpiresAtByTableID mocks base method.
-func (m *MockStore) GetExpiresAtByTableID(ctx context.Context, tableID int64) (*database.GetExpiresAtByTableIDRow, error) {
+// CreateVariant mocks base method.
+func (m *MockStore) CreateVariant(ctx context.Context, arg database.CreateVariantParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExpiresAtByTableID", ctx, tableID)
-	ret0, _ := ret[0].(*database.GetExpiresAtByTableIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateVariant", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetExpiresAtByTableID indicates an expected call of GetExpiresAtByTableID.
-func (mr *MockStoreMockRecorder) GetExpiresAtByTableID(ctx, tableID any) *gomock.Call {
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockStoreMockRecorder) CreateVariant(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiresAtByTableID", reflect.TypeOf((*MockStore)(nil).GetExpiresAtByTableID), ctx, tableID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockStore)(nil).CreateVariant), ctx, arg)
 }
 
-// GetOrderByID mocks base method.
-func (m *MockStore) GetOrderByID(ctx context.Context, id int64) (*database.GetOrderByIDRow, error) {
+// DecreaseProductStock mocks base method.
+func (m *MockStore) DecreaseProductStock(ctx context.Context, arg database.DecreaseProductStockParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderByID", ctx, id)
-	ret0, _ := ret[0].(*database.GetOrderByIDRow)
+	ret := m.ctrl.Call(m, "DecreaseProductStock", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrderByID indicates an expected call of GetOrderByID.
-func (mr *MockStoreMockRecorder) GetOrderByID(ctx, id any) *gomock.Call {
+// DecreaseProductStock indicates an expected call of DecreaseProductStock.
+func (mr *MockStoreMockRecorder) DecreaseProductStock(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockStore)(nil).GetOrderByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecreaseProductStock", reflect.TypeOf((*MockStore)(nil).DecreaseProductStock), ctx, arg)
 }
 
-// GetOrderIDBySessionID mocks base method.
-func (m *MockStore) GetOrderIDBySessionID(ctx context.Context, sessionID pgtype.UUID) (int64, error) {
+// DeleteComboSlotOptionsBySlotID mocks base method.
+func (m *MockStore) DeleteComboSlotOptionsBySlotID(ctx context.Context, slotID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderIDBySessionID", ctx, sessionID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteComboSlotOptionsBySlotID", ctx, slotID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderIDBySessionID indicates an expected call of GetOrderIDBySessionID.
-func (mr *MockStoreMockRecorder) GetOrderIDBySessionID(ctx, sessionID any) *gomock.Call {
+// DeleteComboSlotOptionsBySlotID indicates an expected call of DeleteComboSlotOptionsBySlotID.
+func (mr *MockStoreMockRecorder) DeleteComboSlotOptionsBySlotID(ctx, slotID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderIDBySessionID", reflect.TypeOf((*MockStore)(nil).GetOrderIDBySessionID), ctx, sessionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComboSlotOptionsBySlotID", reflect.TypeOf((*MockStore)(nil).DeleteComboSlotOptionsBySlotID), ctx, slotID)
 }
 
-// GetOrderItemsByID mocks base method.
-func (m *MockStore) GetOrderItemsByID(ctx context.Context, id int64) (*database.GetOrderItemsByIDRow, error) {
+// DeleteComboSlotsExcept mocks base method.
+func (m *MockStore) DeleteComboSlotsExcept(ctx context.Context, arg database.DeleteComboSlotsExceptParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderItemsByID", ctx, id)
-	ret0, _ := ret[0].(*database.GetOrderItemsByIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteComboSlotsExcept", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderItemsByID indicates an expected call of GetOrderItemsByID.
-func (mr *MockStoreMockRecorder) GetOrderItemsByID(ctx, id any) *gomock.Call {
+// DeleteComboSlotsExcept indicates an expected call of DeleteComboSlotsExcept.
+func (mr *MockStoreMockRecorder) DeleteComboSlotsExcept(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsByID", reflect.TypeOf((*MockStore)(nil).GetOrderItemsByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComboSlotsExcept", reflect.TypeOf((*MockStore)(nil).DeleteComboSlotsExcept), ctx, arg)
 }
 
-// GetOrderItemsByOrderID mocks base method.
-func (m *MockStore) GetOrderItemsByOrderID(ctx context.Context, orderID int64) ([]*database.GetOrderItemsByOrderIDRow, error) {
+// DeleteMenuSchedulesByCategoryID mocks base method.
+func (m *MockStore) DeleteMenuSchedulesByCategoryID(ctx context.Context, categoryID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderItemsByOrderID", ctx, orderID)
-	ret0, _ := ret[0].([]*database.GetOrderItemsByOrderIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteMenuSchedulesByCategoryID", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderItemsByOrderID indicates an expected call of GetOrderItemsByOrderID.
-func (mr *MockStoreMockRecorder) GetOrderItemsByOrderID(ctx, orderID any) *gomock.Call {
+// DeleteMenuSchedulesByCategoryID indicates an expected call of DeleteMenuSchedulesByCategoryID.
+func (mr *MockStoreMockRecorder) DeleteMenuSchedulesByCategoryID(ctx, categoryID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsByOrderID", reflect.TypeOf((*MockStore)(nil).GetOrderItemsByOrderID), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuSchedulesByCategoryID", reflect.TypeOf((*MockStore)(nil).DeleteMenuSchedulesByCategoryID), ctx, categoryID)
 }
 
-// GetOrderSequence mocks base method.
-func (m *MockStore) GetOrderSequence(ctx context.Context, orderDate pgtype.Date) (int32, error) {
+// DeleteMenuSchedulesByProductID mocks base method.
+func (m *MockStore) DeleteMenuSchedulesByProductID(ctx context.Context, productID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderSequence", ctx, orderDate)
-	ret0, _ := ret[0].(int32)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteMenuSchedulesByProductID", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderSequence indicates an expected call of GetOrderSequence.
-func (mr *MockStoreMockRecorder) GetOrderSequence(ctx, orderDate any) *gomock.Call {
+// DeleteMenuSchedulesByProductID indicates an expected call of DeleteMenuSchedulesByProductID.
+func (mr *MockStoreMockRecorder) DeleteMenuSchedulesByProductID(ctx, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderSequence", reflect.TypeOf((*MockStore)(nil).GetOrderSequence), ctx, orderDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuSchedulesByProductID", reflect.TypeOf((*MockStore)(nil).DeleteMenuSchedulesByProductID), ctx, productID)
 }
 
-// GetOrderStatusCompleted mocks base method.
-func (m *MockStore) GetOrderStatusCompleted(ctx context.Context) (int64, error) {
+// DeleteModifierGroupsExcept mocks base method.
+func (m *MockStore) DeleteModifierGroupsExcept(ctx context.Context, arg database.DeleteModifierGroupsExceptParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderStatusCompleted", ctx)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteModifierGroupsExcept", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderStatusCompleted indicates an expected call of GetOrderStatusCompleted.
-func (mr *MockStoreMockRecorder) GetOrderStatusCompleted(ctx any) *gomock.Call {
+// DeleteModifierGroupsExcept indicates an expected call of DeleteModifierGroupsExcept.
+func (mr *MockStoreMockRecorder) DeleteModifierGroupsExcept(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusCompleted", reflect.TypeOf((*MockStore)(nil).GetOrderStatusCompleted), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModifierGroupsExcept", reflect.TypeOf((*MockStore)(nil).DeleteModifierGroupsExcept), ctx, arg)
 }
 
-// GetOrderStatusPreparing mocks base method.
-func (m *MockStore) GetOrderStatusPreparing(ctx context.Context) (int64, error) {
+// DeleteModifiersExcept mocks base method.
+func (m *MockStore) DeleteModifiersExcept(ctx context.Context, arg database.DeleteModifiersExceptParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderStatusPreparing", ctx)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
+	ret := m.ctrl.Call(m, "DeleteModifiersExcept", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
 
-// GetOrderStatusPreparing indicates an expected call of GetOrderStatusPreparing.
-func (mr *MockStoreMockRecorder) GetOrderStatusPreparing(ctx any) *gomock.Call {
+// DeleteModifiersExcept indicates an expected call of DeleteModifiersExcept.
+func (mr *MockStoreMockRecorder) DeleteModifiersExcept(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusPreparing", reflect.TypeOf((*MockStore)(nil).GetOrderStatusPreparing), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModifiersExcept", reflect.TypeOf((*MockStore)(nil).DeleteModifiersExcept), ctx, arg)
 }
 
-// GetOrderStatusServed mocks base method.
-func (m *MockStore) GetOrderStatusServed(ctx context.Context) (int64, error) {
+// DeleteProductAssociations mocks base method.
+func (m *MockStore) DeleteProductAssociations(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderStatusServed", ctx)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteProductAssociations", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderStatusServed indicates an expected call of GetOrderStatusServed.
-func (mr *MockStoreMockRecorder) GetOrderStatusServed(ctx any) *gomock.Call {
+// DeleteProductAssociations indicates an expected call of DeleteProductAssociations.
+func (mr *MockStoreMockRecorder) DeleteProductAssociations(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusServed", reflect.TypeOf((*MockStore)(nil).GetOrderStatusServed), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductAssociations", reflect.TypeOf((*MockStore)(nil).DeleteProductAssociations), ctx)
 }
 
-// GetOrderWithItems mocks base method.
-func (m *MockStore) GetOrderWithItems(ctx context.Context, arg database.GetOrderWithItemsParams) ([]*database.GetOrderWithItemsRow, error) {
+// DeleteProductDietaryTags mocks base method.
+func (m *MockStore) DeleteProductDietaryTags(ctx context.Context, productID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderWithItems", ctx, arg)
-	ret0, _ := ret[0].([]*database.GetOrderWithItemsRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteProductDietaryTags", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderWithItems indicates an expected call of GetOrderWithItems.
-func (mr *MockStoreMockRecorder) GetOrderWithItems(ctx, arg any) *gomock.Call {
+// DeleteProductDietaryTags indicates an expected call of DeleteProductDietaryTags.
+func (mr *MockStoreMockRecorder) DeleteProductDietaryTags(ctx, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItems", reflect.TypeOf((*MockStore)(nil).GetOrderWithItems), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductDietaryTags", reflect.TypeOf((*MockStore)(nil).DeleteProductDietaryTags), ctx, productID)
 }
 
-// GetOrderWithItemsByID mocks base method.
-func (m *MockStore) GetOrderWithItemsByID(ctx context.Context, arg database.GetOrderWithItemsByIDParams) (*database.GetOrderWithItemsByIDRow, error) {
+// DeleteProductIngredients mocks base method.
+func (m *MockStore) DeleteProductIngredients(ctx context.Context, productID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderWithItemsByID", ctx, arg)
-	ret0, _ := ret[0].(*database.GetOrderWithItemsByIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteProductIngredients", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderWithItemsByID indicates an expected call of GetOrderWithItemsByID.
-func (mr *MockStoreMockRecorder) GetOrderWithItemsByID(ctx, arg any) *gomock.Call {
+// DeleteProductIngredients indicates an expected call of DeleteProductIngredients.
+func (mr *MockStoreMockRecorder) DeleteProductIngredients(ctx, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItemsByID", reflect.TypeOf((*MockStore)(nil).GetOrderWithItemsByID), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductIngredients", reflect.TypeOf((*MockStore)(nil).DeleteProductIngredients), ctx, productID)
 }
 
-// GetOrderWithItemsGroupID mocks base method.
-func (m *MockStore) GetOrderWithItemsGroupID(ctx context.Context, orderItemsID []int64) ([]*database.GetOrderWithItemsGroupIDRow, error) {
+// DeleteProductSearchKeywords mocks base method.
+func (m *MockStore) DeleteProductSearchKeywords(ctx context.Context, productID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderWithItemsGroupID", ctx, orderItemsID)
-	ret0, _ := ret[0].([]*database.GetOrderWithItemsGroupIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteProductSearchKeywords", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrderWithItemsGroupID indicates an expected call of GetOrderWithItemsGroupID.
-func (mr *MockStoreMockRecorder) GetOrderWithItemsGroupID(ctx, orderItemsID any) *gomock.Call {
+// DeleteProductSearchKeywords indicates an expected call of DeleteProductSearchKeywords.
+func (mr *MockStoreMockRecorder) DeleteProductSearchKeywords(ctx, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItemsGroupID", reflect.TypeOf((*MockStore)(nil).GetOrderWithItemsGroupID), ctx, orderItemsID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductSearchKeywords", reflect.TypeOf((*MockStore)(nil).DeleteProductSearchKeywords), ctx, productID)
 }
 
-// GetPaymentAmountByTransaction mocks base method.
-func (m *MockStore) GetPaymentAmountByTransaction(ctx context.Context, transactionID string) (pgtype.Numeric, error) {
+// DeleteScheduledMenuVersion mocks base method.
+func (m *MockStore) DeleteScheduledMenuVersion(ctx context.Context, id int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaymentAmountByTransaction", ctx, transactionID)
-	ret0, _ := ret[0].(pgtype.Numeric)
+	ret := m.ctrl.Call(m, "DeleteScheduledMenuVersion", ctx, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPaymentAmountByTransaction indicates an expected call of GetPaymentAmountByTransaction.
-func (mr *MockStoreMockRecorder) GetPaymentAmountByTransaction(ctx, transactionID any) *gomock.Call {
+// DeleteScheduledMenuVersion indicates an expected call of DeleteScheduledMenuVersion.
+func (mr *MockStoreMockRecorder) DeleteScheduledMenuVersion(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentAmountByTransaction", reflect.TypeOf((*MockStore)(nil).GetPaymentAmountByTransaction), ctx, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledMenuVersion", reflect.TypeOf((*MockStore)(nil).DeleteScheduledMenuVersion), ctx, id)
 }
 
-// GetPaymentLastStatusCodeByTransaction mocks base method.
-func (m *MockStore) GetPaymentLastStatusCodeByTransaction(ctx context.Context, transactionID string) (pgtype.Text, error) {
+// DeleteScheduledProductPrice mocks base method.
+func (m *MockStore) DeleteScheduledProductPrice(ctx context.Context, arg database.DeleteScheduledProductPriceParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaymentLastStatusCodeByTransaction", ctx, transactionID)
-	ret0, _ := ret[0].(pgtype.Text)
+	ret := m.ctrl.Call(m, "DeleteScheduledProductPrice", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPaymentLastStatusCodeByTransaction indicates an expected call of GetPaymentLastStatusCodeByTransaction.
-func (mr *MockStoreMockRecorder) GetPaymentLastStatusCodeByTransaction(ctx, transactionID any) *gomock.Call {
+// DeleteScheduledProductPrice indicates an expected call of DeleteScheduledProductPrice.
+func (mr *MockStoreMockRecorder) DeleteScheduledProductPrice(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentLastStatusCodeByTransaction", reflect.TypeOf((*MockStore)(nil).GetPaymentLastStatusCodeByTransaction), ctx, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledProductPrice", reflect.TypeOf((*MockStore)(nil).DeleteScheduledProductPrice), ctx, arg)
 }
 
-// GetPaymentOrderIDByTransaction mocks base method.
-func (m *MockStore) GetPaymentOrderIDByTransaction(ctx context.Context, transactionID string) (int64, error) {
+// DeleteSearchAlias mocks base method.
+func (m *MockStore) DeleteSearchAlias(ctx context.Context, id int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaymentOrderIDByTransaction", ctx, transactionID)
+	ret := m.ctrl.Call(m, "DeleteSearchAlias", ctx, id)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPaymentOrderIDByTransaction indicates an expected call of GetPaymentOrderIDByTransaction.
-func (mr *MockStoreMockRecorder) GetPaymentOrderIDByTransaction(ctx, transactionID any) *gomock.Call {
+// DeleteSearchAlias indicates an expected call of DeleteSearchAlias.
+func (mr *MockStoreMockRecorder) DeleteSearchAlias(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderIDByTransaction", reflect.TypeOf((*MockStore)(nil).GetPaymentOrderIDByTransaction), ctx, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSearchAlias", reflect.TypeOf((*MockStore)(nil).DeleteSearchAlias), ctx, id)
 }
 
-// GetPaymentStatusPending mocks base method.
-func (m *MockStore) GetPaymentStatusPending(ctx context.Context) (int64, error) {
+// DeleteSentOutboxEvents mocks base method.
+func (m *MockStore) DeleteSentOutboxEvents(ctx context.Context, retentionDays int32) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPaymentStatusPending", ctx)
+	ret := m.ctrl.Call(m, "DeleteSentOutboxEvents", ctx, retentionDays)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPaymentStatusPending indicates an expected call of GetPaymentStatusPending.
-func (mr *MockStoreMockRecorder) GetPaymentStatusPending(ctx any) *gomock.Call {
+// DeleteSentOutboxEvents indicates an expected call of DeleteSentOutboxEvents.
+func (mr *MockStoreMockRecorder) DeleteSentOutboxEvents(ctx, retentionDays any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentStatusPending", reflect.TypeOf((*MockStore)(nil).GetPaymentStatusPending), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSentOutboxEvents", reflect.TypeOf((*MockStore)(nil).DeleteSentOutboxEvents), ctx, retentionDays)
 }
 
-// GetProductAvailableByID mocks base method.
-func (m *MockStore) GetProductAvailableByID(ctx context.Context, id int64) (*database.GetProductAvailableByIDRow, error) {
+// DeleteTranslationsByEntity mocks base method.
+func (m *MockStore) DeleteTranslationsByEntity(ctx context.Context, arg database.DeleteTranslationsByEntityParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductAvailableByID", ctx, id)
-	ret0, _ := ret[0].(*database.GetProductAvailableByIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteTranslationsByEntity", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetProductAvailableByID indicates an expected call of GetProductAvailableByID.
-func (mr *MockStoreMockRecorder) GetProductAvailableByID(ctx, id any) *gomock.Call {
+// DeleteTranslationsByEntity indicates an expected call of DeleteTranslationsByEntity.
+func (mr *MockStoreMockRecorder) DeleteTranslationsByEntity(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAvailableByID", reflect.TypeOf((*MockStore)(nil).GetProductAvailableByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTranslationsByEntity", reflect.TypeOf((*MockStore)(nil).DeleteTranslationsByEntity), ctx, arg)
 }
 
-// GetProductByID mocks base method.
-func (m *MockStore) GetProductByID(ctx context.Context, id int64) (*database.GetProductByIDRow, error) {
+// DeleteVariantsExcept mocks base method.
+func (m *MockStore) DeleteVariantsExcept(ctx context.Context, arg database.DeleteVariantsExceptParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductByID", ctx, id)
-	ret0, _ := ret[0].(*database.GetProductByIDRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteVariantsExcept", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetProductByID indicates an expected call of GetProductByID.
-func (mr *MockStoreMockRecorder) GetProductByID(ctx, id any) *gomock.Call {
+// DeleteVariantsExcept indicates an expected call of DeleteVariantsExcept.
+func (mr *MockStoreMockRecorder) DeleteVariantsExcept(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockStore)(nil).GetProductByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariantsExcept", reflect.TypeOf((*MockStore)(nil).DeleteVariantsExcept), ctx, arg)
 }
 
-// GetSessionExtensionModeByReasonCode mocks base method.
-func (m *MockStore) GetSessionExtensionModeByReasonCode(ctx context.Context, code string) (*database.GetSessionExtensionModeByReasonCodeRow, error) {
+// GetCategoryIDBySortOrderForUpdate mocks base method.
+func (m *MockStore) GetCategoryIDBySortOrderForUpdate(ctx context.Context, sortOrder int32) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessionExtensionModeByReasonCode", ctx, code)
-	ret0, _ := ret[0].(*database.GetSessionExtensionModeByReasonCodeRow)
+	ret := m.ctrl.Call(m, "GetCategoryIDBySortOrderForUpdate", ctx, sortOrder)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSessionExtensionModeByReasonCode indicates an expected call of GetSessionExtensionModeByReasonCode.
-func (mr *MockStoreMockRecorder) GetSessionExtensionModeByReasonCode(ctx, code any) *gomock.Call {
+// GetCategoryIDBySortOrderForUpdate indicates an expected call of GetCategoryIDBySortOrderForUpdate.
+func (mr *MockStoreMockRecorder) GetCategoryIDBySortOrderForUpdate(ctx, sortOrder any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionExtensionModeByReasonCode", reflect.TypeOf((*MockStore)(nil).GetSessionExtensionModeByReasonCode), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryIDBySortOrderForUpdate", reflect.TypeOf((*MockStore)(nil).GetCategoryIDBySortOrderForUpdate), ctx, sortOrder)
 }
 
-// GetSessionIDByOrderID mocks base method.
-func (m *MockStore) GetSessionIDByOrderID(ctx context.Context, id int64) (pgtype.UUID, error) {
+// GetCategorySortOrderForUpdate mocks base method.
+func (m *MockStore) GetCategorySortOrderForUpdate(ctx context.Context, id int64) (int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessionIDByOrderID", ctx, id)
-	ret0, _ := ret[0].(pgtype.UUID)
+	ret := m.ctrl.Call(m, "GetCategorySortOrderForUpdate", ctx, id)
+	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSessionIDByOrderID indicates an expected call of GetSessionIDByOrderID.
-func (mr *MockStoreMockRecorder) GetSessionIDByOrderID(ctx, id any) *gomock.Call {
+// GetCategorySortOrderForUpdate indicates an expected call of GetCategorySortOrderForUpdate.
+func (mr *MockStoreMockRecorder) GetCategorySortOrderForUpdate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionIDByOrderID", reflect.TypeOf((*MockStore)(nil).GetSessionIDByOrderID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySortOrderForUpdate", reflect.TypeOf((*MockStore)(nil).GetCategorySortOrderForUpdate), ctx, id)
 }
 
-// GetSessionIDByTableID mocks base method.
-func (m *MockStore) GetSessionIDByTableID(ctx context.Context, tableID int64) (pgtype.UUID, error) {
+// GetComboOrderItemProgress mocks base method.
+func (m *MockStore) GetComboOrderItemProgress(ctx context.Context, parentOrderItemID int64) (*database.GetComboOrderItemProgressRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessionIDByTableID", ctx, tableID)
-	ret0, _ := ret[0].(pgtype.UUID)
+	ret := m.ctrl.Call(m, "GetComboOrderItemProgress", ctx, parentOrderItemID)
+	ret0, _ := ret[0].(*database.GetComboOrderItemProgressRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSessionIDByTableID indicates an expected call of GetSessionIDByTableID.
-func (mr *MockStoreMockRecorder) GetSessionIDByTableID(ctx, tableID any) *gomock.Call {
+// GetComboOrderItemProgress indicates an expected call of GetComboOrderItemProgress.
+func (mr *MockStoreMockRecorder) GetComboOrderItemProgress(ctx, parentOrderItemID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionIDByTableID", reflect.TypeOf((*MockStore)(nil).GetSessionIDByTableID), ctx, tableID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComboOrderItemProgress", reflect.TypeOf((*MockStore)(nil).GetComboOrderItemProgress), ctx, parentOrderItemID)
 }
 
-// GetTableIDByOrderID mocks base method.
-func (m *MockStore) GetTableIDByOrderID(ctx context.Context, orderID int64) (int64, error) {
+// GetDurationMinutesByProductID mocks base method.
+func (m *MockStore) GetDurationMinutesByProductID(ctx context.Context, productsID int64) (int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTableIDByOrderID", ctx, orderID)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetDurationMinutesByProductID", ctx, productsID)
+	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTableIDByOrderID indicates an expected call of GetTableIDByOrderID.
-func (mr *MockStoreMockRecorder) GetTableIDByOrderID(ctx, orderID any) *gomock.Call {
+// GetDurationMinutesByProductID indicates an expected call of GetDurationMinutesByProductID.
+func (mr *MockStoreMockRecorder) GetDurationMinutesByProductID(ctx, productsID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableIDByOrderID", reflect.TypeOf((*MockStore)(nil).GetTableIDByOrderID), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDurationMinutesByProductID", reflect.TypeOf((*MockStore)(nil).GetDurationMinutesByProductID), ctx, productsID)
 }
 
-// GetTableNumber mocks base method.
-func (m *MockStore) GetTableNumber(ctx context.Context, id int64) (int32, error) {
+// GetExpiresAtByTableID mocks base method.
+func (m *MockStore) GetExpiresAtByTableID(ctx context.Context, tableID int64) (*database.GetExpiresAtByTableIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTableNumber", ctx, id)
-	ret0, _ := ret[0].(int32)
+	ret := m.ctrl.Call(m, "GetExpiresAtByTableID", ctx, tableID)
+	ret0, _ := ret[0].(*database.GetExpiresAtByTableIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTableNumber indicates an expected call of GetTableNumber.
-func (mr *MockStoreMockRecorder) GetTableNumber(ctx, id any) *gomock.Call {
+// GetExpiresAtByTableID indicates an expected call of GetExpiresAtByTableID.
+func (mr *MockStoreMockRecorder) GetExpiresAtByTableID(ctx, tableID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableNumber", reflect.TypeOf((*MockStore)(nil).GetTableNumber), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiresAtByTableID", reflect.TypeOf((*MockStore)(nil).GetExpiresAtByTableID), ctx, tableID)
 }
 
-// GetTableNumberOrderByID mocks base method.
-func (m *MockStore) GetTableNumberOrderByID(ctx context.Context, orderID int64) (int32, error) {
+// GetLastOutboxEventIDByKey mocks base method.
+func (m *MockStore) GetLastOutboxEventIDByKey(ctx context.Context, arg database.GetLastOutboxEventIDByKeyParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTableNumberOrderByID", ctx, orderID)
-	ret0, _ := ret[0].(int32)
+	ret := m.ctrl.Call(m, "GetLastOutboxEventIDByKey", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTableNumberOrderByID indicates an expected call of GetTableNumberOrderByID.
-func (mr *MockStoreMockRecorder) GetTableNumberOrderByID(ctx, orderID any) *gomock.Call {
+// GetLastOutboxEventIDByKey indicates an expected call of GetLastOutboxEventIDByKey.
+func (mr *MockStoreMockRecorder) GetLastOutboxEventIDByKey(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableNumberOrderByID", reflect.TypeOf((*MockStore)(nil).GetTableNumberOrderByID), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastOutboxEventIDByKey", reflect.TypeOf((*MockStore)(nil).GetLastOutboxEventIDByKey), ctx, arg)
 }
 
-// GetTableSession mocks base method.
-func (m *MockStore) GetTableSession(ctx context.Context, sessionid pgtype.UUID) (*database.GetTableSessionRow, error) {
+// GetMenuVersionSnapshot mocks base method.
+func (m *MockStore) GetMenuVersionSnapshot(ctx context.Context, id int64) (*database.GetMenuVersionSnapshotRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTableSession", ctx, sessionid)
-	ret0, _ := ret[0].(*database.GetTableSessionRow)
+	ret := m.ctrl.Call(m, "GetMenuVersionSnapshot", ctx, id)
+	ret0, _ := ret[0].(*database.GetMenuVersionSnapshotRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTableSession indicates an expected call of GetTableSession.
-func (mr *MockStoreMockRecorder) GetTableSession(ctx, sessionid any) *gomock.Call {
+// GetMenuVersionSnapshot indicates an expected call of GetMenuVersionSnapshot.
+func (mr *MockStoreMockRecorder) GetMenuVersionSnapshot(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableSession", reflect.TypeOf((*MockStore)(nil).GetTableSession), ctx, sessionid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuVersionSnapshot", reflect.TypeOf((*MockStore)(nil).GetMenuVersionSnapshot), ctx, id)
 }
 
-// GetTimeNow mocks base method.
-func (m *MockStore) GetTimeNow(ctx context.Context) (pgtype.Timestamptz, error) {
+// GetOpenTableServiceRequestID mocks base method.
+func (m *MockStore) GetOpenTableServiceRequestID(ctx context.Context, arg database.GetOpenTableServiceRequestIDParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTimeNow", ctx)
-	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret := m.ctrl.Call(m, "GetOpenTableServiceRequestID", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTimeNow indicates an expected call of GetTimeNow.
-func (mr *MockStoreMockRecorder) GetTimeNow(ctx any) *gomock.Call {
+// GetOpenTableServiceRequestID indicates an expected call of GetOpenTableServiceRequestID.
+func (mr *MockStoreMockRecorder) GetOpenTableServiceRequestID(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeNow", reflect.TypeOf((*MockStore)(nil).GetTimeNow), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenTableServiceRequestID", reflect.TypeOf((*MockStore)(nil).GetOpenTableServiceRequestID), ctx, arg)
 }
 
-// GetTotalAmountToPayForServedItems mocks base method.
-func (m *MockStore) GetTotalAmountToPayForServedItems(ctx context.Context, orderID int64) (pgtype.Numeric, error) {
+// GetOrderBuyXGetYDiscount mocks base method.
+func (m *MockStore) GetOrderBuyXGetYDiscount(ctx context.Context, orderID int64) (pgtype.Numeric, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalAmountToPayForServedItems", ctx, orderID)
+	ret := m.ctrl.Call(m, "GetOrderBuyXGetYDiscount", ctx, orderID)
 	ret0, _ := ret[0].(pgtype.Numeric)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalAmountToPayForServedItems indicates an expected call of GetTotalAmountToPayForServedItems.
-func (mr *MockStoreMockRecorder) GetTotalAmountToPayForServedItems(ctx, orderID any) *gomock.Call {
+// GetOrderBuyXGetYDiscount indicates an expected call of GetOrderBuyXGetYDiscount.
+func (mr *MockStoreMockRecorder) GetOrderBuyXGetYDiscount(ctx, orderID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalAmountToPayForServedItems", reflect.TypeOf((*MockStore)(nil).GetTotalAmountToPayForServedItems), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderBuyXGetYDiscount", reflect.TypeOf((*MockStore)(nil).GetOrderBuyXGetYDiscount), ctx, orderID)
 }
 
-// GetTotalItemOrderWithItems mocks base method.
-func (m *MockStore) GetTotalItemOrderWithItems(ctx context.Context, orderID int64) (int64, error) {
+// GetOrderByID mocks base method.
+func (m *MockStore) GetOrderByID(ctx context.Context, id int64) (*database.GetOrderByIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalItemOrderWithItems", ctx, orderID)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetOrderByID", ctx, id)
+	ret0, _ := ret[0].(*database.GetOrderByIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalItemOrderWithItems indicates an expected call of GetTotalItemOrderWithItems.
-func (mr *MockStoreMockRecorder) GetTotalItemOrderWithItems(ctx, orderID any) *gomock.Call {
+// GetOrderByID indicates an expected call of GetOrderByID.
+func (mr *MockStoreMockRecorder) GetOrderByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalItemOrderWithItems", reflect.TypeOf((*MockStore)(nil).GetTotalItemOrderWithItems), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockStore)(nil).GetOrderByID), ctx, id)
 }
 
-// GetTotalPageQuickSearchTables mocks base method.
-func (m *MockStore) GetTotalPageQuickSearchTables(ctx context.Context, numberOfPeople int32) (int64, error) {
+// GetOrderIDBySessionID mocks base method.
+func (m *MockStore) GetOrderIDBySessionID(ctx context.Context, sessionID pgtype.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalPageQuickSearchTables", ctx, numberOfPeople)
+	ret := m.ctrl.Call(m, "GetOrderIDBySessionID", ctx, sessionID)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalPageQuickSearchTables indicates an expected call of GetTotalPageQuickSearchTables.
-func (mr *MockStoreMockRecorder) GetTotalPageQuickSearchTables(ctx, numberOfPeople any) *gomock.Call {
+// GetOrderIDBySessionID indicates an expected call of GetOrderIDBySessionID.
+func (mr *MockStoreMockRecorder) GetOrderIDBySessionID(ctx, sessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPageQuickSearchTables", reflect.TypeOf((*MockStore)(nil).GetTotalPageQuickSearchTables), ctx, numberOfPeople)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderIDBySessionID", reflect.TypeOf((*MockStore)(nil).GetOrderIDBySessionID), ctx, sessionID)
 }
 
-// GetTotalPageSearchProducts mocks base method.
-func (m *MockStore) GetTotalPageSearchProducts(ctx context.Context, arg database.GetTotalPageSearchProductsParams) (int64, error) {
+// GetOrderItemForAmend mocks base method.
+func (m *MockStore) GetOrderItemForAmend(ctx context.Context, arg database.GetOrderItemForAmendParams) (*database.GetOrderItemForAmendRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalPageSearchProducts", ctx, arg)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetOrderItemForAmend", ctx, arg)
+	ret0, _ := ret[0].(*database.GetOrderItemForAmendRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalPageSearchProducts indicates an expected call of GetTotalPageSearchProducts.
-func (mr *MockStoreMockRecorder) GetTotalPageSearchProducts(ctx, arg any) *gomock.Call {
+// GetOrderItemForAmend indicates an expected call of GetOrderItemForAmend.
+func (mr *MockStoreMockRecorder) GetOrderItemForAmend(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPageSearchProducts", reflect.TypeOf((*MockStore)(nil).GetTotalPageSearchProducts), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemForAmend", reflect.TypeOf((*MockStore)(nil).GetOrderItemForAmend), ctx, arg)
 }
 
-// GetTotalPageSearchTables mocks base method.
-func (m *MockStore) GetTotalPageSearchTables(ctx context.Context, arg database.GetTotalPageSearchTablesParams) (int64, error) {
+// GetOrderItemsByID mocks base method.
+func (m *MockStore) GetOrderItemsByID(ctx context.Context, id int64) (*database.GetOrderItemsByIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalPageSearchTables", ctx, arg)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetOrderItemsByID", ctx, id)
+	ret0, _ := ret[0].(*database.GetOrderItemsByIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalPageSearchTables indicates an expected call of GetTotalPageSearchTables.
-func (mr *MockStoreMockRecorder) GetTotalPageSearchTables(ctx, arg any) *gomock.Call {
+// GetOrderItemsByID indicates an expected call of GetOrderItemsByID.
+func (mr *MockStoreMockRecorder) GetOrderItemsByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPageSearchTables", reflect.TypeOf((*MockStore)(nil).GetTotalPageSearchTables), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsByID", reflect.TypeOf((*MockStore)(nil).GetOrderItemsByID), ctx, id)
 }
 
-// GetTotalSearchOrderItems mocks base method.
-func (m *MockStore) GetTotalSearchOrderItems(ctx context.Context, arg database.GetTotalSearchOrderItemsParams) (int64, error) {
+// GetOrderItemsByOrderID mocks base method.
+func (m *MockStore) GetOrderItemsByOrderID(ctx context.Context, orderID int64) ([]*database.GetOrderItemsByOrderIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalSearchOrderItems", ctx, arg)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetOrderItemsByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*database.GetOrderItemsByOrderIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalSearchOrderItems indicates an expected call of GetTotalSearchOrderItems.
-func (mr *MockStoreMockRecorder) GetTotalSearchOrderItems(ctx, arg any) *gomock.Call {
+// GetOrderItemsByOrderID indicates an expected call of GetOrderItemsByOrderID.
+func (mr *MockStoreMockRecorder) GetOrderItemsByOrderID(ctx, orderID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalSearchOrderItems", reflect.TypeOf((*MockStore)(nil).GetTotalSearchOrderItems), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsByOrderID", reflect.TypeOf((*MockStore)(nil).GetOrderItemsByOrderID), ctx, orderID)
 }
 
-// GetTotalSearchOrderItemsIsNotFinal mocks base method.
-func (m *MockStore) GetTotalSearchOrderItemsIsNotFinal(ctx context.Context, arg database.GetTotalSearchOrderItemsIsNotFinalParams) (int64, error) {
+// GetOrderItemsStatusForUpdate mocks base method.
+func (m *MockStore) GetOrderItemsStatusForUpdate(ctx context.Context, id int64) (*database.GetOrderItemsStatusForUpdateRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalSearchOrderItemsIsNotFinal", ctx, arg)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "GetOrderItemsStatusForUpdate", ctx, id)
+	ret0, _ := ret[0].(*database.GetOrderItemsStatusForUpdateRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTotalSearchOrderItemsIsNotFinal indicates an expected call of GetTotalSearchOrderItemsIsNotFinal.
-func (mr *MockStoreMockRecorder) GetTotalSearchOrderItemsIsNotFinal(ctx, arg any) *gomock.Call {
+// GetOrderItemsStatusForUpdate indicates an expected call of GetOrderItemsStatusForUpdate.
+func (mr *MockStoreMockRecorder) GetOrderItemsStatusForUpdate(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalSearchOrderItemsIsNotFinal", reflect.TypeOf((*MockStore)(nil).GetTotalSearchOrderItemsIsNotFinal), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderItemsStatusForUpdate", reflect.TypeOf((*MockStore)(nil).GetOrderItemsStatusForUpdate), ctx, id)
 }
 
-// Health mocks base method.
-func (m *MockStore) Health(ctx context.Context) (int32, error) {
+// GetOrderSequence mocks base method.
+func (m *MockStore) GetOrderSequence(ctx context.Context, orderDate pgtype.Date) (int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Health", ctx)
+	ret := m.ctrl.Call(m, "GetOrderSequence", ctx, orderDate)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Health indicates an expected call of Health.
-func (mr *MockStoreMockRecorder) Health(ctx any) *gomock.Call {
+// GetOrderSequence indicates an expected call of GetOrderSequence.
+func (mr *MockStoreMockRecorder) GetOrderSequence(ctx, orderDate any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockStore)(nil).Health), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderSequence", reflect.TypeOf((*MockStore)(nil).GetOrderSequence), ctx, orderDate)
 }
 
-// IsOrderExist mocks base method.
-func (m *MockStore) IsOrderExist(ctx context.Context, id int64) (bool, error) {
+// GetOrderStatusByID mocks base method.
+func (m *MockStore) GetOrderStatusByID(ctx context.Context, id int64) (*database.GetOrderStatusByIDRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOrderExist", ctx, id)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetOrderStatusByID", ctx, id)
+	ret0, _ := ret[0].(*database.GetOrderStatusByIDRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsOrderExist indicates an expected call of IsOrderExist.
-func (mr *MockStoreMockRecorder) IsOrderExist(ctx, id any) *gomock.Call {
+// GetOrderStatusByID indicates an expected call of GetOrderStatusByID.
+func (mr *MockStoreMockRecorder) GetOrderStatusByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderExist", reflect.TypeOf((*MockStore)(nil).IsOrderExist), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusByID", reflect.TypeOf((*MockStore)(nil).GetOrderStatusByID), ctx, id)
 }
 
-// IsOrderItemsExist mocks base method.
+// GetOrderStatusByIDForUpdate mocks base method.
+func (m *MockStore) GetOrderStatusByIDForUpdate(ctx context.Context, id int64) (*database.GetOrderStatusByIDForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderStatusByIDForUpdate", ctx, id)
+	ret0, _ := ret[0].(*database.GetOrderStatusByIDForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderStatusByIDForUpdate indicates an expected call of GetOrderStatusByIDForUpdate.
+func (mr *MockStoreMockRecorder) GetOrderStatusByIDForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusByIDForUpdate", reflect.TypeOf((*MockStore)(nil).GetOrderStatusByIDForUpdate), ctx, id)
+}
+
+// GetOrderStatusCompleted mocks base method.
+func (m *MockStore) GetOrderStatusCompleted(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderStatusCompleted", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderStatusCompleted indicates an expected call of GetOrderStatusCompleted.
+func (mr *MockStoreMockRecorder) GetOrderStatusCompleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusCompleted", reflect.TypeOf((*MockStore)(nil).GetOrderStatusCompleted), ctx)
+}
+
+// GetOrderStatusPending mocks base method.
+func (m *MockStore) GetOrderStatusPending(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderStatusPending", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderStatusPending indicates an expected call of GetOrderStatusPending.
+func (mr *MockStoreMockRecorder) GetOrderStatusPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusPending", reflect.TypeOf((*MockStore)(nil).GetOrderStatusPending), ctx)
+}
+
+// GetOrderStatusPreparing mocks base method.
+func (m *MockStore) GetOrderStatusPreparing(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderStatusPreparing", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderStatusPreparing indicates an expected call of GetOrderStatusPreparing.
+func (mr *MockStoreMockRecorder) GetOrderStatusPreparing(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusPreparing", reflect.TypeOf((*MockStore)(nil).GetOrderStatusPreparing), ctx)
+}
+
+// GetOrderStatusServed mocks base method.
+func (m *MockStore) GetOrderStatusServed(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderStatusServed", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderStatusServed indicates an expected call of GetOrderStatusServed.
+func (mr *MockStoreMockRecorder) GetOrderStatusServed(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderStatusServed", reflect.TypeOf((*MockStore)(nil).GetOrderStatusServed), ctx)
+}
+
+// GetOrderWithItems mocks base method.
+func (m *MockStore) GetOrderWithItems(ctx context.Context, arg database.GetOrderWithItemsParams) ([]*database.GetOrderWithItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderWithItems", ctx, arg)
+	ret0, _ := ret[0].([]*database.GetOrderWithItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderWithItems indicates an expected call of GetOrderWithItems.
+func (mr *MockStoreMockRecorder) GetOrderWithItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItems", reflect.TypeOf((*MockStore)(nil).GetOrderWithItems), ctx, arg)
+}
+
+// GetOrderWithItemsByID mocks base method.
+func (m *MockStore) GetOrderWithItemsByID(ctx context.Context, arg database.GetOrderWithItemsByIDParams) (*database.GetOrderWithItemsByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderWithItemsByID", ctx, arg)
+	ret0, _ := ret[0].(*database.GetOrderWithItemsByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderWithItemsByID indicates an expected call of GetOrderWithItemsByID.
+func (mr *MockStoreMockRecorder) GetOrderWithItemsByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItemsByID", reflect.TypeOf((*MockStore)(nil).GetOrderWithItemsByID), ctx, arg)
+}
+
+// GetOrderWithItemsGroupID mocks base method.
+func (m *MockStore) GetOrderWithItemsGroupID(ctx context.Context, orderItemsID []int64) ([]*database.GetOrderWithItemsGroupIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderWithItemsGroupID", ctx, orderItemsID)
+	ret0, _ := ret[0].([]*database.GetOrderWithItemsGroupIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderWithItemsGroupID indicates an expected call of GetOrderWithItemsGroupID.
+func (mr *MockStoreMockRecorder) GetOrderWithItemsGroupID(ctx, orderItemsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderWithItemsGroupID", reflect.TypeOf((*MockStore)(nil).GetOrderWithItemsGroupID), ctx, orderItemsID)
+}
+
+// GetPaymentAmountByTransaction mocks base method.
+func (m *MockStore) GetPaymentAmountByTransaction(ctx context.Context, transactionID string) (pgtype.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentAmountByTransaction", ctx, transactionID)
+	ret0, _ := ret[0].(pgtype.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentAmountByTransaction indicates an expected call of GetPaymentAmountByTransaction.
+func (mr *MockStoreMockRecorder) GetPaymentAmountByTransaction(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentAmountByTransaction", reflect.TypeOf((*MockStore)(nil).GetPaymentAmountByTransaction), ctx, transactionID)
+}
+
+// GetPaymentLastStatusCodeByTransaction mocks base method.
+func (m *MockStore) GetPaymentLastStatusCodeByTransaction(ctx context.Context, transactionID string) (pgtype.Text, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentLastStatusCodeByTransaction", ctx, transactionID)
+	ret0, _ := ret[0].(pgtype.Text)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentLastStatusCodeByTransaction indicates an expected call of GetPaymentLastStatusCodeByTransaction.
+func (mr *MockStoreMockRecorder) GetPaymentLastStatusCodeByTransaction(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentLastStatusCodeByTransaction", reflect.TypeOf((*MockStore)(nil).GetPaymentLastStatusCodeByTransaction), ctx, transactionID)
+}
+
+// GetPaymentOrderIDByTransaction mocks base method.
+func (m *MockStore) GetPaymentOrderIDByTransaction(ctx context.Context, transactionID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentOrderIDByTransaction", ctx, transactionID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentOrderIDByTransaction indicates an expected call of GetPaymentOrderIDByTransaction.
+func (mr *MockStoreMockRecorder) GetPaymentOrderIDByTransaction(ctx, transactionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentOrderIDByTransaction", reflect.TypeOf((*MockStore)(nil).GetPaymentOrderIDByTransaction), ctx, transactionID)
+}
+
+// GetPaymentStatusPending mocks base method.
+func (m *MockStore) GetPaymentStatusPending(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentStatusPending", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentStatusPending indicates an expected call of GetPaymentStatusPending.
+func (mr *MockStoreMockRecorder) GetPaymentStatusPending(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentStatusPending", reflect.TypeOf((*MockStore)(nil).GetPaymentStatusPending), ctx)
+}
+
+// GetProductAvailableByID mocks base method.
+func (m *MockStore) GetProductAvailableByID(ctx context.Context, arg database.GetProductAvailableByIDParams) (*database.GetProductAvailableByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAvailableByID", ctx, arg)
+	ret0, _ := ret[0].(*database.GetProductAvailableByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAvailableByID indicates an expected call of GetProductAvailableByID.
+func (mr *MockStoreMockRecorder) GetProductAvailableByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAvailableByID", reflect.TypeOf((*MockStore)(nil).GetProductAvailableByID), ctx, arg)
+}
+
+// GetProductByID mocks base method.
+func (m *MockStore) GetProductByID(ctx context.Context, arg database.GetProductByIDParams) (*database.GetProductByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByID", ctx, arg)
+	ret0, _ := ret[0].(*database.GetProductByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByID indicates an expected call of GetProductByID.
+func (mr *MockStoreMockRecorder) GetProductByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockStore)(nil).GetProductByID), ctx, arg)
+}
+
+// GetProductDetailByID mocks base method.
+func (m *MockStore) GetProductDetailByID(ctx context.Context, id int64) (*database.GetProductDetailByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductDetailByID", ctx, id)
+	ret0, _ := ret[0].(*database.GetProductDetailByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductDetailByID indicates an expected call of GetProductDetailByID.
+func (mr *MockStoreMockRecorder) GetProductDetailByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDetailByID", reflect.TypeOf((*MockStore)(nil).GetProductDetailByID), ctx, id)
+}
+
+// GetProductPriceAt mocks base method.
+func (m *MockStore) GetProductPriceAt(ctx context.Context, arg database.GetProductPriceAtParams) (pgtype.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductPriceAt", ctx, arg)
+	ret0, _ := ret[0].(pgtype.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductPriceAt indicates an expected call of GetProductPriceAt.
+func (mr *MockStoreMockRecorder) GetProductPriceAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductPriceAt", reflect.TypeOf((*MockStore)(nil).GetProductPriceAt), ctx, arg)
+}
+
+// GetPublishedCategories mocks base method.
+func (m *MockStore) GetPublishedCategories(ctx context.Context, id int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedCategories", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedCategories indicates an expected call of GetPublishedCategories.
+func (mr *MockStoreMockRecorder) GetPublishedCategories(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedCategories", reflect.TypeOf((*MockStore)(nil).GetPublishedCategories), ctx, id)
+}
+
+// GetPublishedMenuVersionID mocks base method.
+func (m *MockStore) GetPublishedMenuVersionID(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedMenuVersionID", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedMenuVersionID indicates an expected call of GetPublishedMenuVersionID.
+func (mr *MockStoreMockRecorder) GetPublishedMenuVersionID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedMenuVersionID", reflect.TypeOf((*MockStore)(nil).GetPublishedMenuVersionID), ctx)
+}
+
+// GetSessionExtensionModeByReasonCode mocks base method.
+func (m *MockStore) GetSessionExtensionModeByReasonCode(ctx context.Context, code string) (*database.GetSessionExtensionModeByReasonCodeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionExtensionModeByReasonCode", ctx, code)
+	ret0, _ := ret[0].(*database.GetSessionExtensionModeByReasonCodeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionExtensionModeByReasonCode indicates an expected call of GetSessionExtensionModeByReasonCode.
+func (mr *MockStoreMockRecorder) GetSessionExtensionModeByReasonCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionExtensionModeByReasonCode", reflect.TypeOf((*MockStore)(nil).GetSessionExtensionModeByReasonCode), ctx, code)
+}
+
+// GetSessionIDByOrderID mocks base method.
+func (m *MockStore) GetSessionIDByOrderID(ctx context.Context, id int64) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionIDByOrderID", ctx, id)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionIDByOrderID indicates an expected call of GetSessionIDByOrderID.
+func (mr *MockStoreMockRecorder) GetSessionIDByOrderID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionIDByOrderID", reflect.TypeOf((*MockStore)(nil).GetSessionIDByOrderID), ctx, id)
+}
+
+// GetSessionIDByTableID mocks base method.
+func (m *MockStore) GetSessionIDByTableID(ctx context.Context, tableID int64) (pgtype.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionIDByTableID", ctx, tableID)
+	ret0, _ := ret[0].(pgtype.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionIDByTableID indicates an expected call of GetSessionIDByTableID.
+func (mr *MockStoreMockRecorder) GetSessionIDByTableID(ctx, tableID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionIDByTableID", reflect.TypeOf((*MockStore)(nil).GetSessionIDByTableID), ctx, tableID)
+}
+
+// GetTableIDByOrderID mocks base method.
+func (m *MockStore) GetTableIDByOrderID(ctx context.Context, orderID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableIDByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableIDByOrderID indicates an expected call of GetTableIDByOrderID.
+func (mr *MockStoreMockRecorder) GetTableIDByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableIDByOrderID", reflect.TypeOf((*MockStore)(nil).GetTableIDByOrderID), ctx, orderID)
+}
+
+// GetTableNumber mocks base method.
+func (m *MockStore) GetTableNumber(ctx context.Context, id int64) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableNumber", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableNumber indicates an expected call of GetTableNumber.
+func (mr *MockStoreMockRecorder) GetTableNumber(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableNumber", reflect.TypeOf((*MockStore)(nil).GetTableNumber), ctx, id)
+}
+
+// GetTableNumberOrderByID mocks base method.
+func (m *MockStore) GetTableNumberOrderByID(ctx context.Context, orderID int64) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableNumberOrderByID", ctx, orderID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableNumberOrderByID indicates an expected call of GetTableNumberOrderByID.
+func (mr *MockStoreMockRecorder) GetTableNumberOrderByID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableNumberOrderByID", reflect.TypeOf((*MockStore)(nil).GetTableNumberOrderByID), ctx, orderID)
+}
+
+// GetTableServiceRequestForUpdate mocks base method.
+func (m *MockStore) GetTableServiceRequestForUpdate(ctx context.Context, id int64) (*database.GetTableServiceRequestForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableServiceRequestForUpdate", ctx, id)
+	ret0, _ := ret[0].(*database.GetTableServiceRequestForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableServiceRequestForUpdate indicates an expected call of GetTableServiceRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetTableServiceRequestForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableServiceRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetTableServiceRequestForUpdate), ctx, id)
+}
+
+// GetTableSession mocks base method.
+func (m *MockStore) GetTableSession(ctx context.Context, sessionid pgtype.UUID) (*database.GetTableSessionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableSession", ctx, sessionid)
+	ret0, _ := ret[0].(*database.GetTableSessionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableSession indicates an expected call of GetTableSession.
+func (mr *MockStoreMockRecorder) GetTableSession(ctx, sessionid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableSession", reflect.TypeOf((*MockStore)(nil).GetTableSession), ctx, sessionid)
+}
+
+// GetTableStatusForUpdate mocks base method.
+func (m *MockStore) GetTableStatusForUpdate(ctx context.Context, id int64) (*database.GetTableStatusForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableStatusForUpdate", ctx, id)
+	ret0, _ := ret[0].(*database.GetTableStatusForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableStatusForUpdate indicates an expected call of GetTableStatusForUpdate.
+func (mr *MockStoreMockRecorder) GetTableStatusForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableStatusForUpdate", reflect.TypeOf((*MockStore)(nil).GetTableStatusForUpdate), ctx, id)
+}
+
+// GetTimeNow mocks base method.
+func (m *MockStore) GetTimeNow(ctx context.Context) (pgtype.Timestamptz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeNow", ctx)
+	ret0, _ := ret[0].(pgtype.Timestamptz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeNow indicates an expected call of GetTimeNow.
+func (mr *MockStoreMockRecorder) GetTimeNow(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeNow", reflect.TypeOf((*MockStore)(nil).GetTimeNow), ctx)
+}
+
+// GetTotalAmountToPayForServedItems mocks base method.
+func (m *MockStore) GetTotalAmountToPayForServedItems(ctx context.Context, id int64) (pgtype.Numeric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalAmountToPayForServedItems", ctx, id)
+	ret0, _ := ret[0].(pgtype.Numeric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalAmountToPayForServedItems indicates an expected call of GetTotalAmountToPayForServedItems.
+func (mr *MockStoreMockRecorder) GetTotalAmountToPayForServedItems(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalAmountToPayForServedItems", reflect.TypeOf((*MockStore)(nil).GetTotalAmountToPayForServedItems), ctx, id)
+}
+
+// GetTotalItemOrderWithItems mocks base method.
+func (m *MockStore) GetTotalItemOrderWithItems(ctx context.Context, orderID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalItemOrderWithItems", ctx, orderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalItemOrderWithItems indicates an expected call of GetTotalItemOrderWithItems.
+func (mr *MockStoreMockRecorder) GetTotalItemOrderWithItems(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalItemOrderWithItems", reflect.TypeOf((*MockStore)(nil).GetTotalItemOrderWithItems), ctx, orderID)
+}
+
+// GetTotalPageQuickSearchTables mocks base method.
+func (m *MockStore) GetTotalPageQuickSearchTables(ctx context.Context, numberOfPeople int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPageQuickSearchTables", ctx, numberOfPeople)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPageQuickSearchTables indicates an expected call of GetTotalPageQuickSearchTables.
+func (mr *MockStoreMockRecorder) GetTotalPageQuickSearchTables(ctx, numberOfPeople any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPageQuickSearchTables", reflect.TypeOf((*MockStore)(nil).GetTotalPageQuickSearchTables), ctx, numberOfPeople)
+}
+
+// GetTotalPageSearchProducts mocks base method.
+func (m *MockStore) GetTotalPageSearchProducts(ctx context.Context, arg database.GetTotalPageSearchProductsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPageSearchProducts", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPageSearchProducts indicates an expected call of GetTotalPageSearchProducts.
+func (mr *MockStoreMockRecorder) GetTotalPageSearchProducts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPageSearchProducts", reflect.TypeOf((*MockStore)(nil).GetTotalPageSearchProducts), ctx, arg)
+}
+
+// GetTotalPageSearchTables mocks base method.
+func (m *MockStore) GetTotalPageSearchTables(ctx context.Context, arg database.GetTotalPageSearchTablesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPageSearchTables", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPageSearchTables indicates an expected call of GetTotalPageSearchTables.
+func (mr *MockStoreMockRecorder) GetTotalPageSearchTables(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPageSearchTables", reflect.TypeOf((*MockStore)(nil).GetTotalPageSearchTables), ctx, arg)
+}
+
+// GetTotalSearchOrderItems mocks base method.
+func (m *MockStore) GetTotalSearchOrderItems(ctx context.Context, arg database.GetTotalSearchOrderItemsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalSearchOrderItems", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalSearchOrderItems indicates an expected call of GetTotalSearchOrderItems.
+func (mr *MockStoreMockRecorder) GetTotalSearchOrderItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalSearchOrderItems", reflect.TypeOf((*MockStore)(nil).GetTotalSearchOrderItems), ctx, arg)
+}
+
+// GetTotalSearchOrderItemsIsNotFinal mocks base method.
+func (m *MockStore) GetTotalSearchOrderItemsIsNotFinal(ctx context.Context, arg database.GetTotalSearchOrderItemsIsNotFinalParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalSearchOrderItemsIsNotFinal", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalSearchOrderItemsIsNotFinal indicates an expected call of GetTotalSearchOrderItemsIsNotFinal.
+func (mr *MockStoreMockRecorder) GetTotalSearchOrderItemsIsNotFinal(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalSearchOrderItemsIsNotFinal", reflect.TypeOf((*MockStore)(nil).GetTotalSearchOrderItemsIsNotFinal), ctx, arg)
+}
+
+// Health mocks base method.
+func (m *MockStore) Health(ctx context.Context) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health", ctx)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockStoreMockRecorder) Health(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockStore)(nil).Health), ctx)
+}
+
+// IncreaseProductStock mocks base method.
+func (m *MockStore) IncreaseProductStock(ctx context.Context, arg database.IncreaseProductStockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncreaseProductStock", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncreaseProductStock indicates an expected call of IncreaseProductStock.
+func (mr *MockStoreMockRecorder) IncreaseProductStock(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseProductStock", reflect.TypeOf((*MockStore)(nil).IncreaseProductStock), ctx, arg)
+}
+
+// IsCategoryExists mocks base method.
+func (m *MockStore) IsCategoryExists(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCategoryExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCategoryExists indicates an expected call of IsCategoryExists.
+func (mr *MockStoreMockRecorder) IsCategoryExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCategoryExists", reflect.TypeOf((*MockStore)(nil).IsCategoryExists), ctx, id)
+}
+
+// IsIngredientExists mocks base method.
+func (m *MockStore) IsIngredientExists(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIngredientExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsIngredientExists indicates an expected call of IsIngredientExists.
+func (mr *MockStoreMockRecorder) IsIngredientExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIngredientExists", reflect.TypeOf((*MockStore)(nil).IsIngredientExists), ctx, id)
+}
+
+// IsMenuVersioned mocks base method.
+func (m *MockStore) IsMenuVersioned(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMenuVersioned", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMenuVersioned indicates an expected call of IsMenuVersioned.
+func (mr *MockStoreMockRecorder) IsMenuVersioned(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMenuVersioned", reflect.TypeOf((*MockStore)(nil).IsMenuVersioned), ctx)
+}
+
+// IsOrderExist mocks base method.
+func (m *MockStore) IsOrderExist(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrderExist", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderExist indicates an expected call of IsOrderExist.
+func (mr *MockStoreMockRecorder) IsOrderExist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderExist", reflect.TypeOf((*MockStore)(nil).IsOrderExist), ctx, id)
+}
+
+// IsOrderItemsExist mocks base method.
 func (m *MockStore) IsOrderItemsExist(ctx context.Context, id int64) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOrderItemsExist", ctx, id)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "IsOrderItemsExist", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderItemsExist indicates an expected call of IsOrderItemsExist.
+func (mr *MockStoreMockRecorder) IsOrderItemsExist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderItemsExist", reflect.TypeOf((*MockStore)(nil).IsOrderItemsExist), ctx, id)
+}
+
+// IsOrderItemsNotFinal mocks base method.
+func (m *MockStore) IsOrderItemsNotFinal(ctx context.Context, orderID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrderItemsNotFinal", ctx, orderID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderItemsNotFinal indicates an expected call of IsOrderItemsNotFinal.
+func (mr *MockStoreMockRecorder) IsOrderItemsNotFinal(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderItemsNotFinal", reflect.TypeOf((*MockStore)(nil).IsOrderItemsNotFinal), ctx, orderID)
+}
+
+// IsOrderStatusExist mocks base method.
+func (m *MockStore) IsOrderStatusExist(ctx context.Context, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrderStatusExist", ctx, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderStatusExist indicates an expected call of IsOrderStatusExist.
+func (mr *MockStoreMockRecorder) IsOrderStatusExist(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderStatusExist", reflect.TypeOf((*MockStore)(nil).IsOrderStatusExist), ctx, code)
+}
+
+// IsOrderStatusFinal mocks base method.
+func (m *MockStore) IsOrderStatusFinal(ctx context.Context, code string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrderStatusFinal", ctx, code)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderStatusFinal indicates an expected call of IsOrderStatusFinal.
+func (mr *MockStoreMockRecorder) IsOrderStatusFinal(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderStatusFinal", reflect.TypeOf((*MockStore)(nil).IsOrderStatusFinal), ctx, code)
+}
+
+// IsOrderWithItemsExists mocks base method.
+func (m *MockStore) IsOrderWithItemsExists(ctx context.Context, arg database.IsOrderWithItemsExistsParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrderWithItemsExists", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrderWithItemsExists indicates an expected call of IsOrderWithItemsExists.
+func (mr *MockStoreMockRecorder) IsOrderWithItemsExists(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderWithItemsExists", reflect.TypeOf((*MockStore)(nil).IsOrderWithItemsExists), ctx, arg)
+}
+
+// IsProductExists mocks base method.
+func (m *MockStore) IsProductExists(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductExists indicates an expected call of IsProductExists.
+func (mr *MockStoreMockRecorder) IsProductExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductExists", reflect.TypeOf((*MockStore)(nil).IsProductExists), ctx, id)
+}
+
+// IsProductSoldOut mocks base method.
+func (m *MockStore) IsProductSoldOut(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductSoldOut", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductSoldOut indicates an expected call of IsProductSoldOut.
+func (mr *MockStoreMockRecorder) IsProductSoldOut(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductSoldOut", reflect.TypeOf((*MockStore)(nil).IsProductSoldOut), ctx, id)
+}
+
+// IsProductStockTracked mocks base method.
+func (m *MockStore) IsProductStockTracked(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductStockTracked", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductStockTracked indicates an expected call of IsProductStockTracked.
+func (mr *MockStoreMockRecorder) IsProductStockTracked(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductStockTracked", reflect.TypeOf((*MockStore)(nil).IsProductStockTracked), ctx, id)
+}
+
+// IsProductWithinSchedule mocks base method.
+func (m *MockStore) IsProductWithinSchedule(ctx context.Context, arg database.IsProductWithinScheduleParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProductWithinSchedule", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProductWithinSchedule indicates an expected call of IsProductWithinSchedule.
+func (mr *MockStoreMockRecorder) IsProductWithinSchedule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductWithinSchedule", reflect.TypeOf((*MockStore)(nil).IsProductWithinSchedule), ctx, arg)
+}
+
+// IsSessionExtensionModeFree mocks base method.
+func (m *MockStore) IsSessionExtensionModeFree(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionExtensionModeFree", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionExtensionModeFree indicates an expected call of IsSessionExtensionModeFree.
+func (mr *MockStoreMockRecorder) IsSessionExtensionModeFree(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionExtensionModeFree", reflect.TypeOf((*MockStore)(nil).IsSessionExtensionModeFree), ctx, id)
+}
+
+// IsSessionExtensionReasonExist mocks base method.
+func (m *MockStore) IsSessionExtensionReasonExist(ctx context.Context, id int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionExtensionReasonExist", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionExtensionReasonExist indicates an expected call of IsSessionExtensionReasonExist.
+func (mr *MockStoreMockRecorder) IsSessionExtensionReasonExist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionExtensionReasonExist", reflect.TypeOf((*MockStore)(nil).IsSessionExtensionReasonExist), ctx, id)
+}
+
+// IsTableAvailableOrReserved mocks base method.
+func (m *MockStore) IsTableAvailableOrReserved(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTableAvailableOrReserved", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTableAvailableOrReserved indicates an expected call of IsTableAvailableOrReserved.
+func (mr *MockStoreMockRecorder) IsTableAvailableOrReserved(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableAvailableOrReserved", reflect.TypeOf((*MockStore)(nil).IsTableAvailableOrReserved), ctx, id)
+}
+
+// IsTableExists mocks base method.
+func (m *MockStore) IsTableExists(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTableExists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTableExists indicates an expected call of IsTableExists.
+func (mr *MockStoreMockRecorder) IsTableExists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableExists", reflect.TypeOf((*MockStore)(nil).IsTableExists), ctx, id)
+}
+
+// IsTableSessionActive mocks base method.
+func (m *MockStore) IsTableSessionActive(ctx context.Context, sessionid pgtype.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTableSessionActive", ctx, sessionid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTableSessionActive indicates an expected call of IsTableSessionActive.
+func (mr *MockStoreMockRecorder) IsTableSessionActive(ctx, sessionid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableSessionActive", reflect.TypeOf((*MockStore)(nil).IsTableSessionActive), ctx, sessionid)
+}
+
+// IsTableSessionExists mocks base method.
+func (m *MockStore) IsTableSessionExists(ctx context.Context, sessionid pgtype.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTableSessionExists", ctx, sessionid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTableSessionExists indicates an expected call of IsTableSessionExists.
+func (mr *MockStoreMockRecorder) IsTableSessionExists(ctx, sessionid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableSessionExists", reflect.TypeOf((*MockStore)(nil).IsTableSessionExists), ctx, sessionid)
+}
+
+// ListActivePromotionsForProduct mocks base method.
+func (m *MockStore) ListActivePromotionsForProduct(ctx context.Context, arg database.ListActivePromotionsForProductParams) ([]*database.ListActivePromotionsForProductRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivePromotionsForProduct", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListActivePromotionsForProductRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivePromotionsForProduct indicates an expected call of ListActivePromotionsForProduct.
+func (mr *MockStoreMockRecorder) ListActivePromotionsForProduct(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivePromotionsForProduct", reflect.TypeOf((*MockStore)(nil).ListActivePromotionsForProduct), ctx, arg)
+}
+
+// ListAllCategory mocks base method.
+func (m *MockStore) ListAllCategory(ctx context.Context) ([]*database.ListAllCategoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllCategory", ctx)
+	ret0, _ := ret[0].([]*database.ListAllCategoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllCategory indicates an expected call of ListAllCategory.
+func (mr *MockStoreMockRecorder) ListAllCategory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllCategory", reflect.TypeOf((*MockStore)(nil).ListAllCategory), ctx)
+}
+
+// ListAvailableProductIDs mocks base method.
+func (m *MockStore) ListAvailableProductIDs(ctx context.Context, ids []int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailableProductIDs", ctx, ids)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailableProductIDs indicates an expected call of ListAvailableProductIDs.
+func (mr *MockStoreMockRecorder) ListAvailableProductIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailableProductIDs", reflect.TypeOf((*MockStore)(nil).ListAvailableProductIDs), ctx, ids)
+}
+
+// ListBillItemsByOrderID mocks base method.
+func (m *MockStore) ListBillItemsByOrderID(ctx context.Context, orderID int64) ([]*database.ListBillItemsByOrderIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillItemsByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*database.ListBillItemsByOrderIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillItemsByOrderID indicates an expected call of ListBillItemsByOrderID.
+func (mr *MockStoreMockRecorder) ListBillItemsByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillItemsByOrderID", reflect.TypeOf((*MockStore)(nil).ListBillItemsByOrderID), ctx, orderID)
+}
+
+// ListCategory mocks base method.
+func (m *MockStore) ListCategory(ctx context.Context) ([]*database.ListCategoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategory", ctx)
+	ret0, _ := ret[0].([]*database.ListCategoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategory indicates an expected call of ListCategory.
+func (mr *MockStoreMockRecorder) ListCategory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategory", reflect.TypeOf((*MockStore)(nil).ListCategory), ctx)
+}
+
+// ListComboSlotOptionsBySlotIDs mocks base method.
+func (m *MockStore) ListComboSlotOptionsBySlotIDs(ctx context.Context, slotIds []int64) ([]*database.ListComboSlotOptionsBySlotIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComboSlotOptionsBySlotIDs", ctx, slotIds)
+	ret0, _ := ret[0].([]*database.ListComboSlotOptionsBySlotIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComboSlotOptionsBySlotIDs indicates an expected call of ListComboSlotOptionsBySlotIDs.
+func (mr *MockStoreMockRecorder) ListComboSlotOptionsBySlotIDs(ctx, slotIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComboSlotOptionsBySlotIDs", reflect.TypeOf((*MockStore)(nil).ListComboSlotOptionsBySlotIDs), ctx, slotIds)
+}
+
+// ListComboSlotsByProductIDs mocks base method.
+func (m *MockStore) ListComboSlotsByProductIDs(ctx context.Context, productIds []int64) ([]*database.ListComboSlotsByProductIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComboSlotsByProductIDs", ctx, productIds)
+	ret0, _ := ret[0].([]*database.ListComboSlotsByProductIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComboSlotsByProductIDs indicates an expected call of ListComboSlotsByProductIDs.
+func (mr *MockStoreMockRecorder) ListComboSlotsByProductIDs(ctx, productIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComboSlotsByProductIDs", reflect.TypeOf((*MockStore)(nil).ListComboSlotsByProductIDs), ctx, productIds)
+}
+
+// ListDietaryTags mocks base method.
+func (m *MockStore) ListDietaryTags(ctx context.Context) ([]*database.ListDietaryTagsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDietaryTags", ctx)
+	ret0, _ := ret[0].([]*database.ListDietaryTagsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDietaryTags indicates an expected call of ListDietaryTags.
+func (mr *MockStoreMockRecorder) ListDietaryTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDietaryTags", reflect.TypeOf((*MockStore)(nil).ListDietaryTags), ctx)
+}
+
+// ListDietaryTagsByProductIDs mocks base method.
+func (m *MockStore) ListDietaryTagsByProductIDs(ctx context.Context, productIds []int64) ([]*database.ListDietaryTagsByProductIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDietaryTagsByProductIDs", ctx, productIds)
+	ret0, _ := ret[0].([]*database.ListDietaryTagsByProductIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDietaryTagsByProductIDs indicates an expected call of ListDietaryTagsByProductIDs.
+func (mr *MockStoreMockRecorder) ListDietaryTagsByProductIDs(ctx, productIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDietaryTagsByProductIDs", reflect.TypeOf((*MockStore)(nil).ListDietaryTagsByProductIDs), ctx, productIds)
+}
+
+// ListIngredientMovementsByOrderItemID mocks base method.
+func (m *MockStore) ListIngredientMovementsByOrderItemID(ctx context.Context, orderItemID int64) ([]*database.ListIngredientMovementsByOrderItemIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIngredientMovementsByOrderItemID", ctx, orderItemID)
+	ret0, _ := ret[0].([]*database.ListIngredientMovementsByOrderItemIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIngredientMovementsByOrderItemID indicates an expected call of ListIngredientMovementsByOrderItemID.
+func (mr *MockStoreMockRecorder) ListIngredientMovementsByOrderItemID(ctx, orderItemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIngredientMovementsByOrderItemID", reflect.TypeOf((*MockStore)(nil).ListIngredientMovementsByOrderItemID), ctx, orderItemID)
+}
+
+// ListIngredients mocks base method.
+func (m *MockStore) ListIngredients(ctx context.Context) ([]*database.ListIngredientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIngredients", ctx)
+	ret0, _ := ret[0].([]*database.ListIngredientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIngredients indicates an expected call of ListIngredients.
+func (mr *MockStoreMockRecorder) ListIngredients(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIngredients", reflect.TypeOf((*MockStore)(nil).ListIngredients), ctx)
+}
+
+// ListLowStockIngredients mocks base method.
+func (m *MockStore) ListLowStockIngredients(ctx context.Context) ([]*database.ListLowStockIngredientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLowStockIngredients", ctx)
+	ret0, _ := ret[0].([]*database.ListLowStockIngredientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLowStockIngredients indicates an expected call of ListLowStockIngredients.
+func (mr *MockStoreMockRecorder) ListLowStockIngredients(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLowStockIngredients", reflect.TypeOf((*MockStore)(nil).ListLowStockIngredients), ctx)
+}
+
+// ListMenuSchedulesByCategoryID mocks base method.
+func (m *MockStore) ListMenuSchedulesByCategoryID(ctx context.Context, categoryID int64) ([]*database.ListMenuSchedulesByCategoryIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuSchedulesByCategoryID", ctx, categoryID)
+	ret0, _ := ret[0].([]*database.ListMenuSchedulesByCategoryIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuSchedulesByCategoryID indicates an expected call of ListMenuSchedulesByCategoryID.
+func (mr *MockStoreMockRecorder) ListMenuSchedulesByCategoryID(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuSchedulesByCategoryID", reflect.TypeOf((*MockStore)(nil).ListMenuSchedulesByCategoryID), ctx, categoryID)
+}
+
+// ListMenuSchedulesByProductID mocks base method.
+func (m *MockStore) ListMenuSchedulesByProductID(ctx context.Context, productID int64) ([]*database.ListMenuSchedulesByProductIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuSchedulesByProductID", ctx, productID)
+	ret0, _ := ret[0].([]*database.ListMenuSchedulesByProductIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuSchedulesByProductID indicates an expected call of ListMenuSchedulesByProductID.
+func (mr *MockStoreMockRecorder) ListMenuSchedulesByProductID(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuSchedulesByProductID", reflect.TypeOf((*MockStore)(nil).ListMenuSchedulesByProductID), ctx, productID)
+}
+
+// ListMenuVersions mocks base method.
+func (m *MockStore) ListMenuVersions(ctx context.Context) ([]*database.ListMenuVersionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMenuVersions", ctx)
+	ret0, _ := ret[0].([]*database.ListMenuVersionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMenuVersions indicates an expected call of ListMenuVersions.
+func (mr *MockStoreMockRecorder) ListMenuVersions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMenuVersions", reflect.TypeOf((*MockStore)(nil).ListMenuVersions), ctx)
+}
+
+// ListModifierGroupsByProductIDs mocks base method.
+func (m *MockStore) ListModifierGroupsByProductIDs(ctx context.Context, productIds []int64) ([]*database.ListModifierGroupsByProductIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModifierGroupsByProductIDs", ctx, productIds)
+	ret0, _ := ret[0].([]*database.ListModifierGroupsByProductIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModifierGroupsByProductIDs indicates an expected call of ListModifierGroupsByProductIDs.
+func (mr *MockStoreMockRecorder) ListModifierGroupsByProductIDs(ctx, productIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModifierGroupsByProductIDs", reflect.TypeOf((*MockStore)(nil).ListModifierGroupsByProductIDs), ctx, productIds)
+}
+
+// ListModifiersByGroupIDs mocks base method.
+func (m *MockStore) ListModifiersByGroupIDs(ctx context.Context, groupIds []int64) ([]*database.ListModifiersByGroupIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModifiersByGroupIDs", ctx, groupIds)
+	ret0, _ := ret[0].([]*database.ListModifiersByGroupIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModifiersByGroupIDs indicates an expected call of ListModifiersByGroupIDs.
+func (mr *MockStoreMockRecorder) ListModifiersByGroupIDs(ctx, groupIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModifiersByGroupIDs", reflect.TypeOf((*MockStore)(nil).ListModifiersByGroupIDs), ctx, groupIds)
+}
+
+// ListOrderStatus mocks base method.
+func (m *MockStore) ListOrderStatus(ctx context.Context) ([]*database.ListOrderStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrderStatus", ctx)
+	ret0, _ := ret[0].([]*database.ListOrderStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrderStatus indicates an expected call of ListOrderStatus.
+func (mr *MockStoreMockRecorder) ListOrderStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrderStatus", reflect.TypeOf((*MockStore)(nil).ListOrderStatus), ctx)
+}
+
+// ListOutboxEventsByKey mocks base method.
+func (m *MockStore) ListOutboxEventsByKey(ctx context.Context, arg database.ListOutboxEventsByKeyParams) ([]*database.ListOutboxEventsByKeyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutboxEventsByKey", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListOutboxEventsByKeyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutboxEventsByKey indicates an expected call of ListOutboxEventsByKey.
+func (mr *MockStoreMockRecorder) ListOutboxEventsByKey(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutboxEventsByKey", reflect.TypeOf((*MockStore)(nil).ListOutboxEventsByKey), ctx, arg)
+}
+
+// ListPaymentMethods mocks base method.
+func (m *MockStore) ListPaymentMethods(ctx context.Context) ([]*database.ListPaymentMethodsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentMethods", ctx)
+	ret0, _ := ret[0].([]*database.ListPaymentMethodsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentMethods indicates an expected call of ListPaymentMethods.
+func (mr *MockStoreMockRecorder) ListPaymentMethods(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentMethods", reflect.TypeOf((*MockStore)(nil).ListPaymentMethods), ctx)
+}
+
+// ListProductIngredients mocks base method.
+func (m *MockStore) ListProductIngredients(ctx context.Context, productID int64) ([]*database.ListProductIngredientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductIngredients", ctx, productID)
+	ret0, _ := ret[0].([]*database.ListProductIngredientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductIngredients indicates an expected call of ListProductIngredients.
+func (mr *MockStoreMockRecorder) ListProductIngredients(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductIngredients", reflect.TypeOf((*MockStore)(nil).ListProductIngredients), ctx, productID)
+}
+
+// ListProductIngredientsForUpdate mocks base method.
+func (m *MockStore) ListProductIngredientsForUpdate(ctx context.Context, arg database.ListProductIngredientsForUpdateParams) ([]*database.ListProductIngredientsForUpdateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductIngredientsForUpdate", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListProductIngredientsForUpdateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductIngredientsForUpdate indicates an expected call of ListProductIngredientsForUpdate.
+func (mr *MockStoreMockRecorder) ListProductIngredientsForUpdate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductIngredientsForUpdate", reflect.TypeOf((*MockStore)(nil).ListProductIngredientsForUpdate), ctx, arg)
+}
+
+// ListProductPrices mocks base method.
+func (m *MockStore) ListProductPrices(ctx context.Context, productID int64) ([]*database.ListProductPricesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductPrices", ctx, productID)
+	ret0, _ := ret[0].([]*database.ListProductPricesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductPrices indicates an expected call of ListProductPrices.
+func (mr *MockStoreMockRecorder) ListProductPrices(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductPrices", reflect.TypeOf((*MockStore)(nil).ListProductPrices), ctx, productID)
+}
+
+// ListProductSearchKeywords mocks base method.
+func (m *MockStore) ListProductSearchKeywords(ctx context.Context, productID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductSearchKeywords", ctx, productID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductSearchKeywords indicates an expected call of ListProductSearchKeywords.
+func (mr *MockStoreMockRecorder) ListProductSearchKeywords(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductSearchKeywords", reflect.TypeOf((*MockStore)(nil).ListProductSearchKeywords), ctx, productID)
+}
+
+// ListProductTimeExtension mocks base method.
+func (m *MockStore) ListProductTimeExtension(ctx context.Context) ([]*database.ListProductTimeExtensionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTimeExtension", ctx)
+	ret0, _ := ret[0].([]*database.ListProductTimeExtensionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTimeExtension indicates an expected call of ListProductTimeExtension.
+func (mr *MockStoreMockRecorder) ListProductTimeExtension(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTimeExtension", reflect.TypeOf((*MockStore)(nil).ListProductTimeExtension), ctx)
+}
+
+// ListProductsForMenuSnapshot mocks base method.
+func (m *MockStore) ListProductsForMenuSnapshot(ctx context.Context) ([]*database.ListProductsForMenuSnapshotRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductsForMenuSnapshot", ctx)
+	ret0, _ := ret[0].([]*database.ListProductsForMenuSnapshotRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductsForMenuSnapshot indicates an expected call of ListProductsForMenuSnapshot.
+func (mr *MockStoreMockRecorder) ListProductsForMenuSnapshot(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductsForMenuSnapshot", reflect.TypeOf((*MockStore)(nil).ListProductsForMenuSnapshot), ctx)
+}
+
+// ListPromotions mocks base method.
+func (m *MockStore) ListPromotions(ctx context.Context) ([]*database.ListPromotionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromotions", ctx)
+	ret0, _ := ret[0].([]*database.ListPromotionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromotions indicates an expected call of ListPromotions.
+func (mr *MockStoreMockRecorder) ListPromotions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotions", reflect.TypeOf((*MockStore)(nil).ListPromotions), ctx)
+}
+
+// ListPublishedProducts mocks base method.
+func (m *MockStore) ListPublishedProducts(ctx context.Context, arg database.ListPublishedProductsParams) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublishedProducts", ctx, arg)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublishedProducts indicates an expected call of ListPublishedProducts.
+func (mr *MockStoreMockRecorder) ListPublishedProducts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedProducts", reflect.TypeOf((*MockStore)(nil).ListPublishedProducts), ctx, arg)
+}
+
+// ListRecommendedProducts mocks base method.
+func (m *MockStore) ListRecommendedProducts(ctx context.Context, arg database.ListRecommendedProductsParams) ([]*database.ListRecommendedProductsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecommendedProducts", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListRecommendedProductsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecommendedProducts indicates an expected call of ListRecommendedProducts.
+func (mr *MockStoreMockRecorder) ListRecommendedProducts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecommendedProducts", reflect.TypeOf((*MockStore)(nil).ListRecommendedProducts), ctx, arg)
+}
+
+// ListSearchAliases mocks base method.
+func (m *MockStore) ListSearchAliases(ctx context.Context) ([]*database.ListSearchAliasesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSearchAliases", ctx)
+	ret0, _ := ret[0].([]*database.ListSearchAliasesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSearchAliases indicates an expected call of ListSearchAliases.
+func (mr *MockStoreMockRecorder) ListSearchAliases(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSearchAliases", reflect.TypeOf((*MockStore)(nil).ListSearchAliases), ctx)
+}
+
+// ListSessionExtensionReason mocks base method.
+func (m *MockStore) ListSessionExtensionReason(ctx context.Context) ([]*database.ListSessionExtensionReasonRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionExtensionReason", ctx)
+	ret0, _ := ret[0].([]*database.ListSessionExtensionReasonRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionExtensionReason indicates an expected call of ListSessionExtensionReason.
+func (mr *MockStoreMockRecorder) ListSessionExtensionReason(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionExtensionReason", reflect.TypeOf((*MockStore)(nil).ListSessionExtensionReason), ctx)
+}
+
+// ListSessionOrderedProductIDs mocks base method.
+func (m *MockStore) ListSessionOrderedProductIDs(ctx context.Context, sessionID pgtype.UUID) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessionOrderedProductIDs", ctx, sessionID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessionOrderedProductIDs indicates an expected call of ListSessionOrderedProductIDs.
+func (mr *MockStoreMockRecorder) ListSessionOrderedProductIDs(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionOrderedProductIDs", reflect.TypeOf((*MockStore)(nil).ListSessionOrderedProductIDs), ctx, sessionID)
+}
+
+// ListTableServiceRequests mocks base method.
+func (m *MockStore) ListTableServiceRequests(ctx context.Context, arg database.ListTableServiceRequestsParams) ([]*database.ListTableServiceRequestsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableServiceRequests", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListTableServiceRequestsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableServiceRequests indicates an expected call of ListTableServiceRequests.
+func (mr *MockStoreMockRecorder) ListTableServiceRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableServiceRequests", reflect.TypeOf((*MockStore)(nil).ListTableServiceRequests), ctx, arg)
+}
+
+// ListTableStatus mocks base method.
+func (m *MockStore) ListTableStatus(ctx context.Context) ([]*database.ListTableStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTableStatus", ctx)
+	ret0, _ := ret[0].([]*database.ListTableStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTableStatus indicates an expected call of ListTableStatus.
+func (mr *MockStoreMockRecorder) ListTableStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableStatus", reflect.TypeOf((*MockStore)(nil).ListTableStatus), ctx)
+}
+
+// ListTranslationLocales mocks base method.
+func (m *MockStore) ListTranslationLocales(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTranslationLocales", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTranslationLocales indicates an expected call of ListTranslationLocales.
+func (mr *MockStoreMockRecorder) ListTranslationLocales(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTranslationLocales", reflect.TypeOf((*MockStore)(nil).ListTranslationLocales), ctx)
+}
+
+// ListTranslations mocks base method.
+func (m *MockStore) ListTranslations(ctx context.Context, arg database.ListTranslationsParams) ([]*database.ListTranslationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTranslations", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListTranslationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTranslations indicates an expected call of ListTranslations.
+func (mr *MockStoreMockRecorder) ListTranslations(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTranslations", reflect.TypeOf((*MockStore)(nil).ListTranslations), ctx, arg)
+}
+
+// ListTranslationsByEntity mocks base method.
+func (m *MockStore) ListTranslationsByEntity(ctx context.Context, arg database.ListTranslationsByEntityParams) ([]*database.ListTranslationsByEntityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTranslationsByEntity", ctx, arg)
+	ret0, _ := ret[0].([]*database.ListTranslationsByEntityRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsOrderItemsExist indicates an expected call of IsOrderItemsExist.
-func (mr *MockStoreMockRecorder) IsOrderItemsExist(ctx, id any) *gomock.Call {
+// ListTranslationsByEntity indicates an expected call of ListTranslationsByEntity.
+func (mr *MockStoreMockRecorder) ListTranslationsByEntity(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderItemsExist", reflect.TypeOf((*MockStore)(nil).IsOrderItemsExist), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTranslationsByEntity", reflect.TypeOf((*MockStore)(nil).ListTranslationsByEntity), ctx, arg)
 }
 
-// IsOrderItemsNotFinal mocks base method.
-func (m *MockStore) IsOrderItemsNotFinal(ctx context.Context, orderID int64) (bool, error) {
+// ListVariantsByProductIDs mocks base method.
+func (m *MockStore) ListVariantsByProductIDs(ctx context.Context, productIds []int64) ([]*database.ListVariantsByProductIDsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOrderItemsNotFinal", ctx, orderID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ListVariantsByProductIDs", ctx, productIds)
+	ret0, _ := ret[0].([]*database.ListVariantsByProductIDsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsOrderItemsNotFinal indicates an expected call of IsOrderItemsNotFinal.
-func (mr *MockStoreMockRecorder) IsOrderItemsNotFinal(ctx, orderID any) *gomock.Call {
+// ListVariantsByProductIDs indicates an expected call of ListVariantsByProductIDs.
+func (mr *MockStoreMockRecorder) ListVariantsByProductIDs(ctx, productIds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderItemsNotFinal", reflect.TypeOf((*MockStore)(nil).IsOrderItemsNotFinal), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariantsByProductIDs", reflect.TypeOf((*MockStore)(nil).ListVariantsByProductIDs), ctx, productIds)
 }
 
-// IsOrderStatusExist mocks base method.
-func (m *MockStore) IsOrderStatusExist(ctx context.Context, code string) (bool, error) {
+// MarkOutboxEventFailed mocks base method.
+func (m *MockStore) MarkOutboxEventFailed(ctx context.Context, arg database.MarkOutboxEventFailedParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOrderStatusExist", ctx, code)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "MarkOutboxEventFailed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventFailed indicates an expected call of MarkOutboxEventFailed.
+func (mr *MockStoreMockRecorder) MarkOutboxEventFailed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventFailed", reflect.TypeOf((*MockStore)(nil).MarkOutboxEventFailed), ctx, arg)
+}
+
+// MarkOutboxEventSent mocks base method.
+func (m *MockStore) MarkOutboxEventSent(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventSent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventSent indicates an expected call of MarkOutboxEventSent.
+func (mr *MockStoreMockRecorder) MarkOutboxEventSent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventSent", reflect.TypeOf((*MockStore)(nil).MarkOutboxEventSent), ctx, id)
+}
+
+// NextOutboxEventSeq mocks base method.
+func (m *MockStore) NextOutboxEventSeq(ctx context.Context, arg database.NextOutboxEventSeqParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextOutboxEventSeq", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsOrderStatusExist indicates an expected call of IsOrderStatusExist.
-func (mr *MockStoreMockRecorder) IsOrderStatusExist(ctx, code any) *gomock.Call {
+// NextOutboxEventSeq indicates an expected call of NextOutboxEventSeq.
+func (mr *MockStoreMockRecorder) NextOutboxEventSeq(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderStatusExist", reflect.TypeOf((*MockStore)(nil).IsOrderStatusExist), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextOutboxEventSeq", reflect.TypeOf((*MockStore)(nil).NextOutboxEventSeq), ctx, arg)
 }
 
-// IsOrderStatusFinal mocks base method.
-func (m *MockStore) IsOrderStatusFinal(ctx context.Context, code string) (bool, error) {
+// QuickSearchTables mocks base method.
+func (m *MockStore) QuickSearchTables(ctx context.Context, arg database.QuickSearchTablesParams) ([]*database.QuickSearchTablesRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOrderStatusFinal", ctx, code)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "QuickSearchTables", ctx, arg)
+	ret0, _ := ret[0].([]*database.QuickSearchTablesRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsOrderStatusFinal indicates an expected call of IsOrderStatusFinal.
-func (mr *MockStoreMockRecorder) IsOrderStatusFinal(ctx, code any) *gomock.Call {
+// QuickSearchTables indicates an expected call of QuickSearchTables.
+func (mr *MockStoreMockRecorder) QuickSearchTables(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderStatusFinal", reflect.TypeOf((*MockStore)(nil).IsOrderStatusFinal), ctx, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuickSearchTables", reflect.TypeOf((*MockStore)(nil).QuickSearchTables), ctx, arg)
 }
 
-// IsOrderWithItemsExists mocks base method.
-func (m *MockStore) IsOrderWithItemsExists(ctx context.Context, arg database.IsOrderWithItemsExistsParams) (bool, error) {
+// RecordProductPriceChange mocks base method.
+func (m *MockStore) RecordProductPriceChange(ctx context.Context, arg database.RecordProductPriceChangeParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOrderWithItemsExists", ctx, arg)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "RecordProductPriceChange", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordProductPriceChange indicates an expected call of RecordProductPriceChange.
+func (mr *MockStoreMockRecorder) RecordProductPriceChange(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordProductPriceChange", reflect.TypeOf((*MockStore)(nil).RecordProductPriceChange), ctx, arg)
+}
+
+// RefreshProductAssociations mocks base method.
+func (m *MockStore) RefreshProductAssociations(ctx context.Context, arg database.RefreshProductAssociationsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshProductAssociations", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsOrderWithItemsExists indicates an expected call of IsOrderWithItemsExists.
-func (mr *MockStoreMockRecorder) IsOrderWithItemsExists(ctx, arg any) *gomock.Call {
+// RefreshProductAssociations indicates an expected call of RefreshProductAssociations.
+func (mr *MockStoreMockRecorder) RefreshProductAssociations(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrderWithItemsExists", reflect.TypeOf((*MockStore)(nil).IsOrderWithItemsExists), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshProductAssociations", reflect.TypeOf((*MockStore)(nil).RefreshProductAssociations), ctx, arg)
 }
 
-// IsProductExists mocks base method.
-func (m *MockStore) IsProductExists(ctx context.Context, id int64) (bool, error) {
+// SearchOrderItems mocks base method.
+func (m *MockStore) SearchOrderItems(ctx context.Context, arg database.SearchOrderItemsParams) ([]*database.SearchOrderItemsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsProductExists", ctx, id)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "SearchOrderItems", ctx, arg)
+	ret0, _ := ret[0].([]*database.SearchOrderItemsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsProductExists indicates an expected call of IsProductExists.
-func (mr *MockStoreMockRecorder) IsProductExists(ctx, id any) *gomock.Call {
+// SearchOrderItems indicates an expected call of SearchOrderItems.
+func (mr *MockStoreMockRecorder) SearchOrderItems(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProductExists", reflect.TypeOf((*MockStore)(nil).IsProductExists), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrderItems", reflect.TypeOf((*MockStore)(nil).SearchOrderItems), ctx, arg)
 }
 
-// IsSessionExtensionModeFree mocks base method.
-func (m *MockStore) IsSessionExtensionModeFree(ctx context.Context, id int64) (bool, error) {
+// SearchOrderItemsIsNotFinal mocks base method.
+func (m *MockStore) SearchOrderItemsIsNotFinal(ctx context.Context, arg database.SearchOrderItemsIsNotFinalParams) ([]*database.SearchOrderItemsIsNotFinalRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsSessionExtensionModeFree", ctx, id)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "SearchOrderItemsIsNotFinal", ctx, arg)
+	ret0, _ := ret[0].([]*database.SearchOrderItemsIsNotFinalRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsSessionExtensionModeFree indicates an expected call of IsSessionExtensionModeFree.
-func (mr *MockStoreMockRecorder) IsSessionExtensionModeFree(ctx, id any) *gomock.Call {
+// SearchOrderItemsIsNotFinal indicates an expected call of SearchOrderItemsIsNotFinal.
+func (mr *MockStoreMockRecorder) SearchOrderItemsIsNotFinal(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionExtensionModeFree", reflect.TypeOf((*MockStore)(nil).IsSessionExtensionModeFree), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrderItemsIsNotFinal", reflect.TypeOf((*MockStore)(nil).SearchOrderItemsIsNotFinal), ctx, arg)
 }
 
-// IsSessionExtensionReasonExist mocks base method.
-func (m *MockStore) IsSessionExtensionReasonExist(ctx context.Context, id int64) (int64, error) {
+// SearchProducts mocks base method.
+func (m *MockStore) SearchProducts(ctx context.Context, arg database.SearchProductsParams) ([]*database.SearchProductsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsSessionExtensionReasonExist", ctx, id)
+	ret := m.ctrl.Call(m, "SearchProducts", ctx, arg)
+	ret0, _ := ret[0].([]*database.SearchProductsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProducts indicates an expected call of SearchProducts.
+func (mr *MockStoreMockRecorder) SearchProducts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProducts", reflect.TypeOf((*MockStore)(nil).SearchProducts), ctx, arg)
+}
+
+// SearchTables mocks base method.
+func (m *MockStore) SearchTables(ctx context.Context, arg database.SearchTablesParams) ([]*database.SearchTablesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTables", ctx, arg)
+	ret0, _ := ret[0].([]*database.SearchTablesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTables indicates an expected call of SearchTables.
+func (mr *MockStoreMockRecorder) SearchTables(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTables", reflect.TypeOf((*MockStore)(nil).SearchTables), ctx, arg)
+}
+
+// TXAmendOrderItem mocks base method.
+func (m *MockStore) TXAmendOrderItem(ctx context.Context, arg database.TXAmendOrderItemParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXAmendOrderItem", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXAmendOrderItem indicates an expected call of TXAmendOrderItem.
+func (mr *MockStoreMockRecorder) TXAmendOrderItem(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXAmendOrderItem", reflect.TypeOf((*MockStore)(nil).TXAmendOrderItem), ctx, arg)
+}
+
+// TXCallbackPayment mocks base method.
+func (m *MockStore) TXCallbackPayment(ctx context.Context, arg database.TXCallbackPaymentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCallbackPayment", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXCallbackPayment indicates an expected call of TXCallbackPayment.
+func (mr *MockStoreMockRecorder) TXCallbackPayment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCallbackPayment", reflect.TypeOf((*MockStore)(nil).TXCallbackPayment), ctx, arg)
+}
+
+// TXCreateIngredientMovement mocks base method.
+func (m *MockStore) TXCreateIngredientMovement(ctx context.Context, arg database.CreateIngredientMovementParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCreateIngredientMovement", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXCreateIngredientMovement indicates an expected call of TXCreateIngredientMovement.
+func (mr *MockStoreMockRecorder) TXCreateIngredientMovement(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateIngredientMovement", reflect.TypeOf((*MockStore)(nil).TXCreateIngredientMovement), ctx, arg)
+}
+
+// TXCreateOrder mocks base method.
+func (m *MockStore) TXCreateOrder(ctx context.Context, arg database.TXCreateOrderParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCreateOrder", ctx, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsSessionExtensionReasonExist indicates an expected call of IsSessionExtensionReasonExist.
-func (mr *MockStoreMockRecorder) IsSessionExtensionReasonExist(ctx, id any) *gomock.Call {
+// TXCreateOrder indicates an expected call of TXCreateOrder.
+func (mr *MockStoreMockRecorder) TXCreateOrder(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionExtensionReasonExist", reflect.TypeOf((*MockStore)(nil).IsSessionExtensionReasonExist), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateOrder", reflect.TypeOf((*MockStore)(nil).TXCreateOrder), ctx, arg)
 }
 
-// IsTableAvailableOrReserved mocks base method.
-func (m *MockStore) IsTableAvailableOrReserved(ctx context.Context, id int64) (bool, error) {
+// TXCreateOrderItems mocks base method.
+func (m *MockStore) TXCreateOrderItems(ctx context.Context, arg database.TXCreateOrderItemsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsTableAvailableOrReserved", ctx, id)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "TXCreateOrderItems", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXCreateOrderItems indicates an expected call of TXCreateOrderItems.
+func (mr *MockStoreMockRecorder) TXCreateOrderItems(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateOrderItems", reflect.TypeOf((*MockStore)(nil).TXCreateOrderItems), ctx, arg)
+}
+
+// TXCreatePayment mocks base method.
+func (m *MockStore) TXCreatePayment(ctx context.Context, arg database.CreatePaymentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCreatePayment", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXCreatePayment indicates an expected call of TXCreatePayment.
+func (mr *MockStoreMockRecorder) TXCreatePayment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreatePayment", reflect.TypeOf((*MockStore)(nil).TXCreatePayment), ctx, arg)
+}
+
+// TXCreateProduct mocks base method.
+func (m *MockStore) TXCreateProduct(ctx context.Context, arg database.TXCreateProductParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCreateProduct", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TXCreateProduct indicates an expected call of TXCreateProduct.
+func (mr *MockStoreMockRecorder) TXCreateProduct(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateProduct", reflect.TypeOf((*MockStore)(nil).TXCreateProduct), ctx, arg)
+}
+
+// TXCreateTableServiceRequest mocks base method.
+func (m *MockStore) TXCreateTableServiceRequest(ctx context.Context, arg database.TXCreateTableServiceRequestParams) (*model.TableServiceRequest, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCreateTableServiceRequest", ctx, arg)
+	ret0, _ := ret[0].(*model.TableServiceRequest)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TXCreateTableServiceRequest indicates an expected call of TXCreateTableServiceRequest.
+func (mr *MockStoreMockRecorder) TXCreateTableServiceRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateTableServiceRequest", reflect.TypeOf((*MockStore)(nil).TXCreateTableServiceRequest), ctx, arg)
+}
+
+// TXCreateTableSession mocks base method.
+func (m *MockStore) TXCreateTableSession(ctx context.Context, arg database.CreateTableSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXCreateTableSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXCreateTableSession indicates an expected call of TXCreateTableSession.
+func (mr *MockStoreMockRecorder) TXCreateTableSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateTableSession", reflect.TypeOf((*MockStore)(nil).TXCreateTableSession), ctx, arg)
+}
+
+// TXPublishMenuVersion mocks base method.
+func (m *MockStore) TXPublishMenuVersion(ctx context.Context, arg database.TXPublishMenuVersionParams) (*database.CreateMenuVersionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXPublishMenuVersion", ctx, arg)
+	ret0, _ := ret[0].(*database.CreateMenuVersionRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsTableAvailableOrReserved indicates an expected call of IsTableAvailableOrReserved.
-func (mr *MockStoreMockRecorder) IsTableAvailableOrReserved(ctx, id any) *gomock.Call {
+// TXPublishMenuVersion indicates an expected call of TXPublishMenuVersion.
+func (mr *MockStoreMockRecorder) TXPublishMenuVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXPublishMenuVersion", reflect.TypeOf((*MockStore)(nil).TXPublishMenuVersion), ctx, arg)
+}
+
+// TXRefreshProductAssociations mocks base method.
+func (m *MockStore) TXRefreshProductAssociations(ctx context.Context, arg database.RefreshProductAssociationsParams) (int64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXRefreshProductAssociations", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TXRefreshProductAssociations indicates an expected call of TXRefreshProductAssociations.
+func (mr *MockStoreMockRecorder) TXRefreshProductAssociations(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXRefreshProductAssociations", reflect.TypeOf((*MockStore)(nil).TXRefreshProductAssociations), ctx, arg)
+}
+
+// TXReplaceComboSlots mocks base method.
+func (m *MockStore) TXReplaceComboSlots(ctx context.Context, arg database.TXReplaceComboSlotsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXReplaceComboSlots", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXReplaceComboSlots indicates an expected call of TXReplaceComboSlots.
+func (mr *MockStoreMockRecorder) TXReplaceComboSlots(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceComboSlots", reflect.TypeOf((*MockStore)(nil).TXReplaceComboSlots), ctx, arg)
+}
+
+// TXReplaceMenuSchedules mocks base method.
+func (m *MockStore) TXReplaceMenuSchedules(ctx context.Context, arg database.TXReplaceMenuSchedulesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXReplaceMenuSchedules", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXReplaceMenuSchedules indicates an expected call of TXReplaceMenuSchedules.
+func (mr *MockStoreMockRecorder) TXReplaceMenuSchedules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceMenuSchedules", reflect.TypeOf((*MockStore)(nil).TXReplaceMenuSchedules), ctx, arg)
+}
+
+// TXReplaceModifierGroups mocks base method.
+func (m *MockStore) TXReplaceModifierGroups(ctx context.Context, arg database.TXReplaceModifierGroupsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXReplaceModifierGroups", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXReplaceModifierGroups indicates an expected call of TXReplaceModifierGroups.
+func (mr *MockStoreMockRecorder) TXReplaceModifierGroups(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceModifierGroups", reflect.TypeOf((*MockStore)(nil).TXReplaceModifierGroups), ctx, arg)
+}
+
+// TXReplaceProductDietaryTags mocks base method.
+func (m *MockStore) TXReplaceProductDietaryTags(ctx context.Context, arg database.CreateProductDietaryTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXReplaceProductDietaryTags", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXReplaceProductDietaryTags indicates an expected call of TXReplaceProductDietaryTags.
+func (mr *MockStoreMockRecorder) TXReplaceProductDietaryTags(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceProductDietaryTags", reflect.TypeOf((*MockStore)(nil).TXReplaceProductDietaryTags), ctx, arg)
+}
+
+// TXReplaceProductIngredients mocks base method.
+func (m *MockStore) TXReplaceProductIngredients(ctx context.Context, arg database.TXReplaceProductIngredientsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TXReplaceProductIngredients", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TXReplaceProductIngredients indicates an expected call of TXReplaceProductIngredients.
+func (mr *MockStoreMockRecorder) TXReplaceProductIngredients(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableAvailableOrReserved", reflect.TypeOf((*MockStore)(nil).IsTableAvailableOrReserved), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceProductIngredients", reflect.TypeOf((*MockStore)(nil).TXReplaceProductIngredients), ctx, arg)
 }
 
-// IsTableExists mocks base method.
-func (m *MockStore) IsTableExists(ctx context.Context, id int64) (bool, error) {
+// TXReplaceProductSearchKeywords mocks base method.
+func (m *MockStore) TXReplaceProductSearchKeywords(ctx context.Context, arg database.TXReplaceProductSearchKeywordsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsTableExists", ctx, id)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXReplaceProductSearchKeywords", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// IsTableExists indicates an expected call of IsTableExists.
-func (mr *MockStoreMockRecorder) IsTableExists(ctx, id any) *gomock.Call {
+// TXReplaceProductSearchKeywords indicates an expected call of TXReplaceProductSearchKeywords.
+func (mr *MockStoreMockRecorder) TXReplaceProductSearchKeywords(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableExists", reflect.TypeOf((*MockStore)(nil).IsTableExists), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceProductSearchKeywords", reflect.TypeOf((*MockStore)(nil).TXReplaceProductSearchKeywords), ctx, arg)
 }
 
-// IsTableSessionActive mocks base method.
-func (m *MockStore) IsTableSessionActive(ctx context.Context, sessionid pgtype.UUID) (bool, error) {
+// TXReplaceTranslations mocks base method.
+func (m *MockStore) TXReplaceTranslations(ctx context.Context, arg database.TXReplaceTranslationsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsTableSessionActive", ctx, sessionid)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXReplaceTranslations", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// IsTableSessionActive indicates an expected call of IsTableSessionActive.
-func (mr *MockStoreMockRecorder) IsTableSessionActive(ctx, sessionid any) *gomock.Call {
+// TXReplaceTranslations indicates an expected call of TXReplaceTranslations.
+func (mr *MockStoreMockRecorder) TXReplaceTranslations(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableSessionActive", reflect.TypeOf((*MockStore)(nil).IsTableSessionActive), ctx, sessionid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceTranslations", reflect.TypeOf((*MockStore)(nil).TXReplaceTranslations), ctx, arg)
 }
 
-// IsTableSessionExists mocks base method.
-func (m *MockStore) IsTableSessionExists(ctx context.Context, sessionid pgtype.UUID) (bool, error) {
+// TXReplaceVariants mocks base method.
+func (m *MockStore) TXReplaceVariants(ctx context.Context, arg database.TXReplaceVariantsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsTableSessionExists", ctx, sessionid)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXReplaceVariants", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// IsTableSessionExists indicates an expected call of IsTableSessionExists.
-func (mr *MockStoreMockRecorder) IsTableSessionExists(ctx, sessionid any) *gomock.Call {
+// TXReplaceVariants indicates an expected call of TXReplaceVariants.
+func (mr *MockStoreMockRecorder) TXReplaceVariants(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTableSessionExists", reflect.TypeOf((*MockStore)(nil).IsTableSessionExists), ctx, sessionid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXReplaceVariants", reflect.TypeOf((*MockStore)(nil).TXReplaceVariants), ctx, arg)
 }
 
-// ListCategory mocks base method.
-func (m *MockStore) ListCategory(ctx context.Context) ([]*database.ListCategoryRow, error) {
+// TXSessionsExtension mocks base method.
+func (m *MockStore) TXSessionsExtension(ctx context.Context, arg database.TXSessionsExtensionParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCategory", ctx)
-	ret0, _ := ret[0].([]*database.ListCategoryRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXSessionsExtension", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListCategory indicates an expected call of ListCategory.
-func (mr *MockStoreMockRecorder) ListCategory(ctx any) *gomock.Call {
+// TXSessionsExtension indicates an expected call of TXSessionsExtension.
+func (mr *MockStoreMockRecorder) TXSessionsExtension(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategory", reflect.TypeOf((*MockStore)(nil).ListCategory), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXSessionsExtension", reflect.TypeOf((*MockStore)(nil).TXSessionsExtension), ctx, arg)
 }
 
-// ListOrderStatus mocks base method.
-func (m *MockStore) ListOrderStatus(ctx context.Context) ([]*database.ListOrderStatusRow, error) {
+// TXUpdateCategorySortOrder mocks base method.
+func (m *MockStore) TXUpdateCategorySortOrder(ctx context.Context, arg database.TXUpdateCategorySortOrderParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOrderStatus", ctx)
-	ret0, _ := ret[0].([]*database.ListOrderStatusRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXUpdateCategorySortOrder", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListOrderStatus indicates an expected call of ListOrderStatus.
-func (mr *MockStoreMockRecorder) ListOrderStatus(ctx any) *gomock.Call {
+// TXUpdateCategorySortOrder indicates an expected call of TXUpdateCategorySortOrder.
+func (mr *MockStoreMockRecorder) TXUpdateCategorySortOrder(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrderStatus", reflect.TypeOf((*MockStore)(nil).ListOrderStatus), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXUpdateCategorySortOrder", reflect.TypeOf((*MockStore)(nil).TXUpdateCategorySortOrder), ctx, arg)
 }
 
-// ListPaymentMethods mocks base method.
-func (m *MockStore) ListPaymentMethods(ctx context.Context) ([]*database.ListPaymentMethodsRow, error) {
+// TXUpdateOrderItemsStatus mocks base method.
+func (m *MockStore) TXUpdateOrderItemsStatus(ctx context.Context, arg database.TXUpdateOrderItemsStatusParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPaymentMethods", ctx)
-	ret0, _ := ret[0].([]*database.ListPaymentMethodsRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXUpdateOrderItemsStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListPaymentMethods indicates an expected call of ListPaymentMethods.
-func (mr *MockStoreMockRecorder) ListPaymentMethods(ctx any) *gomock.Call {
+// TXUpdateOrderItemsStatus indicates an expected call of TXUpdateOrderItemsStatus.
+func (mr *MockStoreMockRecorder) TXUpdateOrderItemsStatus(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentMethods", reflect.TypeOf((*MockStore)(nil).ListPaymentMethods), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXUpdateOrderItemsStatus", reflect.TypeOf((*MockStore)(nil).TXUpdateOrderItemsStatus), ctx, arg)
 }
 
-// ListProductTimeExtension mocks base method.
-func (m *MockStore) ListProductTimeExtension(ctx context.Context) ([]*database.ListProductTimeExtensionRow, error) {
+// TXUpdateProduct mocks base method.
+func (m *MockStore) TXUpdateProduct(ctx context.Context, arg database.TXUpdateProductParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProductTimeExtension", ctx)
-	ret0, _ := ret[0].([]*database.ListProductTimeExtensionRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "TXUpdateProduct", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListProductTimeExtension indicates an expected call of ListProductTimeExtension.
-func (mr *MockStoreMockRecorder) ListProductTimeExtension(ctx any) *gomock.Call {
+// TXUpdateProduct indicates an expected call of TXUpdateProduct.
+func (mr *MockStoreMockRecorder) TXUpdateProduct(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTimeExtension", reflect.TypeOf((*MockStore)(nil).ListProductTimeExtension), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXUpdateProduct", reflect.TypeOf((*MockStore)(nil).TXUpdateProduct), ctx, arg)
 }
 
-// ListSessionExtensionReason mocks base method.
-func (m *MockStore) ListSessionExtensionReason(ctx context.Context) ([]*database.ListSessionExtensionReasonRow, error) {
+// TXUpdateTableServiceRequestStatus mocks base method.
+func (m *MockStore) TXUpdateTableServiceRequestStatus(ctx context.Context, arg database.TXUpdateTableServiceRequestStatusParams) (*model.TableServiceRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSessionExtensionReason", ctx)
-	ret0, _ := ret[0].([]*database.ListSessionExtensionReasonRow)
+	ret := m.ctrl.Call(m, "TXUpdateTableServiceRequestStatus", ctx, arg)
+	ret0, _ := ret[0].(*model.TableServiceRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSessionExtensionReason indicates an expected call of ListSessionExtensionReason.
-func (mr *MockStoreMockRecorder) ListSessionExtensionReason(ctx any) *gomock.Call {
+// TXUpdateTableServiceRequestStatus indicates an expected call of TXUpdateTableServiceRequestStatus.
+func (mr *MockStoreMockRecorder) TXUpdateTableServiceRequestStatus(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessionExtensionReason", reflect.TypeOf((*MockStore)(nil).ListSessionExtensionReason), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXUpdateTableServiceRequestStatus", reflect.TypeOf((*MockStore)(nil).TXUpdateTableServiceRequestStatus), ctx, arg)
 }
 
-// ListTableStatus mocks base method.
-func (m *MockStore) ListTableStatus(ctx context.Context) ([]*database.ListTableStatusRow, error) {
+// TryLockProductAssociations mocks base method.
+func (m *MockStore) TryLockProductAssociations(ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTableStatus", ctx)
-	ret0, _ := ret[0].([]*database.ListTableStatusRow)
+	ret := m.ctrl.Call(m, "TryLockProductAssociations", ctx)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTableStatus indicates an expected call of ListTableStatus.
-func (mr *MockStoreMockRecorder) ListTableStatus(ctx any) *gomock.Call {
+// TryLockProductAssociations indicates an expected call of TryLockProductAssociations.
+func (mr *MockStoreMockRecorder) TryLockProductAssociations(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableStatus", reflect.TypeOf((*MockStore)(nil).ListTableStatus), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLockProductAssociations", reflect.TypeOf((*MockStore)(nil).TryLockProductAssociations), ctx)
 }
 
-// QuickSearchTables mocks base method.
-func (m *MockStore) QuickSearchTables(ctx context.Context, arg database.QuickSearchTablesParams) ([]*database.QuickSearchTablesRow, error) {
+// UpdateCategory mocks base method.
+func (m *MockStore) UpdateCategory(ctx context.Context, arg database.UpdateCategoryParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QuickSearchTables", ctx, arg)
-	ret0, _ := ret[0].([]*database.QuickSearchTablesRow)
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// QuickSearchTables indicates an expected call of QuickSearchTables.
-func (mr *MockStoreMockRecorder) QuickSearchTables(ctx, arg any) *gomock.Call {
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockStoreMockRecorder) UpdateCategory(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuickSearchTables", reflect.TypeOf((*MockStore)(nil).QuickSearchTables), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockStore)(nil).UpdateCategory), ctx, arg)
 }
 
-// SearchOrderItems mocks base method.
-func (m *MockStore) SearchOrderItems(ctx context.Context, arg database.SearchOrderItemsParams) ([]*database.SearchOrderItemsRow, error) {
+// UpdateCategorySortOrder mocks base method.
+func (m *MockStore) UpdateCategorySortOrder(ctx context.Context, arg database.UpdateCategorySortOrderParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchOrderItems", ctx, arg)
-	ret0, _ := ret[0].([]*database.SearchOrderItemsRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateCategorySortOrder", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SearchOrderItems indicates an expected call of SearchOrderItems.
-func (mr *MockStoreMockRecorder) SearchOrderItems(ctx, arg any) *gomock.Call {
+// UpdateCategorySortOrder indicates an expected call of UpdateCategorySortOrder.
+func (mr *MockStoreMockRecorder) UpdateCategorySortOrder(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrderItems", reflect.TypeOf((*MockStore)(nil).SearchOrderItems), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategorySortOrder", reflect.TypeOf((*MockStore)(nil).UpdateCategorySortOrder), ctx, arg)
 }
 
-// SearchOrderItemsIsNotFinal mocks base method.
-func (m *MockStore) SearchOrderItemsIsNotFinal(ctx context.Context, arg database.SearchOrderItemsIsNotFinalParams) ([]*database.SearchOrderItemsIsNotFinalRow, error) {
+// UpdateCategoryVisibility mocks base method.
+func (m *MockStore) UpdateCategoryVisibility(ctx context.Context, arg database.UpdateCategoryVisibilityParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchOrderItemsIsNotFinal", ctx, arg)
-	ret0, _ := ret[0].([]*database.SearchOrderItemsIsNotFinalRow)
+	ret := m.ctrl.Call(m, "UpdateCategoryVisibility", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchOrderItemsIsNotFinal indicates an expected call of SearchOrderItemsIsNotFinal.
-func (mr *MockStoreMockRecorder) SearchOrderItemsIsNotFinal(ctx, arg any) *gomock.Call {
+// UpdateCategoryVisibility indicates an expected call of UpdateCategoryVisibility.
+func (mr *MockStoreMockRecorder) UpdateCategoryVisibility(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrderItemsIsNotFinal", reflect.TypeOf((*MockStore)(nil).SearchOrderItemsIsNotFinal), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryVisibility", reflect.TypeOf((*MockStore)(nil).UpdateCategoryVisibility), ctx, arg)
 }
 
-// SearchProducts mocks base method.
-func (m *MockStore) SearchProducts(ctx context.Context, arg database.SearchProductsParams) ([]*database.SearchProductsRow, error) {
+// UpdateComboSlot mocks base method.
+func (m *MockStore) UpdateComboSlot(ctx context.Context, arg database.UpdateComboSlotParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchProducts", ctx, arg)
-	ret0, _ := ret[0].([]*database.SearchProductsRow)
+	ret := m.ctrl.Call(m, "UpdateComboSlot", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchProducts indicates an expected call of SearchProducts.
-func (mr *MockStoreMockRecorder) SearchProducts(ctx, arg any) *gomock.Call {
+// UpdateComboSlot indicates an expected call of UpdateComboSlot.
+func (mr *MockStoreMockRecorder) UpdateComboSlot(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProducts", reflect.TypeOf((*MockStore)(nil).SearchProducts), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComboSlot", reflect.TypeOf((*MockStore)(nil).UpdateComboSlot), ctx, arg)
 }
 
-// SearchTables mocks base method.
-func (m *MockStore) SearchTables(ctx context.Context, arg database.SearchTablesParams) ([]*database.SearchTablesRow, error) {
+// UpdateIngredient mocks base method.
+func (m *MockStore) UpdateIngredient(ctx context.Context, arg database.UpdateIngredientParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchTables", ctx, arg)
-	ret0, _ := ret[0].([]*database.SearchTablesRow)
+	ret := m.ctrl.Call(m, "UpdateIngredient", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchTables indicates an expected call of SearchTables.
-func (mr *MockStoreMockRecorder) SearchTables(ctx, arg any) *gomock.Call {
+// UpdateIngredient indicates an expected call of UpdateIngredient.
+func (mr *MockStoreMockRecorder) UpdateIngredient(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTables", reflect.TypeOf((*MockStore)(nil).SearchTables), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIngredient", reflect.TypeOf((*MockStore)(nil).UpdateIngredient), ctx, arg)
 }
 
-// TXCreateOrder mocks base method.
-func (m *MockStore) TXCreateOrder(ctx context.Context, arg database.TXCreateOrderParams) (int64, error) {
+// UpdateModifier mocks base method.
+func (m *MockStore) UpdateModifier(ctx context.Context, arg database.UpdateModifierParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TXCreateOrder", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateModifier", ctx, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// TXCreateOrder indicates an expected call of TXCreateOrder.
-func (mr *MockStoreMockRecorder) TXCreateOrder(ctx, arg any) *gomock.Call {
+// UpdateModifier indicates an expected call of UpdateModifier.
+func (mr *MockStoreMockRecorder) UpdateModifier(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateOrder", reflect.TypeOf((*MockStore)(nil).TXCreateOrder), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModifier", reflect.TypeOf((*MockStore)(nil).UpdateModifier), ctx, arg)
 }
 
-// TXCreateTableSession mocks base method.
-func (m *MockStore) TXCreateTableSession(ctx context.Context, arg database.CreateTableSessionParams) error {
+// UpdateModifierGroup mocks base method.
+func (m *MockStore) UpdateModifierGroup(ctx context.Context, arg database.UpdateModifierGroupParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TXCreateTableSession", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateModifierGroup", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// TXCreateTableSession indicates an expected call of TXCreateTableSession.
-func (mr *MockStoreMockRecorder) TXCreateTableSession(ctx, arg any) *gomock.Call {
+// UpdateModifierGroup indicates an expected call of UpdateModifierGroup.
+func (mr *MockStoreMockRecorder) UpdateModifierGroup(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXCreateTableSession", reflect.TypeOf((*MockStore)(nil).TXCreateTableSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModifierGroup", reflect.TypeOf((*MockStore)(nil).UpdateModifierGroup), ctx, arg)
 }
 
-// TXSessionsExtension mocks base method.
-func (m *MockStore) TXSessionsExtension(ctx context.Context, arg database.TXSessionsExtensionParams) error {
+// UpdateOrderItemsIngredientsDeducted mocks base method.
+func (m *MockStore) UpdateOrderItemsIngredientsDeducted(ctx context.Context, arg database.UpdateOrderItemsIngredientsDeductedParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TXSessionsExtension", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateOrderItemsIngredientsDeducted", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// TXSessionsExtension indicates an expected call of TXSessionsExtension.
-func (mr *MockStoreMockRecorder) TXSessionsExtension(ctx, arg any) *gomock.Call {
+// UpdateOrderItemsIngredientsDeducted indicates an expected call of UpdateOrderItemsIngredientsDeducted.
+func (mr *MockStoreMockRecorder) UpdateOrderItemsIngredientsDeducted(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TXSessionsExtension", reflect.TypeOf((*MockStore)(nil).TXSessionsExtension), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderItemsIngredientsDeducted", reflect.TypeOf((*MockStore)(nil).UpdateOrderItemsIngredientsDeducted), ctx, arg)
 }
 
 // UpdateOrderItemsStatus mocks base method.
@@ -1201,6 +3119,93 @@ func (mr *MockStoreMockRecorder) UpdateProductAvailability(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductAvailability", reflect.TypeOf((*MockStore)(nil).UpdateProductAvailability), ctx, arg)
 }
 
+// UpdateProductImage mocks base method.
+func (m *MockStore) UpdateProductImage(ctx context.Context, arg database.UpdateProductImageParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductImage", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductImage indicates an expected call of UpdateProductImage.
+func (mr *MockStoreMockRecorder) UpdateProductImage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductImage", reflect.TypeOf((*MockStore)(nil).UpdateProductImage), ctx, arg)
+}
+
+// UpdateProductStock mocks base method.
+func (m *MockStore) UpdateProductStock(ctx context.Context, arg database.UpdateProductStockParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductStock", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductStock indicates an expected call of UpdateProductStock.
+func (mr *MockStoreMockRecorder) UpdateProductStock(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductStock", reflect.TypeOf((*MockStore)(nil).UpdateProductStock), ctx, arg)
+}
+
+// UpdateProductVisibility mocks base method.
+func (m *MockStore) UpdateProductVisibility(ctx context.Context, arg database.UpdateProductVisibilityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductVisibility", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductVisibility indicates an expected call of UpdateProductVisibility.
+func (mr *MockStoreMockRecorder) UpdateProductVisibility(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductVisibility", reflect.TypeOf((*MockStore)(nil).UpdateProductVisibility), ctx, arg)
+}
+
+// UpdateProductsAvailableByIngredients mocks base method.
+func (m *MockStore) UpdateProductsAvailableByIngredients(ctx context.Context, ingredientIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductsAvailableByIngredients", ctx, ingredientIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductsAvailableByIngredients indicates an expected call of UpdateProductsAvailableByIngredients.
+func (mr *MockStoreMockRecorder) UpdateProductsAvailableByIngredients(ctx, ingredientIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductsAvailableByIngredients", reflect.TypeOf((*MockStore)(nil).UpdateProductsAvailableByIngredients), ctx, ingredientIds)
+}
+
+// UpdateProductsUnavailableByIngredients mocks base method.
+func (m *MockStore) UpdateProductsUnavailableByIngredients(ctx context.Context, ingredientIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductsUnavailableByIngredients", ctx, ingredientIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductsUnavailableByIngredients indicates an expected call of UpdateProductsUnavailableByIngredients.
+func (mr *MockStoreMockRecorder) UpdateProductsUnavailableByIngredients(ctx, ingredientIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductsUnavailableByIngredients", reflect.TypeOf((*MockStore)(nil).UpdateProductsUnavailableByIngredients), ctx, ingredientIds)
+}
+
+// UpdatePromotion mocks base method.
+func (m *MockStore) UpdatePromotion(ctx context.Context, arg database.UpdatePromotionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromotion", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePromotion indicates an expected call of UpdatePromotion.
+func (mr *MockStoreMockRecorder) UpdatePromotion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockStore)(nil).UpdatePromotion), ctx, arg)
+}
+
 // UpdateSessionExpireBySessionID mocks base method.
 func (m *MockStore) UpdateSessionExpireBySessionID(ctx context.Context, arg database.UpdateSessionExpireBySessionIDParams) error {
 	m.ctrl.T.Helper()
@@ -1313,6 +3318,34 @@ func (mr *MockStoreMockRecorder) UpdateStatusPaymentTimeOutByTransactionID(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusPaymentTimeOutByTransactionID", reflect.TypeOf((*MockStore)(nil).UpdateStatusPaymentTimeOutByTransactionID), ctx, transactionID)
 }
 
+// UpdateTableServiceRequestAcknowledged mocks base method.
+func (m *MockStore) UpdateTableServiceRequestAcknowledged(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTableServiceRequestAcknowledged", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTableServiceRequestAcknowledged indicates an expected call of UpdateTableServiceRequestAcknowledged.
+func (mr *MockStoreMockRecorder) UpdateTableServiceRequestAcknowledged(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableServiceRequestAcknowledged", reflect.TypeOf((*MockStore)(nil).UpdateTableServiceRequestAcknowledged), ctx, id)
+}
+
+// UpdateTableServiceRequestResolved mocks base method.
+func (m *MockStore) UpdateTableServiceRequestResolved(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTableServiceRequestResolved", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTableServiceRequestResolved indicates an expected call of UpdateTableServiceRequestResolved.
+func (mr *MockStoreMockRecorder) UpdateTableServiceRequestResolved(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTableServiceRequestResolved", reflect.TypeOf((*MockStore)(nil).UpdateTableServiceRequestResolved), ctx, id)
+}
+
 // UpdateTables mocks base method.
 func (m *MockStore) UpdateTables(ctx context.Context, arg database.UpdateTablesParams) error {
 	m.ctrl.T.Helper()
@@ -1355,6 +3388,20 @@ func (mr *MockStoreMockRecorder) UpdateTablesStatusAvailable(ctx, id any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTablesStatusAvailable", reflect.TypeOf((*MockStore)(nil).UpdateTablesStatusAvailable), ctx, id)
 }
 
+// UpdateTablesStatusCallWaiter mocks base method.
+func (m *MockStore) UpdateTablesStatusCallWaiter(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTablesStatusCallWaiter", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTablesStatusCallWaiter indicates an expected call of UpdateTablesStatusCallWaiter.
+func (mr *MockStoreMockRecorder) UpdateTablesStatusCallWaiter(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTablesStatusCallWaiter", reflect.TypeOf((*MockStore)(nil).UpdateTablesStatusCallWaiter), ctx, id)
+}
+
 // UpdateTablesStatusCleaning mocks base method.
 func (m *MockStore) UpdateTablesStatusCleaning(ctx context.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -1452,3 +3499,18 @@ func (mr *MockStoreMockRecorder) UpdateTablesStatusWaitingToBeServed(ctx, id any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTablesStatusWaitingToBeServed", reflect.TypeOf((*MockStore)(nil).UpdateTablesStatusWaitingToBeServed), ctx, id)
 }
+
+// UpdateVariant mocks base method.
+func (m *MockStore) UpdateVariant(ctx context.Context, arg database.UpdateVariantParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockStoreMockRecorder) UpdateVariant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockStore)(nil).UpdateVariant), ctx, arg)
+}
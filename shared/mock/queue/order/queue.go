@@ -10,7 +10,7 @@
 package mockqueue
 
 import (
-	model "food-story/shared/model"
+	kafka "food-story/shared/kafka"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -40,16 +40,30 @@ func (m *MockQueueProducerInterface) EXPECT() *MockQueueProducerInterfaceMockRec
 	return m.recorder
 }
 
-// PublishOrder mocks base method.
-func (m *MockQueueProducerInterface) PublishOrder(item model.OrderItems) error {
+// PublishMessage mocks base method.
+func (m *MockQueueProducerInterface) PublishMessage(message kafka.Message) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishOrder", item)
+	ret := m.ctrl.Call(m, "PublishMessage", message)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PublishOrder indicates an expected call of PublishOrder.
-func (mr *MockQueueProducerInterfaceMockRecorder) PublishOrder(item any) *gomock.Call {
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockQueueProducerInterfaceMockRecorder) PublishMessage(message any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishOrder", reflect.TypeOf((*MockQueueProducerInterface)(nil).PublishOrder), item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockQueueProducerInterface)(nil).PublishMessage), message)
+}
+
+// PublishMessages mocks base method.
+func (m *MockQueueProducerInterface) PublishMessages(messages []kafka.Message) []error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMessages", messages)
+	ret0, _ := ret[0].([]error)
+	return ret0
+}
+
+// PublishMessages indicates an expected call of PublishMessages.
+func (mr *MockQueueProducerInterfaceMockRecorder) PublishMessages(messages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessages", reflect.TypeOf((*MockQueueProducerInterface)(nil).PublishMessages), messages)
 }
@@ -1,7 +1,9 @@
 package model
 
 import (
+	"encoding/json"
 	"food-story/pkg/utils"
+	"food-story/shared/orderstatus"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -22,53 +24,127 @@ type OrderItemsRow interface {
 	GetPrice() pgtype.Numeric
 	GetQuantity() int32
 	GetNote() pgtype.Text
+	GetModifiers() []byte
+	GetAllergens() []byte
+	GetVariantID() pgtype.Int8
+	GetVariantName() pgtype.Text
+	GetVariantNameEN() pgtype.Text
+	GetParentOrderItemID() pgtype.Int8
 	GetCreatedAt() pgtype.Timestamptz
 }
 
 type OrderItems struct {
-	ID            int64   `json:"id,string" example:"1920153361642950656"`
-	OrderID       int64   `json:"orderID,string" example:"1921828287366041600"`
-	OrderNumber   string  `json:"orderNumber" example:"FS-20250523-0001"`
-	ProductID     int64   `json:"productID,string" example:"1921822053405560832"`
-	StatusID      int64   `json:"statusID,string" example:"1921868485739155458"`
-	ImageURL      *string `json:"imageURL" example:"https://example.com/image.jpg"`
-	TableNumber   int32   `json:"tableNumber" example:"1"`
-	StatusName    string  `json:"statusName" example:"กำลังเตรียมอาหาร"`
-	StatusNameEN  string  `json:"statusNameEN" example:"Preparing"`
-	StatusCode    string  `json:"statusCode" example:"PREPARING"`
-	ProductName   string  `json:"productName" example:"ข้าวผัด"`
-	ProductNameEN string  `json:"productNameEN" example:"Fried rice"`
-	Price         float64 `json:"price" example:"60"`
-	Quantity      int32   `json:"quantity" example:"1"`
-	Note          *string `json:"note" example:"lorem ipsum"`
-	CreatedAt     string  `json:"createdAt" example:"2025-05-23T13:50:36+07:00"`
+	ID                int64                     `json:"id,string" example:"1920153361642950656"`
+	OrderID           int64                     `json:"orderID,string" example:"1921828287366041600"`
+	OrderNumber       string                    `json:"orderNumber" example:"FS-20250523-0001"`
+	ProductID         int64                     `json:"productID,string" example:"1921822053405560832"`
+	StatusID          int64                     `json:"statusID,string" example:"1921868485739155458"`
+	ImageURL          *string                   `json:"imageURL" example:"https://example.com/image.jpg"`
+	TableNumber       int32                     `json:"tableNumber" example:"1"`
+	StatusName        string                    `json:"statusName" example:"กำลังเตรียมอาหาร"`
+	StatusNameEN      string                    `json:"statusNameEN" example:"Preparing"`
+	StatusCode        string                    `json:"statusCode" example:"PREPARING"`
+	ProductName       string                    `json:"productName" example:"ข้าวผัด"`
+	ProductNameEN     string                    `json:"productNameEN" example:"Fried rice"`
+	VariantID         *int64                    `json:"variantID,string" example:"1921822053405560834"`
+	VariantName       *string                   `json:"variantName" example:"ใหญ่"`
+	VariantNameEN     *string                   `json:"variantNameEN" example:"Large"`
+	ParentOrderItemID *int64                    `json:"parentOrderItemID,string" example:"1920153361642950655"`
+	Price             float64                   `json:"price" example:"60"`
+	Quantity          int32                     `json:"quantity" example:"1"`
+	Note              *string                   `json:"note" example:"lorem ipsum"`
+	Modifiers         []OrderItemModifier       `json:"modifiers"`
+	Allergens         []OrderItemAllergen       `json:"allergens"`
+	ComboSelections   []OrderItemComboSelection `json:"-"`
+	CreatedAt         string                    `json:"createdAt" example:"2025-05-23T13:50:36+07:00"`
+
+	// localized fields are filled in for HTTP responses only, the Kafka payload leaves them out
+	LocalizedProductName string  `json:"localizedProductName,omitempty" example:"炒饭"`
+	LocalizedStatusName  string  `json:"localizedStatusName,omitempty" example:"准备中"`
+	LocalizedVariantName *string `json:"localizedVariantName,omitempty" example:"大份"`
+}
+
+type OrderItemModifier struct {
+	ID          int64   `json:"id,string" example:"1921822053405560833"`
+	GroupID     int64   `json:"groupID,string" example:"1921822053405560832"`
+	GroupName   string  `json:"groupName" example:"ระดับความเผ็ด"`
+	GroupNameEN string  `json:"groupNameEN" example:"Spice level"`
+	Name        string  `json:"name" example:"ไม่เผ็ด"`
+	NameEN      string  `json:"nameEN" example:"No chili"`
+	PriceDelta  float64 `json:"priceDelta" example:"0"`
+}
+
+type OrderItemAllergen struct {
+	Code   string `json:"code" example:"PEANUT"`
+	Name   string `json:"name" example:"ถั่วลิสง"`
+	NameEN string `json:"nameEN" example:"Peanut"`
+}
+
+type OrderItemComboSelection struct {
+	SlotID    int64
+	ProductID int64
+}
+
+// OrderItemsAmended is published when a customer changes an item before the kitchen starts it, the kitchen
+// display replaces the ticket with the same ID instead of adding a new one.
+type OrderItemsAmended struct {
+	OrderItems
+	AmendedAt string `json:"amendedAt" example:"2025-05-23T13:55:12+07:00"`
+}
+
+// OrderItemsStatusChanged is published for every order item status change, whoever made it, so other services
+// can follow the item without reading the database.
+type OrderItemsStatusChanged struct {
+	EventID           int64             `json:"eventID,string" example:"1024"`
+	ID                int64             `json:"id,string" example:"1920153361642950656"`
+	OrderID           int64             `json:"orderID,string" example:"1921828287366041600"`
+	ParentOrderItemID *int64            `json:"parentOrderItemID,string" example:"1920153361642950655"`
+	OldStatus         string            `json:"oldStatus" example:"PREPARING"`
+	NewStatus         string            `json:"newStatus" example:"SERVED"`
+	Actor             orderstatus.Actor `json:"actor" example:"KITCHEN"`
+	ChangedAt         string            `json:"changedAt" example:"2025-05-23T14:05:40+07:00"`
+}
+
+type OrderItemsAmendment struct {
+	ID        int64
+	OrderID   int64
+	Quantity  int32
+	Note      *string
+	Modifiers []OrderItemModifier
 }
 
 type OrderItemsStatus struct {
 	ID         int64
 	OrderID    int64
 	StatusCode string
+	Actor      orderstatus.Actor
 }
 
 func TransformOrderItemsByIDResults[T OrderItemsRow](results T) *OrderItems {
 	createdAt, _ := utils.PgTimestampToThaiISO8601(results.GetCreatedAt())
 	return &OrderItems{
-		ID:            results.GetID(),
-		OrderID:       results.GetOrderID(),
-		OrderNumber:   results.GetOrderNumber(),
-		ProductID:     results.GetProductID(),
-		StatusID:      results.GetStatusID(),
-		ImageURL:      utils.PgTextToStringPtr(results.GetImageURL()),
-		TableNumber:   results.GetTableNumber(),
-		StatusName:    results.GetStatusName(),
-		StatusNameEN:  results.GetStatusNameEN(),
-		StatusCode:    results.GetStatusCode(),
-		ProductName:   results.GetProductName(),
-		ProductNameEN: results.GetProductNameEN(),
-		Price:         utils.PgNumericToFloat64(results.GetPrice()),
-		Quantity:      results.GetQuantity(),
-		Note:          utils.PgTextToStringPtr(results.GetNote()),
-		CreatedAt:     createdAt,
+		ID:                results.GetID(),
+		OrderID:           results.GetOrderID(),
+		OrderNumber:       results.GetOrderNumber(),
+		ProductID:         results.GetProductID(),
+		StatusID:          results.GetStatusID(),
+		ImageURL:          utils.PgTextToStringPtr(results.GetImageURL()),
+		TableNumber:       results.GetTableNumber(),
+		StatusName:        results.GetStatusName(),
+		StatusNameEN:      results.GetStatusNameEN(),
+		StatusCode:        results.GetStatusCode(),
+		ProductName:       results.GetProductName(),
+		ProductNameEN:     results.GetProductNameEN(),
+		VariantID:         utils.PgInt8ToInt64Ptr(results.GetVariantID()),
+		VariantName:       utils.PgTextToStringPtr(results.GetVariantName()),
+		VariantNameEN:     utils.PgTextToStringPtr(results.GetVariantNameEN()),
+		ParentOrderItemID: utils.PgInt8ToInt64Ptr(results.GetParentOrderItemID()),
+		Price:             utils.PgNumericToFloat64(results.GetPrice()),
+		Quantity:          results.GetQuantity(),
+		Note:              utils.PgTextToStringPtr(results.GetNote()),
+		Modifiers:         UnmarshalOrderItemModifiers(results.GetModifiers()),
+		Allergens:         UnmarshalOrderItemAllergens(results.GetAllergens()),
+		CreatedAt:         createdAt,
 	}
 }
 
@@ -83,3 +159,27 @@ func TransformOrderItemsResults[T OrderItemsRow](results []T) []*OrderItems {
 	}
 	return data
 }
+
+func UnmarshalOrderItemModifiers(data []byte) []OrderItemModifier {
+	modifiers := []OrderItemModifier{}
+	if len(data) == 0 {
+		return modifiers
+	}
+
+	if err := json.Unmarshal(data, &modifiers); err != nil {
+		return []OrderItemModifier{}
+	}
+	return modifiers
+}
+
+func UnmarshalOrderItemAllergens(data []byte) []OrderItemAllergen {
+	allergens := []OrderItemAllergen{}
+	if len(data) == 0 {
+		return allergens
+	}
+
+	if err := json.Unmarshal(data, &allergens); err != nil {
+		return []OrderItemAllergen{}
+	}
+	return allergens
+}
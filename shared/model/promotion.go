@@ -0,0 +1,8 @@
+package model
+
+// PromotionType values are stored in promotions.promotion_type.
+const (
+	PromotionTypePercentage  = "PERCENTAGE"
+	PromotionTypeFixedAmount = "FIXED_AMOUNT"
+	PromotionTypeBuyXGetY    = "BUY_X_GET_Y"
+)
@@ -0,0 +1,39 @@
+package model
+
+// request types and reasons of table_service_requests
+const (
+	ServiceRequestCallWaiter  = "CALL_WAITER"
+	ServiceRequestRequestBill = "REQUEST_BILL"
+
+	ServiceRequestReasonWater   = "WATER"
+	ServiceRequestReasonCutlery = "CUTLERY"
+	ServiceRequestReasonHelp    = "HELP"
+)
+
+// A request is PENDING until a staff member acknowledges it and RESOLVED once handled, staff may resolve a
+// PENDING request directly.
+const (
+	ServiceRequestPending      = "PENDING"
+	ServiceRequestAcknowledged = "ACKNOWLEDGED"
+	ServiceRequestResolved     = "RESOLVED"
+)
+
+type TableServiceRequest struct {
+	ID             int64   `json:"id,string" example:"1923564209223999490"`
+	TableID        int64   `json:"tableID,string" example:"1920153361642950656"`
+	TableNumber    int32   `json:"tableNumber" example:"1"`
+	OrderID        *int64  `json:"orderID,string" example:"1921828287366041600"`
+	RequestType    string  `json:"requestType" example:"CALL_WAITER"`
+	Reason         *string `json:"reason" example:"WATER"`
+	Status         string  `json:"status" example:"PENDING"`
+	CreatedAt      string  `json:"createdAt" example:"2025-05-23T14:05:40+07:00"`
+	AcknowledgedAt *string `json:"acknowledgedAt" example:"2025-05-23T14:06:10+07:00"`
+	ResolvedAt     *string `json:"resolvedAt" example:"2025-05-23T14:08:00+07:00"`
+}
+
+// TableServiceRequestChanged is published when a request is created, acknowledged or resolved so staff screens
+// follow the open requests in real time.
+type TableServiceRequestChanged struct {
+	EventID int64 `json:"eventID,string" example:"2048"`
+	TableServiceRequest
+}
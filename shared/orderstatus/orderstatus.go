@@ -0,0 +1,113 @@
+package orderstatus
+
+import (
+	"errors"
+	"fmt"
+	"food-story/pkg/exceptions"
+)
+
+// codes of md_order_statuses, orders and order items share the same master data
+const (
+	Pending        = "PENDING"
+	Confirmed      = "CONFIRMED"
+	Preparing      = "PREPARING"
+	Served         = "SERVED"
+	WaitingPayment = "WAITING_PAYMENT"
+	Completed      = "COMPLETED"
+	Cancelled      = "CANCELLED"
+)
+
+// Actor is who asks for a status change.
+type Actor string
+
+const (
+	ActorCustomer Actor = "CUSTOMER"
+	ActorStaff    Actor = "STAFF"
+	ActorKitchen  Actor = "KITCHEN"
+	ActorPayment  Actor = "PAYMENT"
+	ActorSystem   Actor = "SYSTEM"
+)
+
+// Status is the current status of an order or order item as stored, IsFinal comes from md_order_statuses.
+type Status struct {
+	Code    string
+	IsFinal bool
+}
+
+// Machine holds the allowed transitions and the actors that may trigger each one.
+type Machine struct {
+	name        string
+	transitions map[string]map[string][]Actor
+}
+
+// OrderItem covers order_items.status_id. The kitchen moves an item forward, a customer can only cancel before
+// the kitchen starts and SYSTEM settles a combo line from its components.
+var OrderItem = Machine{
+	name: "order item",
+	transitions: map[string]map[string][]Actor{
+		Pending: {
+			Confirmed: {ActorStaff, ActorKitchen},
+			Preparing: {ActorKitchen},
+			Served:    {ActorStaff, ActorKitchen, ActorSystem},
+			Cancelled: {ActorCustomer, ActorStaff, ActorKitchen, ActorSystem},
+		},
+		Confirmed: {
+			Preparing: {ActorKitchen},
+			Served:    {ActorStaff, ActorKitchen, ActorSystem},
+			Cancelled: {ActorCustomer, ActorStaff, ActorKitchen, ActorSystem},
+		},
+		Preparing: {
+			Served:    {ActorStaff, ActorKitchen, ActorSystem},
+			Cancelled: {ActorStaff, ActorKitchen, ActorSystem},
+		},
+		// a served item is terminal, md_order_statuses marks it final as well
+		Served: {},
+	},
+}
+
+// Order covers orders.status_id. A failed payment leaves the order waiting, so the customer can pay again.
+var Order = Machine{
+	name: "order",
+	transitions: map[string]map[string][]Actor{
+		Confirmed: {
+			WaitingPayment: {ActorPayment},
+			Cancelled:      {ActorStaff},
+		},
+		WaitingPayment: {
+			WaitingPayment: {ActorPayment},
+			Completed:      {ActorPayment},
+			Cancelled:      {ActorStaff},
+		},
+	},
+}
+
+// Validate returns exceptions.ErrOrderStatusTransition when the status cannot move to the target at all, a
+// final status never moves, and exceptions.ErrOrderStatusActor when the transition exists but the actor may not
+// trigger it.
+func (m Machine) Validate(current Status, to string, actor Actor) error {
+	if current.IsFinal {
+		return fmt.Errorf("%w: %s is %s and cannot change to %s", exceptions.ErrOrderStatusTransition, m.name, current.Code, to)
+	}
+
+	actors, ok := m.transitions[current.Code][to]
+	if !ok {
+		return fmt.Errorf("%w: %s cannot change from %s to %s", exceptions.ErrOrderStatusTransition, m.name, current.Code, to)
+	}
+
+	for _, allowed := range actors {
+		if allowed == actor {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s cannot change %s from %s to %s", exceptions.ErrOrderStatusActor, actor, m.name, current.Code, to)
+}
+
+// Error maps a Validate error to the error every service responds with. Every illegal transition is a conflict,
+// including one the actor may not trigger, the caller is already authorized for the endpoint itself.
+func Error(err error) (*exceptions.AppError, bool) {
+	if errors.Is(err, exceptions.ErrOrderStatusTransition) || errors.Is(err, exceptions.ErrOrderStatusActor) {
+		return exceptions.Error(exceptions.CodeConflict, err.Error()), true
+	}
+	return nil, false
+}
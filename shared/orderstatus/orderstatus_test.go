@@ -0,0 +1,171 @@
+package orderstatus
+
+import (
+	"errors"
+	"food-story/pkg/exceptions"
+	"slices"
+	"testing"
+)
+
+var (
+	allStatuses = []string{Pending, Confirmed, Preparing, Served, WaitingPayment, Completed, Cancelled}
+	allActors   = []Actor{ActorCustomer, ActorStaff, ActorKitchen, ActorPayment, ActorSystem}
+)
+
+type transition struct {
+	from, to string
+}
+
+func TestMachineValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine Machine
+		// allowed lists every legal transition and who may trigger it, anything else must be refused
+		allowed map[transition][]Actor
+	}{
+		{
+			name:    "order item",
+			machine: OrderItem,
+			allowed: map[transition][]Actor{
+				{Pending, Confirmed}:   {ActorStaff, ActorKitchen},
+				{Pending, Preparing}:   {ActorKitchen},
+				{Pending, Served}:      {ActorStaff, ActorKitchen, ActorSystem},
+				{Pending, Cancelled}:   {ActorCustomer, ActorStaff, ActorKitchen, ActorSystem},
+				{Confirmed, Preparing}: {ActorKitchen},
+				{Confirmed, Served}:    {ActorStaff, ActorKitchen, ActorSystem},
+				{Confirmed, Cancelled}: {ActorCustomer, ActorStaff, ActorKitchen, ActorSystem},
+				{Preparing, Served}:    {ActorStaff, ActorKitchen, ActorSystem},
+				{Preparing, Cancelled}: {ActorStaff, ActorKitchen, ActorSystem},
+			},
+		},
+		{
+			name:    "order",
+			machine: Order,
+			allowed: map[transition][]Actor{
+				{Confirmed, WaitingPayment}:      {ActorPayment},
+				{Confirmed, Cancelled}:           {ActorStaff},
+				{WaitingPayment, WaitingPayment}: {ActorPayment},
+				{WaitingPayment, Completed}:      {ActorPayment},
+				{WaitingPayment, Cancelled}:      {ActorStaff},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, from := range allStatuses {
+			for _, to := range allStatuses {
+				for _, actor := range allActors {
+					actors, exists := tt.allowed[transition{from, to}]
+
+					var want error
+					switch {
+					case !exists:
+						want = exceptions.ErrOrderStatusTransition
+					case !slices.Contains(actors, actor):
+						want = exceptions.ErrOrderStatusActor
+					}
+
+					t.Run(tt.name+"/"+from+"->"+to+"/"+string(actor), func(t *testing.T) {
+						err := tt.machine.Validate(Status{Code: from}, to, actor)
+						if want == nil {
+							if err != nil {
+								t.Fatalf("expected transition to be allowed, got %v", err)
+							}
+							return
+						}
+
+						if !errors.Is(err, want) {
+							t.Fatalf("expected %v, got %v", want, err)
+						}
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestMachineValidateFinalStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine Machine
+		current Status
+	}{
+		{name: "served order item", machine: OrderItem, current: Status{Code: Served, IsFinal: true}},
+		{name: "cancelled order item", machine: OrderItem, current: Status{Code: Cancelled, IsFinal: true}},
+		{name: "completed order", machine: Order, current: Status{Code: Completed, IsFinal: true}},
+		{name: "cancelled order", machine: Order, current: Status{Code: Cancelled, IsFinal: true}},
+		// a status flagged final in md_order_statuses never moves, even where the machine lists a transition
+		{name: "final pending order item", machine: OrderItem, current: Status{Code: Pending, IsFinal: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, to := range allStatuses {
+				for _, actor := range allActors {
+					err := tt.machine.Validate(tt.current, to, actor)
+					if !errors.Is(err, exceptions.ErrOrderStatusTransition) {
+						t.Fatalf("%s -> %s by %s: expected %v, got %v", tt.current.Code, to, actor, exceptions.ErrOrderStatusTransition, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode exceptions.Code
+		wantOK   bool
+	}{
+		{
+			name:     "transition that does not exist",
+			err:      OrderItem.Validate(Status{Code: Preparing}, Confirmed, ActorKitchen),
+			wantCode: exceptions.CodeConflict,
+			wantOK:   true,
+		},
+		{
+			name:     "actor not allowed",
+			err:      OrderItem.Validate(Status{Code: Preparing}, Cancelled, ActorCustomer),
+			wantCode: exceptions.CodeConflict,
+			wantOK:   true,
+		},
+		{
+			name:     "final status",
+			err:      Order.Validate(Status{Code: Completed, IsFinal: true}, Cancelled, ActorStaff),
+			wantCode: exceptions.CodeConflict,
+			wantOK:   true,
+		},
+		{
+			name:   "other error",
+			err:    errors.New("connection reset"),
+			wantOK: false,
+		},
+		{
+			name:   "no error",
+			err:    nil,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			appErr, ok := Error(tt.err)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok %t, got %t", tt.wantOK, ok)
+			}
+
+			if !ok {
+				if appErr != nil {
+					t.Fatalf("expected no app error, got %v", appErr)
+				}
+				return
+			}
+
+			if appErr.Code != tt.wantCode {
+				t.Fatalf("expected code %s, got %s", tt.wantCode, appErr.Code)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package redis
 
 const (
-	KeyTable       = "table:"
-	KeyTableStatus = "table::status"
+	KeyTable                 = "table:"
+	KeyTableStatus           = "table::status"
+	KeyMenu                  = "menu:"
+	KeyMenuVersion           = "menu::version"
+	KeyRecommendationVersion = "menu::recommendation-version"
+	KeyIdempotency           = "idempotency:"
 )
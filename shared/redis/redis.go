@@ -15,8 +15,10 @@ var ctx = context.Background()
 
 type RedisInterface interface {
 	Set(key string, value string, expiration time.Duration) error
+	SetNX(key string, value string, expiration time.Duration) (bool, error)
 	Get(key string) (string, error)
 	Del(key string) error
+	Incr(key string) (int64, error)
 	TTL(key string) (time.Duration, error)
 	Close()
 }
@@ -46,6 +48,11 @@ func (r *RedisClient) Set(key string, value string, expiration time.Duration) er
 	return r.Client.Set(ctx, key, value, expiration).Err()
 }
 
+// SetNX sets key only when it does not exist yet and reports whether it did.
+func (r *RedisClient) SetNX(key string, value string, expiration time.Duration) (bool, error) {
+	return r.Client.SetNX(ctx, key, value, expiration).Result()
+}
+
 func (r *RedisClient) Get(key string) (string, error) {
 	data, err := r.Client.Get(ctx, key).Result()
 	if errors.Is(err, redis.Nil) {
@@ -62,6 +69,10 @@ func (r *RedisClient) Del(key string) error {
 	return r.Client.Del(ctx, key).Err()
 }
 
+func (r *RedisClient) Incr(key string) (int64, error) {
+	return r.Client.Incr(ctx, key).Result()
+}
+
 func (r *RedisClient) TTL(key string) (time.Duration, error) {
 	data, err := r.Client.TTL(ctx, key).Result()
 	if errors.Is(err, redis.Nil) {
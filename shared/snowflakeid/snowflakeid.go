@@ -18,6 +18,24 @@ func NewSnowflake(snowflakeID *snowflake.Node) *SnowflakeImpl {
 	}
 }
 
+// Default node numbers, one per service. Services share the database so two nodes with the same number could
+// generate the same ID, a second instance of a service sets SNOWFLAKE_NODE_ID to a number no one else uses.
+const (
+	NodeMenuService int64 = iota + 1
+	NodeOrderService
+	NodeKitchenService
+	NodePaymentService
+	NodeTableService
+)
+
+// NodeNumber returns the configured node number, or the service default when SNOWFLAKE_NODE_ID is not set
+func NodeNumber(configured, serviceDefault int64) int64 {
+	if configured != 0 {
+		return configured
+	}
+	return serviceDefault
+}
+
 func CreateSnowflakeNode(nodeNumber int64) (*snowflake.Node, error) {
 	node, err := snowflake.NewNode(nodeNumber)
 	if err != nil {
@@ -209,6 +209,7 @@ func (s *Handler) CreateTableSession(c *fiber.Ctx) error {
 	result, err := s.useCase.CreateTableSession(c.Context(), domain.TableSession{
 		TableID:        tableID,
 		NumberOfPeople: body.NumberOfPeople,
+		Locale:         body.Locale,
 	})
 	if err != nil {
 		return middleware.ResponseError(c, err)
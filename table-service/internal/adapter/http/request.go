@@ -10,8 +10,9 @@ type updateTableStatus struct {
 }
 
 type TableSession struct {
-	TableID        string `json:"tableID" validate:"required" example:"1923564209627467776"`
-	NumberOfPeople int32  `json:"numberOfPeople" validate:"required,gte=1" example:"3"`
+	TableID        string  `json:"tableID" validate:"required" example:"1923564209627467776"`
+	NumberOfPeople int32   `json:"numberOfPeople" validate:"required,gte=1" example:"3"`
+	Locale         *string `json:"locale" validate:"omitempty,bcp47_language_tag,max=10" example:"zh-TW"`
 }
 
 type SearchTable struct {
@@ -91,8 +91,8 @@ func New() (*FiberServer, error) {
 	// connect to redis
 	redisConn := redis.NewRedisClient(configApp.RedisAddress, configApp.RedisPassword, 0)
 
-	// Create a new Node with a Node number of 1
-	node, err := snowflakeid.CreateSnowflakeNode(1)
+	// each service has its own node number so IDs written to the shared database never collide
+	node, err := snowflakeid.CreateSnowflakeNode(snowflakeid.NodeNumber(configApp.SnowflakeNodeID, snowflakeid.NodeTableService))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snowflake: %w", err)
 	}
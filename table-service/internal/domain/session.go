@@ -20,6 +20,7 @@ type TableSession struct {
 	TableID        int64
 	SessionID      uuid.UUID
 	NumberOfPeople int32
+	Locale         *string
 }
 
 type SessionExtension struct {
@@ -35,6 +36,7 @@ type CurrentTableSession struct {
 	Status      string    `json:"status" example:"active"`
 	StartedAt   time.Time `json:"startedAt" example:"2025-05-23T11:59:50.010316+07:00"`
 	OrderID     *string   `json:"orderID" example:"1922535048335069184"`
+	Locale      *string   `json:"locale" example:"zh-tw"`
 }
 
 type ProductTimeExtension struct {
@@ -58,6 +58,7 @@ func (i *Implement) CreateTableSession(ctx context.Context, payload domain.Table
 		Status:      _sessionStatusActive,
 		StartedAt:   startedAt,
 		OrderID:     nil,
+		Locale:      normalizeLocale(payload.Locale),
 	}, i.config.TableSessionDuration)
 	if err != nil {
 		return "", err
@@ -75,6 +76,15 @@ func (i *Implement) CreateTableSession(ctx context.Context, payload domain.Table
 	return i.config.FrontendURL + "?s=" + encryptedSessionID, nil
 }
 
+func normalizeLocale(locale *string) *string {
+	if locale == nil {
+		return nil
+	}
+
+	normalized := utils.NormalizeLocale(*locale)
+	return &normalized
+}
+
 func (i *Implement) generateSessionDetails() (uuid.UUID, time.Time) {
 	return uuid.New(), time.Now().Add(i.config.TableSessionDuration)
 }